@@ -3,18 +3,43 @@ package app
 import (
     "context"
     "database/sql"
+    "log"
     _ "github.com/lib/pq"
 
     "auction-system/internal/config"
+    "auction-system/internal/application/digest"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    "auction-system/internal/application/idempotency"
+    "auction-system/internal/application/ledger"
+    notificationDispatch "auction-system/internal/application/notifier"
+    "auction-system/internal/application/payout"
+    "auction-system/internal/application/settlement"
+    "auction-system/internal/application/webhook"
     "auction-system/internal/infrastructure/persistence/postgres"
+    "auction-system/internal/domain/entity"
+    eventDomain "auction-system/internal/domain/event"
+    feeInfra "auction-system/internal/infrastructure/fee"
+    invoiceDomain "auction-system/internal/domain/invoice"
+    invoiceInfra "auction-system/internal/infrastructure/invoice"
+    exchangeDomain "auction-system/internal/domain/exchange"
+    exchangeInfra "auction-system/internal/infrastructure/exchange"
     userUseCase "auction-system/internal/application/usecase/user"
     lotUseCase "auction-system/internal/application/usecase/lot"
     auctionUseCase "auction-system/internal/application/usecase/auction"
     bidUseCase "auction-system/internal/application/usecase/bid"
+    invoiceUseCase "auction-system/internal/application/usecase/invoice"
+    withdrawalUseCase "auction-system/internal/application/usecase/withdrawal"
+    notificationUseCase "auction-system/internal/application/usecase/notification"
+    webhookUseCase "auction-system/internal/application/usecase/webhook"
     handler "auction-system/internal/interfaces/grpc/handler"
     "auction-system/internal/worker"
     notificationDomain "auction-system/internal/domain/notification"
     notificationInfra "auction-system/internal/infrastructure/notification"
+    paymentInfra "auction-system/internal/infrastructure/payment"
+    payoutInfra "auction-system/internal/infrastructure/payout"
+    webhookInfra "auction-system/internal/infrastructure/webhook"
+    "auction-system/pkg/api"
 )
 
 type App struct {
@@ -22,6 +47,7 @@ type App struct {
     db       *sql.DB
     handlers *handler.Handlers
     worker   *worker.Worker
+    services *services
 }
 
 func NewApp(cfg *config.Config) (*App, error) {
@@ -31,9 +57,12 @@ func NewApp(cfg *config.Config) (*App, error) {
     }
 
     repos := initRepositories(db)
-    services := initServices()
-    useCases := initUseCases(repos)
-    handlers := initHandlers(useCases)
+    services, err := initServices(cfg, db, repos)
+    if err != nil {
+        return nil, err
+    }
+    useCases := initUseCases(cfg, repos, services)
+    handlers := initHandlers(cfg, useCases, services)
     worker := initWorker(repos, services)
 
     return &App{
@@ -41,19 +70,37 @@ func NewApp(cfg *config.Config) (*App, error) {
         db:       db,
         handlers: handlers,
         worker:   worker,
+        services: services,
     }, nil
 }
 
 func (a *App) Run(ctx context.Context) error {
     go a.worker.Start(ctx)
 
+    go func() {
+        if err := a.services.events.Listen(ctx); err != nil {
+            log.Printf("Auction events from other instances are not received: %v", err)
+        }
+    }()
+    go func() {
+        if err := a.services.notifications.Listen(ctx); err != nil {
+            log.Printf("Notifications from other instances are not received: %v", err)
+        }
+    }()
+
     return a.handlers.Serve(ctx, a.cfg)
 }
 
 func (a *App) Shutdown(ctx context.Context) error {
+    // Ends the WatchAuction, SSE and WebSocket streams, which would
+    // otherwise hold up the graceful stop.
+    a.services.bus.Close()
+    a.services.notificationBus.Close()
     if err := a.handlers.Shutdown(ctx); err != nil {
         return err
     }
+    // Sends the notifications still queued while the database is open.
+    a.services.dispatcher.Close()
     return a.db.Close()
 }
 
@@ -69,26 +116,62 @@ func initDatabase(cfg *config.Config) (*sql.DB, error) {
 }
 
 type repositories struct {
-    userRepo    *postgres.UserRepository
-    lotRepo     *postgres.LotRepository
-    auctionRepo *postgres.AuctionRepository
-    bidRepo     *postgres.BidRepository
+    userRepo        *postgres.UserRepository
+    lotRepo         *postgres.LotRepository
+    auctionRepo     *postgres.AuctionRepository
+    bidRepo         *postgres.BidRepository
+    maxBidRepo      *postgres.MaxBidRepository
+    winnerRepo      *postgres.AuctionWinnerRepository
+    holdRepo        *postgres.FundHoldRepository
+    walletRepo      *postgres.WalletRepository
+    paymentRepo     *postgres.PaymentRepository
+    ledgerRepo      *postgres.LedgerRepository
+    invoiceRepo     *postgres.InvoiceRepository
+    withdrawalRepo  *postgres.WithdrawalRepository
+    idempotencyRepo *postgres.IdempotencyRepository
+    notificationRepo *postgres.NotificationRepository
+    notificationPreferenceRepo *postgres.NotificationPreferenceRepository
+    webhookRepo     *postgres.WebhookRepository
+    webhookDeliveryRepo *postgres.WebhookDeliveryRepository
+    watchlistRepo   *postgres.WatchlistRepository
+    digestRepo      *postgres.DigestRepository
+    txManager       *postgres.TxManager
 }
 
 func initRepositories(db *sql.DB) *repositories {
     return &repositories{
-        userRepo:    postgres.NewUserRepository(db),
-        lotRepo:     postgres.NewLotRepository(db),
-        auctionRepo: postgres.NewAuctionRepository(db),
-        bidRepo:     postgres.NewBidRepository(db),
+        userRepo:        postgres.NewUserRepository(db),
+        lotRepo:         postgres.NewLotRepository(db),
+        auctionRepo:     postgres.NewAuctionRepository(db),
+        bidRepo:         postgres.NewBidRepository(db),
+        maxBidRepo:      postgres.NewMaxBidRepository(db),
+        winnerRepo:      postgres.NewAuctionWinnerRepository(db),
+        holdRepo:        postgres.NewFundHoldRepository(db),
+        walletRepo:      postgres.NewWalletRepository(db),
+        paymentRepo:     postgres.NewPaymentRepository(db),
+        ledgerRepo:      postgres.NewLedgerRepository(db),
+        invoiceRepo:     postgres.NewInvoiceRepository(db),
+        withdrawalRepo:  postgres.NewWithdrawalRepository(db),
+        idempotencyRepo: postgres.NewIdempotencyRepository(db),
+        notificationRepo: postgres.NewNotificationRepository(db),
+        notificationPreferenceRepo: postgres.NewNotificationPreferenceRepository(db),
+        webhookRepo:     postgres.NewWebhookRepository(db),
+        webhookDeliveryRepo: postgres.NewWebhookDeliveryRepository(db),
+        watchlistRepo:   postgres.NewWatchlistRepository(db),
+        digestRepo:      postgres.NewDigestRepository(db),
+        txManager:       postgres.NewTxManager(db),
     }
 }
 
 type useCases struct {
-    user    *userUseCases
-    lot     *lotUseCases
-    auction *auctionUseCases
-    bid     *bidUseCases
+    user       *userUseCases
+    lot        *lotUseCases
+    auction    *auctionUseCases
+    bid        *bidUseCases
+    invoice    *invoiceUseCases
+    withdrawal *withdrawalUseCases
+    notification *notificationUseCases
+    webhook    *webhookUseCases
 }
 
 type userUseCases struct {
@@ -98,6 +181,7 @@ type userUseCases struct {
     delete       *userUseCase.DeleteUserUseCase
     getAll       *userUseCase.GetAllUserUseCase
     updateBalance *userUseCase.UpdateBalanceUseCase
+    transactions  *userUseCase.ListTransactionsUseCase
 }
 
 type lotUseCases struct {
@@ -113,33 +197,201 @@ type auctionUseCases struct {
     get     *auctionUseCase.GetAuctionUseCase
     update  *auctionUseCase.UpdateAuctionUseCase
     list    *auctionUseCase.ListAuctionsUseCase
+    buyNow  *auctionUseCase.BuyNowUseCase
+    accept  *auctionUseCase.AcceptPriceUseCase
+    settlement *auctionUseCase.GetSettlementUseCase
+    addToWatchlist      *auctionUseCase.AddToWatchlistUseCase
+    removeFromWatchlist *auctionUseCase.RemoveFromWatchlistUseCase
+    getWatchlist        *auctionUseCase.GetWatchlistUseCase
+    watch   *auctionUseCase.WatchAuctionUseCase
 }
 
 type bidUseCases struct {
     place   *bidUseCase.PlaceBidUseCase
     get     *bidUseCase.GetBidUseCase
     list    *bidUseCase.ListBidsUseCase
+    setMax  *bidUseCase.SetMaxBidUseCase
+}
+
+type invoiceUseCases struct {
+    get      *invoiceUseCase.GetInvoiceUseCase
+    list     *invoiceUseCase.ListInvoicesUseCase
+    download *invoiceUseCase.DownloadInvoiceUseCase
+}
+
+type withdrawalUseCases struct {
+    request *withdrawalUseCase.RequestWithdrawalUseCase
+    get     *withdrawalUseCase.GetWithdrawalUseCase
+    list    *withdrawalUseCase.ListWithdrawalsUseCase
+    approve *withdrawalUseCase.ApproveWithdrawalUseCase
+    reject  *withdrawalUseCase.RejectWithdrawalUseCase
+}
+
+type notificationUseCases struct {
+    watch       *notificationUseCase.WatchNotificationsUseCase
+    list        *notificationUseCase.ListNotificationsUseCase
+    unreadCount *notificationUseCase.GetUnreadCountUseCase
+    markRead    *notificationUseCase.MarkReadUseCase
+    markAllRead *notificationUseCase.MarkAllReadUseCase
+    getPreferences    *notificationUseCase.GetPreferencesUseCase
+    updatePreferences *notificationUseCase.UpdatePreferencesUseCase
+}
+
+type webhookUseCases struct {
+    create     *webhookUseCase.CreateWebhookUseCase
+    list       *webhookUseCase.ListWebhooksUseCase
+    delete     *webhookUseCase.DeleteWebhookUseCase
+    deliveries *webhookUseCase.ListWebhookDeliveriesUseCase
+    redeliver  *webhookUseCase.RedeliverWebhookUseCase
 }
 
 type services struct {
-    notifier notificationDomain.NotificationService
+    notifier    notificationDomain.NotificationService
+    dispatcher  *notificationDispatch.Dispatcher
+    ledger      *ledger.Service
+    settlement  *settlement.Service
+    escrow      *escrow.Service
+    payments    *settlement.PaymentProcessor
+    payouts     *payout.Processor
+    idempotency *idempotency.Service
+    bus         *eventbus.AuctionBus
+    events      *postgres.EventBridge[*entity.AuctionEvent]
+    // publisher sends auction events through events and queues their
+    // webhook deliveries.
+    publisher   eventDomain.Publisher
+    webhooks    *webhook.Deliverer
+    digests     *digest.Service
+    notificationBus *eventbus.NotificationBus
+    notifications   *postgres.EventBridge[*notificationDomain.Notification]
+    rates       exchangeDomain.ExchangeRateProvider
+    invoices    invoiceDomain.Renderer
 }
 
-func initServices() *services {
-    return &services{
-        notifier: notificationInfra.NewMockNotificationAdapter(),
+func initServices(cfg *config.Config, db *sql.DB, repos *repositories) (*services, error) {
+    rates := exchangeInfra.NewStaticRateProvider("USD", exchangeInfra.DefaultRates)
+    if cfg.Exchange.RatesFile != "" {
+        var err error
+        rates, err = exchangeInfra.LoadStaticRateProvider(cfg.Exchange.RatesFile)
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    var fees entity.FeeSchedule
+    if cfg.Fees.ScheduleFile != "" {
+        var err error
+        fees, err = feeInfra.LoadSchedule(cfg.Fees.ScheduleFile)
+        if err != nil {
+            return nil, err
+        }
     }
+
+    invoices := invoiceInfra.NewTemplateRenderer()
+    if cfg.Invoice.TemplateDir != "" || cfg.Invoice.FontFile != "" {
+        var err error
+        invoices, err = invoiceInfra.LoadTemplateRenderer(cfg.Invoice.TemplateDir, cfg.Invoice.FontFile)
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    bus := eventbus.NewAuctionBus(cfg.Events.SubscriberBuffer, cfg.Events.ReplayBuffer)
+    notificationBus := eventbus.NewNotificationBus(cfg.Events.SubscriberBuffer, cfg.Events.ReplayBuffer)
+    notifications := postgres.NewNotificationBridge(db, cfg.Database.GetDSN(), notificationBus)
+    events := postgres.NewAuctionEventBridge(db, cfg.Database.GetDSN(), bus)
+    ledgerService := ledger.NewService(repos.ledgerRepo, repos.walletRepo, repos.txManager)
+    escrowService := escrow.NewService(repos.holdRepo, repos.bidRepo, repos.userRepo, repos.walletRepo)
+    email := notificationInfra.NewMockNotificationAdapter()
+    if cfg.Email.SMTPHost != "" {
+        templates, err := notificationInfra.LoadEmailTemplates(cfg.Email.TemplateDir, cfg.Email.Locale)
+        if err != nil {
+            return nil, err
+        }
+        mailer, err := notificationInfra.NewSMTPMailer(notificationInfra.SMTPConfig{
+            Host:     cfg.Email.SMTPHost,
+            Port:     cfg.Email.SMTPPort,
+            Username: cfg.Email.SMTPUsername,
+            Password: cfg.Email.SMTPPassword,
+            From:     cfg.Email.From,
+            TLS:      cfg.Email.SMTPTLS,
+        })
+        if err != nil {
+            return nil, err
+        }
+        email = notificationInfra.NewEmailNotificationAdapter(repos.userRepo, templates, mailer)
+    }
+    // Each notification goes to the channels its user chose for its type.
+    notifier := notificationInfra.NewRoutingNotificationAdapter(repos.notificationPreferenceRepo, map[notificationDomain.Channel]notificationDomain.NotificationService{
+        notificationDomain.ChannelInApp:   notificationInfra.NewInboxNotificationAdapter(repos.notificationRepo, notifications),
+        notificationDomain.ChannelEmail:   email,
+        notificationDomain.ChannelWebhook: notificationInfra.NewWebhookNotificationAdapter(repos.webhookDeliveryRepo),
+    })
+    dispatcher := notificationDispatch.NewDispatcher(notifier, cfg.Notifications.QueueSize, cfg.Notifications.Workers, cfg.Notifications.Timeout)
+    return &services{
+        notifier:    notifier,
+        dispatcher:  dispatcher,
+        ledger:      ledgerService,
+        settlement:  settlement.NewService(repos.lotRepo, repos.winnerRepo, repos.holdRepo, repos.paymentRepo, escrowService, fees,
+            settlement.NewInvoiceIssuer(repos.invoiceRepo, repos.userRepo, cfg.Invoice.TaxPercent)),
+        payments:    settlement.NewPaymentProcessor(
+            repos.paymentRepo,
+            repos.auctionRepo,
+            repos.lotRepo,
+            repos.holdRepo,
+            repos.walletRepo,
+            ledgerService,
+            paymentInfra.NewMockPaymentAdapter(),
+            notifier,
+            repos.txManager,
+            settlement.RetryPolicy{
+                MaxAttempts: cfg.Payment.MaxAttempts,
+                Backoff:     cfg.Payment.RetryBackoff,
+                MaxBackoff:  cfg.Payment.MaxRetryBackoff,
+            },
+        ),
+        payouts:     payout.NewProcessor(repos.withdrawalRepo, ledgerService, payoutInfra.NewLocalPayoutProvider(), notifier, repos.txManager),
+        idempotency: idempotency.NewService(repos.idempotencyRepo, cfg.Idempotency.KeyTTL),
+        bus:         bus,
+        events:      events,
+        publisher:   webhook.NewPublisher(events, repos.webhookDeliveryRepo),
+        webhooks:    webhook.NewDeliverer(
+            repos.webhookRepo,
+            repos.webhookDeliveryRepo,
+            webhookInfra.NewHTTPSender(cfg.Webhooks.Timeout, cfg.Webhooks.AllowPrivateNetworks),
+            repos.txManager,
+            webhook.RetryPolicy{
+                MaxAttempts: cfg.Webhooks.MaxAttempts,
+                Backoff:     cfg.Webhooks.RetryBackoff,
+                MaxBackoff:  cfg.Webhooks.MaxRetryBackoff,
+            },
+        ),
+        digests:     digest.NewService(
+            repos.notificationPreferenceRepo,
+            repos.watchlistRepo,
+            repos.digestRepo,
+            repos.bidRepo,
+            dispatcher,
+            repos.txManager,
+            cfg.Digest.Hour,
+        ),
+        notificationBus: notificationBus,
+        notifications:   notifications,
+        escrow:      escrowService,
+        rates:       rates,
+        invoices:    invoices,
+    }, nil
 }
 
-func initUseCases(repos *repositories) *useCases {
+func initUseCases(cfg *config.Config, repos *repositories, services *services) *useCases {
     return &useCases{
         user: &userUseCases{
-            create:        userUseCase.NewCreateUserUseCase(repos.userRepo),
-            get:          userUseCase.NewGetUserUseCase(repos.userRepo),
+            create:        userUseCase.NewCreateUserUseCase(repos.userRepo, repos.txManager, services.ledger),
+            get:          userUseCase.NewGetUserUseCase(repos.userRepo, repos.walletRepo, repos.holdRepo),
             update:       userUseCase.NewUpdateUserUseCase(repos.userRepo),
             delete:       userUseCase.NewDeleteUserUseCase(repos.userRepo),
-            getAll:       userUseCase.NewGetAllUserUseCase(repos.userRepo),
-            updateBalance: userUseCase.NewUpdateBalanceUseCase(repos.userRepo),
+            getAll:       userUseCase.NewGetAllUserUseCase(repos.userRepo, repos.walletRepo, repos.holdRepo),
+            updateBalance: userUseCase.NewUpdateBalanceUseCase(repos.userRepo, repos.txManager, services.ledger),
+            transactions:  userUseCase.NewListTransactionsUseCase(repos.userRepo, repos.ledgerRepo),
         },
         lot: &lotUseCases{
             create:  lotUseCase.NewCreateLotUseCase(repos.lotRepo),
@@ -150,19 +402,55 @@ func initUseCases(repos *repositories) *useCases {
         },
         auction: &auctionUseCases{
             create:  auctionUseCase.NewCreateAuctionUseCase(repos.auctionRepo, repos.lotRepo),
-            get:     auctionUseCase.NewGetAuctionUseCase(repos.auctionRepo),
+            get:     auctionUseCase.NewGetAuctionUseCase(repos.auctionRepo, repos.winnerRepo, repos.paymentRepo),
             update:  auctionUseCase.NewUpdateAuctionUseCase(repos.auctionRepo),
-            list:    auctionUseCase.NewListAuctionsUseCase(repos.auctionRepo),
+            list:    auctionUseCase.NewListAuctionsUseCase(repos.auctionRepo, services.rates),
+            buyNow:  auctionUseCase.NewBuyNowUseCase(repos.auctionRepo, repos.bidRepo, repos.lotRepo, repos.txManager, services.escrow, services.settlement, services.publisher, services.dispatcher),
+            accept:  auctionUseCase.NewAcceptPriceUseCase(repos.auctionRepo, repos.bidRepo, repos.lotRepo, repos.txManager, services.escrow, services.settlement, services.publisher, services.dispatcher),
+            settlement: auctionUseCase.NewGetSettlementUseCase(repos.auctionRepo, repos.lotRepo, repos.paymentRepo),
+            addToWatchlist:      auctionUseCase.NewAddToWatchlistUseCase(repos.userRepo, repos.auctionRepo, repos.watchlistRepo),
+            removeFromWatchlist: auctionUseCase.NewRemoveFromWatchlistUseCase(repos.watchlistRepo),
+            getWatchlist:        auctionUseCase.NewGetWatchlistUseCase(repos.userRepo, repos.watchlistRepo),
+            watch:   auctionUseCase.NewWatchAuctionUseCase(repos.auctionRepo, services.bus),
         },
         bid: &bidUseCases{
-            place:   bidUseCase.NewPlaceBidUseCase(repos.bidRepo, repos.auctionRepo, repos.userRepo),
-            get:     bidUseCase.NewGetBidUseCase(repos.bidRepo),
-            list:    bidUseCase.NewListBidsUseCase(repos.bidRepo),
+            place:   bidUseCase.NewPlaceBidUseCase(repos.bidRepo, repos.auctionRepo, repos.maxBidRepo, repos.lotRepo, repos.txManager, services.escrow, services.publisher, services.dispatcher, cfg.Auction.BuyNowDisablePercent),
+            get:     bidUseCase.NewGetBidUseCase(repos.bidRepo, repos.auctionRepo),
+            list:    bidUseCase.NewListBidsUseCase(repos.bidRepo, repos.auctionRepo),
+            setMax:  bidUseCase.NewSetMaxBidUseCase(repos.bidRepo, repos.maxBidRepo, repos.auctionRepo, repos.lotRepo, repos.txManager, services.escrow, services.publisher, services.dispatcher, cfg.Auction.BuyNowDisablePercent),
+        },
+        invoice: &invoiceUseCases{
+            get:      invoiceUseCase.NewGetInvoiceUseCase(repos.invoiceRepo),
+            list:     invoiceUseCase.NewListInvoicesUseCase(repos.userRepo, repos.invoiceRepo),
+            download: invoiceUseCase.NewDownloadInvoiceUseCase(repos.invoiceRepo, services.invoices),
+        },
+        withdrawal: &withdrawalUseCases{
+            request: withdrawalUseCase.NewRequestWithdrawalUseCase(repos.userRepo, repos.walletRepo, repos.holdRepo, repos.withdrawalRepo, repos.txManager, services.ledger),
+            get:     withdrawalUseCase.NewGetWithdrawalUseCase(repos.userRepo, repos.withdrawalRepo),
+            list:    withdrawalUseCase.NewListWithdrawalsUseCase(repos.userRepo, repos.withdrawalRepo),
+            approve: withdrawalUseCase.NewApproveWithdrawalUseCase(repos.userRepo, repos.withdrawalRepo, repos.txManager),
+            reject:  withdrawalUseCase.NewRejectWithdrawalUseCase(repos.userRepo, repos.withdrawalRepo, repos.txManager, services.ledger),
+        },
+        notification: &notificationUseCases{
+            watch:       notificationUseCase.NewWatchNotificationsUseCase(repos.userRepo, services.notificationBus),
+            list:        notificationUseCase.NewListNotificationsUseCase(repos.userRepo, repos.notificationRepo),
+            unreadCount: notificationUseCase.NewGetUnreadCountUseCase(repos.userRepo, repos.notificationRepo),
+            markRead:    notificationUseCase.NewMarkReadUseCase(repos.userRepo, repos.notificationRepo),
+            markAllRead: notificationUseCase.NewMarkAllReadUseCase(repos.userRepo, repos.notificationRepo),
+            getPreferences:    notificationUseCase.NewGetPreferencesUseCase(repos.userRepo, repos.notificationPreferenceRepo),
+            updatePreferences: notificationUseCase.NewUpdatePreferencesUseCase(repos.userRepo, repos.notificationPreferenceRepo),
+        },
+        webhook: &webhookUseCases{
+            create:     webhookUseCase.NewCreateWebhookUseCase(repos.userRepo, repos.webhookRepo, cfg.Webhooks.AllowPrivateNetworks),
+            list:       webhookUseCase.NewListWebhooksUseCase(repos.userRepo, repos.webhookRepo),
+            delete:     webhookUseCase.NewDeleteWebhookUseCase(repos.webhookRepo),
+            deliveries: webhookUseCase.NewListWebhookDeliveriesUseCase(repos.webhookRepo, repos.webhookDeliveryRepo),
+            redeliver:  webhookUseCase.NewRedeliverWebhookUseCase(repos.webhookRepo, repos.webhookDeliveryRepo, repos.txManager),
         },
     }
 }
 
-func initHandlers(uc *useCases) *handler.Handlers {
+func initHandlers(cfg *config.Config, uc *useCases, services *services) *handler.Handlers {
     userHandler := handler.NewUserHandler(
         uc.user.create,
         uc.user.get,
@@ -170,6 +458,7 @@ func initHandlers(uc *useCases) *handler.Handlers {
         uc.user.delete,
         uc.user.getAll,
         uc.user.updateBalance,
+        uc.user.transactions,
     )
 
     lotHandler := handler.NewLotHandler(
@@ -185,23 +474,90 @@ func initHandlers(uc *useCases) *handler.Handlers {
         uc.auction.get,
         uc.auction.update,
         uc.auction.list,
+        uc.auction.buyNow,
+        uc.auction.accept,
+        uc.auction.settlement,
+        uc.auction.addToWatchlist,
+        uc.auction.removeFromWatchlist,
+        uc.auction.getWatchlist,
+        uc.auction.watch,
     )
 
     bidHandler := handler.NewBidHandler(
         uc.bid.place,
         uc.bid.get,
         uc.bid.list,
+        uc.bid.setMax,
+    )
+
+    invoiceHandler := handler.NewInvoiceHandler(
+        uc.invoice.get,
+        uc.invoice.list,
+        uc.invoice.download,
+    )
+
+    withdrawalHandler := handler.NewWithdrawalHandler(
+        uc.withdrawal.request,
+        uc.withdrawal.get,
+        uc.withdrawal.list,
+        uc.withdrawal.approve,
+        uc.withdrawal.reject,
+    )
+
+    notificationHandler := handler.NewNotificationHandler(
+        uc.notification.list,
+        uc.notification.unreadCount,
+        uc.notification.markRead,
+        uc.notification.markAllRead,
+        uc.notification.getPreferences,
+        uc.notification.updatePreferences,
+    )
+
+    webhookHandler := handler.NewWebhookHandler(
+        uc.webhook.create,
+        uc.webhook.list,
+        uc.webhook.delete,
+        uc.webhook.deliveries,
+        uc.webhook.redeliver,
+    )
+
+    eventStreamHandler := handler.NewEventStreamHandler(
+        uc.auction.watch,
+        uc.notification.watch,
+        cfg.Events.Heartbeat,
+        cfg.Events.AllowedOrigins,
+        cfg.Events.UnauthenticatedNotifications,
+    )
+
+    idempotencyInterceptor := handler.NewIdempotencyInterceptor(
+        services.idempotency,
+        api.BidService_PlaceBid_FullMethodName,
+        api.UserService_UpdateBalance_FullMethodName,
+    )
+
+    adminAuthInterceptor := handler.NewAdminAuthInterceptor(
+        cfg.Admin.Tokens,
+        api.WithdrawalService_ApproveWithdrawal_FullMethodName,
+        api.WithdrawalService_RejectWithdrawal_FullMethodName,
     )
 
-    return handler.NewHandlers(userHandler, auctionHandler, lotHandler, bidHandler)
+    return handler.NewHandlers(userHandler, auctionHandler, lotHandler, bidHandler, invoiceHandler, withdrawalHandler, notificationHandler, webhookHandler, eventStreamHandler, idempotencyInterceptor, adminAuthInterceptor)
 }
 
 func initWorker(repos *repositories, services *services) *worker.Worker {
     return worker.NewWorker(
         repos.auctionRepo,
         repos.lotRepo,
-        repos.userRepo,
         repos.bidRepo,
+        repos.txManager,
+        services.settlement,
+        services.payments,
+        services.payouts,
+        services.idempotency,
+        services.webhooks,
+        services.digests,
         services.notifier,
+        services.dispatcher,
+        services.publisher,
     )
 }
@@ -0,0 +1,22 @@
+// Package auth carries the authenticated caller of a request from the
+// transport to the use cases.
+package auth
+
+import (
+    "context"
+)
+
+type userKey struct{}
+
+// WithUser returns a context for a request that userID was authenticated
+// as.
+func WithUser(ctx context.Context, userID int64) context.Context {
+    return context.WithValue(ctx, userKey{}, userID)
+}
+
+// UserID returns who the request was authenticated as. Only requests to
+// methods that require authentication carry a user.
+func UserID(ctx context.Context) (int64, bool) {
+    userID, ok := ctx.Value(userKey{}).(int64)
+    return userID, ok
+}
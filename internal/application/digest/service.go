@@ -0,0 +1,172 @@
+package digest
+
+import (
+    "context"
+    "fmt"
+    "log"
+    "time"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/repository"
+)
+
+// Service compiles the daily and weekly digests and sends them through the
+// notification pipeline. A digest is due at hour o'clock on the first day
+// of its period in the user's time zone: every day for daily digests, and
+// on Mondays for weekly ones. Every digest is recorded, and the record
+// committed, before it is handed to the dispatcher, so a user never gets the
+// same one twice, even from several instances.
+type Service struct {
+    preferenceRepo repository.NotificationPreferenceRepository
+    watchlistRepo  repository.WatchlistRepository
+    digestRepo     repository.DigestRepository
+    bidRepo        repository.BidRepository
+    notifications  *notifier.Dispatcher
+    txManager      repository.TxManager
+    hour           int
+}
+
+func NewService(
+    preferenceRepo repository.NotificationPreferenceRepository,
+    watchlistRepo repository.WatchlistRepository,
+    digestRepo repository.DigestRepository,
+    bidRepo repository.BidRepository,
+    notifications *notifier.Dispatcher,
+    txManager repository.TxManager,
+    hour int,
+) *Service {
+    return &Service{
+        preferenceRepo: preferenceRepo,
+        watchlistRepo:  watchlistRepo,
+        digestRepo:     digestRepo,
+        bidRepo:        bidRepo,
+        notifications:  notifications,
+        txManager:      txManager,
+        hour:           hour,
+    }
+}
+
+// SendDue sends every digest that is due at now and has not been sent.
+func (s *Service) SendDue(ctx context.Context, now time.Time) error {
+    users, err := s.preferenceRepo.GetWithDigest(ctx)
+    if err != nil {
+        return err
+    }
+
+    for _, preferences := range users {
+        if err := s.send(ctx, preferences, now); err != nil {
+            log.Printf("Error sending digest to user %d: %v", preferences.UserID, err)
+        }
+    }
+    return nil
+}
+
+// periodStart returns the midnight, in loc, that starts the period of
+// frequency now falls in.
+func periodStart(frequency notification.DigestFrequency, now time.Time, loc *time.Location) time.Time {
+    local := now.In(loc)
+    day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+    if frequency == notification.DigestWeekly {
+        day = day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+    }
+    return day
+}
+
+// send records the user's digest if it is due and, once the record is
+// committed, dispatches it. A digest with nothing in it is recorded but not
+// sent. A digest that fails to go out is not sent again: it may already
+// have reached some of the user's channels.
+func (s *Service) send(ctx context.Context, preferences *notification.Preferences, now time.Time) error {
+    loc := preferences.Location()
+    start := periodStart(preferences.Digest, now, loc)
+    due := time.Date(start.Year(), start.Month(), start.Day(), s.hour, 0, 0, 0, loc)
+    if now.Before(due) {
+        return nil
+    }
+
+    var report *notification.DigestReport
+    err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        report = nil
+
+        claimed, err := s.digestRepo.Claim(ctx, preferences.UserID, preferences.Digest, start)
+        if err != nil {
+            return err
+        }
+        if !claimed {
+            return nil
+        }
+        report, err = s.compile(ctx, preferences, start, now)
+        return err
+    })
+    if err != nil {
+        return err
+    }
+    if report == nil || report.Empty() {
+        return nil
+    }
+
+    s.notifications.Dispatch(fmt.Sprintf("%s digest for user %d", report.Frequency, report.UserID), func(ctx context.Context, service notification.NotificationService) error {
+        return service.NotifyDigest(ctx, report)
+    })
+    return nil
+}
+
+func (s *Service) compile(ctx context.Context, preferences *notification.Preferences, start, now time.Time) (*notification.DigestReport, error) {
+    userID := preferences.UserID
+    length := preferences.Digest.Length()
+    loc := preferences.Location()
+    report := &notification.DigestReport{
+        UserID:      userID,
+        Frequency:   preferences.Digest,
+        PeriodStart: start,
+    }
+
+    watched, err := s.watchlistRepo.GetByUserID(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+    for _, auction := range watched {
+        running := auction.Status == entity.AuctionStatusPending || auction.Status == entity.AuctionStatusActive
+        if running && auction.EndTime.After(now) && !auction.EndTime.After(now.Add(length)) {
+            report.EndingSoon = append(report.EndingSoon, inLocation(auction, loc))
+        }
+    }
+
+    bidOn, err := s.digestRepo.GetBidAuctions(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+    for _, auction := range bidOn {
+        highest, err := s.bidRepo.GetHighestByAuctionID(ctx, auction.ID)
+        if err != nil {
+            return nil, err
+        }
+        if highest != nil && highest.UserID == userID {
+            report.Leading = append(report.Leading, inLocation(auction, loc))
+        } else {
+            report.Outbid = append(report.Outbid, inLocation(auction, loc))
+        }
+    }
+
+    if len(preferences.FollowedCategories) > 0 {
+        lots, err := s.digestRepo.GetNewLots(ctx, preferences.FollowedCategories, now.Add(-length))
+        if err != nil {
+            return nil, err
+        }
+        for _, lot := range lots {
+            if lot.CreatorID != userID {
+                report.NewLots = append(report.NewLots, lot)
+            }
+        }
+    }
+    return report, nil
+}
+
+// inLocation returns a copy of auction with its end time in loc, so that
+// the digest shows it in the user's time zone.
+func inLocation(auction *entity.Auction, loc *time.Location) *entity.Auction {
+    local := *auction
+    local.EndTime = auction.EndTime.In(loc)
+    return &local
+}
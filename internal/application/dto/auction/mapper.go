@@ -6,27 +6,157 @@ func FromEntity(auction *entity.Auction) *AuctionResponse {
     return &AuctionResponse{
         ID:           auction.ID,
         LotID:        auction.LotID,
+        Type:         auction.Type,
+        Currency:     auction.Currency,
         StartPrice:   auction.StartPrice,
         MinStep:      auction.MinStep,
         CurrentPrice: auction.CurrentPrice,
+        ReserveMet:   reserveMet(auction),
+        BuyNowPrice:  auction.BuyNowPrice,
+        BuyNowAvailable: auction.BuyNowAvailable(),
         StartTime:    auction.StartTime,
         EndTime:      auction.EndTime,
         Status:       auction.Status,
         WinnerID:     auction.WinnerID,
         WinnerBidID:  auction.WinnerBidID,
+        ExtensionWindow:   auction.ExtensionWindow,
+        ExtensionDuration: auction.ExtensionDuration,
+        MaxExtension:      auction.MaxExtension,
+        TotalExtension:    auction.TotalExtension,
+        PriceDecrement:    auction.PriceDecrement,
+        DecrementInterval: auction.DecrementInterval,
+        FloorPrice:        auction.FloorPrice,
+        Quantity:          auction.Quantity,
+        Pricing:           auction.Pricing,
+        Winners:           fromWinnerEntities(auction.Winners),
+        Fees:              fromFeesPtr(auction.Fees),
         CreatedAt:    auction.CreatedAt,
         UpdatedAt:    auction.UpdatedAt,
     }
 }
 
+// reserveMet is omitted for multi-unit auctions with a reserve: their
+// current price is the lowest winning bid, which says nothing about whether
+// units sell above the reserve. The closer allocates them and sets the
+// RESERVE_NOT_MET status when none do.
+func reserveMet(auction *entity.Auction) *bool {
+    if auction.IsMultiUnit() && auction.HasReserve() {
+        return nil
+    }
+    met := auction.ReserveMet()
+    return &met
+}
+
 func ToEntity(req *CreateAuctionRequest) *entity.Auction {
     return &entity.Auction{
         LotID:        req.LotID,
+        Type:         req.Type,
         StartPrice:   req.StartPrice,
+        ReservePrice: req.ReservePrice,
+        BuyNowPrice:  req.BuyNowPrice,
         MinStep:      req.MinStep,
         CurrentPrice: req.StartPrice,
         StartTime:    req.StartTime,
         EndTime:      req.EndTime,
         Status:       entity.AuctionStatusPending,
+        ExtensionWindow:   req.ExtensionWindow,
+        ExtensionDuration: req.ExtensionDuration,
+        MaxExtension:      req.MaxExtension,
+        PriceDecrement:    req.PriceDecrement,
+        DecrementInterval: req.DecrementInterval,
+        FloorPrice:        req.FloorPrice,
+        Pricing:           req.Pricing,
+    }
+}
+
+func fromWinnerEntities(winners []*entity.AuctionWinner) []WinnerResponse {
+    if len(winners) == 0 {
+        return nil
+    }
+    result := make([]WinnerResponse, len(winners))
+    for i, w := range winners {
+        result[i] = WinnerResponse{
+            UserID:    w.UserID,
+            BidID:     w.BidID,
+            Quantity:  w.Quantity,
+            UnitPrice: w.UnitPrice,
+        }
+    }
+    return result
+}
+
+func FromFees(fees entity.Fees) FeesResponse {
+    return FeesResponse{
+        Hammer:         fees.Hammer,
+        Commission:     fees.Commission,
+        BuyerPremium:   fees.BuyerPremium,
+        BuyerTotal:     fees.BuyerTotal(),
+        SellerProceeds: fees.SellerProceeds(),
+    }
+}
+
+func fromFeesPtr(fees *entity.Fees) *FeesResponse {
+    if fees == nil {
+        return nil
+    }
+    resp := FromFees(*fees)
+    return &resp
+}
+
+// ToSettlementResponse builds the seller's view of auction from its
+// payments.
+func ToSettlementResponse(auction *entity.Auction, sellerID int64, payments []*entity.Payment) *SettlementResponse {
+    resp := &SettlementResponse{
+        AuctionID: auction.ID,
+        SellerID:  sellerID,
+        Status:    auction.Status,
+        Currency:  auction.Currency,
+        PaidOut:   entity.NewMoney(0, auction.Currency),
+        Payments:  make([]SettlementPaymentResponse, len(payments)),
+    }
+    totals := entity.Fees{
+        Hammer:       entity.NewMoney(0, auction.Currency),
+        Commission:   entity.NewMoney(0, auction.Currency),
+        BuyerPremium: entity.NewMoney(0, auction.Currency),
+    }
+    for i, p := range payments {
+        totals = totals.Add(p.Fees)
+        if p.Status == entity.PaymentStatusCaptured {
+            resp.PaidOut = resp.PaidOut.Add(p.SellerProceeds())
+        }
+        resp.Payments[i] = SettlementPaymentResponse{
+            ID:      p.ID,
+            BuyerID: p.UserID,
+            Status:  p.Status,
+            Fees:    FromFees(p.Fees),
+        }
+    }
+    resp.Totals = FromFees(totals)
+    return resp
+}
+
+func FromEvent(e *entity.AuctionEvent) *AuctionEventResponse {
+    resp := &AuctionEventResponse{
+        ID:           e.ID,
+        AuctionID:    e.AuctionID,
+        Type:         e.Type,
+        CurrentPrice: e.CurrentPrice,
+        EndTime:      e.EndTime,
+        Status:       e.Status,
+        WinnerID:     e.WinnerID,
+        OccurredAt:   e.OccurredAt,
+    }
+    if e.Bid != nil {
+        resp.Bid = &BidResponse{
+            ID:        e.Bid.ID,
+            AuctionID: e.Bid.AuctionID,
+            UserID:    e.Bid.UserID,
+            Amount:    e.Bid.Amount,
+            Quantity:  e.Bid.Quantity,
+            IsAuto:    e.Bid.IsAuto,
+            CreatedAt: e.Bid.CreatedAt,
+            UpdatedAt: e.Bid.UpdatedAt,
+        }
     }
+    return resp
 }
@@ -1,19 +1,52 @@
 package auction
 
-import "time"
+import (
+    "time"
+    "auction-system/internal/domain/entity"
+)
 
 type CreateAuctionRequest struct {
     LotID       int64     `json:"lot_id" validate:"required,gt=0"`
-    StartPrice  float64   `json:"start_price" validate:"required,gt=0"`
-    MinStep     float64   `json:"min_step" validate:"required,gt=0"`
+    Type        entity.AuctionType `json:"type"`
+    StartPrice  entity.Money `json:"start_price" validate:"required"`
+    ReservePrice entity.Money `json:"reserve_price"`
+    BuyNowPrice entity.Money `json:"buy_now_price"`
+    MinStep     entity.Money `json:"min_step" validate:"required"`
     StartTime   time.Time `json:"start_time" validate:"required"`
     EndTime     time.Time `json:"end_time" validate:"required,gtfield=StartTime"`
+    ExtensionWindow   time.Duration `json:"extension_window" validate:"gte=0"`
+    ExtensionDuration time.Duration `json:"extension_duration" validate:"gte=0"`
+    MaxExtension      time.Duration `json:"max_extension" validate:"gte=0"`
+    PriceDecrement    entity.Money `json:"price_decrement"`
+    DecrementInterval time.Duration `json:"decrement_interval" validate:"gte=0"`
+    FloorPrice        entity.Money `json:"floor_price"`
+    Pricing           entity.MultiUnitPricing `json:"pricing"`
 }
 
 type UpdateAuctionRequest struct {
-    StartPrice *float64
-    MinStep    *float64
+    StartPrice *entity.Money
+    MinStep    *entity.Money
     StartTime  *time.Time
     EndTime    *time.Time
     Status     *string
 }
+
+type BuyNowRequest struct {
+    AuctionID int64 `json:"auction_id" validate:"required,gt=0"`
+    UserID    int64 `json:"user_id" validate:"required,gt=0"`
+}
+
+type GetSettlementRequest struct {
+    AuctionID int64 `json:"auction_id" validate:"required,gt=0"`
+    SellerID  int64 `json:"seller_id" validate:"required,gt=0"`
+}
+
+type AcceptPriceRequest struct {
+    AuctionID int64 `json:"auction_id" validate:"required,gt=0"`
+    UserID    int64 `json:"user_id" validate:"required,gt=0"`
+}
+
+type WatchlistRequest struct {
+    UserID    int64 `json:"user_id" validate:"required,gt=0"`
+    AuctionID int64 `json:"auction_id" validate:"required,gt=0"`
+}
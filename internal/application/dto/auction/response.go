@@ -8,28 +8,103 @@ import (
 type AuctionResponse struct {
     ID           int64             `json:"id"`
     LotID        int64             `json:"lot_id"`
-    StartPrice   float64           `json:"start_price"`
-    MinStep      float64           `json:"min_step"`
-    CurrentPrice float64           `json:"current_price"`
+    Type         entity.AuctionType `json:"type"`
+    Currency     string            `json:"currency"`
+    StartPrice   entity.Money `json:"start_price"`
+    MinStep      entity.Money `json:"min_step"`
+    CurrentPrice entity.Money `json:"current_price"`
+    // ReserveMet is nil when it cannot be told; see reserveMet.
+    ReserveMet   *bool             `json:"reserve_met,omitempty"`
+    BuyNowPrice  entity.Money `json:"buy_now_price,omitempty"`
+    BuyNowAvailable bool           `json:"buy_now_available"`
     StartTime    time.Time         `json:"start_time"`
     EndTime      time.Time         `json:"end_time"`
     Status       entity.AuctionStatus `json:"status"`
     WinnerID     *int64            `json:"winner_id,omitempty"`
     WinnerBidID  *int64            `json:"winner_bid_id,omitempty"`
+    ExtensionWindow   time.Duration `json:"extension_window"`
+    ExtensionDuration time.Duration `json:"extension_duration"`
+    MaxExtension      time.Duration `json:"max_extension"`
+    TotalExtension    time.Duration `json:"total_extension"`
+    PriceDecrement    entity.Money `json:"price_decrement,omitempty"`
+    DecrementInterval time.Duration `json:"decrement_interval,omitempty"`
+    FloorPrice        entity.Money `json:"floor_price,omitempty"`
+    Quantity          int           `json:"quantity"`
+    Pricing           entity.MultiUnitPricing `json:"pricing"`
+    Winners           []WinnerResponse `json:"winners,omitempty"`
+    // Fees is set once the auction is sold.
+    Fees              *FeesResponse `json:"fees,omitempty"`
+    // DisplayCurrentPrice and DisplayBuyNowPrice are converted to the
+    // currency requested by the caller, for display only.
+    DisplayCurrentPrice *entity.Money `json:"display_current_price,omitempty"`
+    DisplayBuyNowPrice  *entity.Money `json:"display_buy_now_price,omitempty"`
     CreatedAt    time.Time         `json:"created_at"`
     UpdatedAt    time.Time         `json:"updated_at"`
 }
 
+type WinnerResponse struct {
+    UserID    int64   `json:"user_id"`
+    BidID     int64   `json:"bid_id"`
+    Quantity  int     `json:"quantity"`
+    UnitPrice entity.Money `json:"unit_price"`
+}
+
+type FeesResponse struct {
+    Hammer         entity.Money `json:"hammer"`
+    Commission     entity.Money `json:"commission"`
+    BuyerPremium   entity.Money `json:"buyer_premium"`
+    BuyerTotal     entity.Money `json:"buyer_total"`
+    SellerProceeds entity.Money `json:"seller_proceeds"`
+}
+
+// SettlementResponse is the seller's view of a sold auction.
+type SettlementResponse struct {
+    AuctionID int64                `json:"auction_id"`
+    SellerID  int64                `json:"seller_id"`
+    Status    entity.AuctionStatus `json:"status"`
+    Currency  string               `json:"currency"`
+    Totals    FeesResponse         `json:"totals"`
+    // PaidOut is the seller's proceeds from captured payments so far.
+    PaidOut   entity.Money         `json:"paid_out"`
+    Payments  []SettlementPaymentResponse `json:"payments"`
+}
+
+type SettlementPaymentResponse struct {
+    ID      int64                `json:"id"`
+    BuyerID int64                `json:"buyer_id"`
+    Status  entity.PaymentStatus `json:"status"`
+    Fees    FeesResponse         `json:"fees"`
+}
+
 type BidResponse struct {
     ID        int64
     AuctionID int64
     UserID    int64
-    Amount    float64
+    Amount    entity.Money
+    Quantity  int
+    IsAuto    bool
     CreatedAt time.Time
     UpdatedAt time.Time
 }
 
+// AuctionEventResponse is an event streamed to auction watchers.
+type AuctionEventResponse struct {
+    ID           int64                   `json:"id"`
+    AuctionID    int64                   `json:"auction_id"`
+    Type         entity.AuctionEventType `json:"type"`
+    Bid          *BidResponse            `json:"bid,omitempty"`
+    CurrentPrice entity.Money            `json:"current_price"`
+    EndTime      time.Time               `json:"end_time"`
+    Status       entity.AuctionStatus    `json:"status"`
+    WinnerID     *int64                  `json:"winner_id,omitempty"`
+    OccurredAt   time.Time               `json:"occurred_at"`
+}
+
 type ListAuctionsResponse struct {
     Auctions   []AuctionResponse `json:"auctions"`
     TotalCount int64            `json:"total_count"`
 }
+
+type WatchlistResponse struct {
+    Auctions []AuctionResponse `json:"auctions"`
+}
@@ -9,6 +9,7 @@ func ToEntity(req *PlaceBidRequest) *entity.Bid {
         AuctionID: req.AuctionID,
         UserID:    req.UserID,
         Amount:    req.Amount,
+        Quantity:  max(req.Quantity, 1),
     }
 }
 
@@ -21,6 +22,8 @@ func FromEntity(bid *entity.Bid) *BidResponse {
         AuctionID: bid.AuctionID,
         UserID:    bid.UserID,
         Amount:    bid.Amount,
+        Quantity:  bid.Quantity,
+        IsAuto:    bid.IsAuto,
         CreatedAt: bid.CreatedAt,
         UpdatedAt: bid.UpdatedAt,
     }
@@ -33,3 +36,25 @@ func FromEntityList(bids []*entity.Bid) []BidResponse {
     }
     return result
 }
+
+func ToMaxBidEntity(req *SetMaxBidRequest) *entity.MaxBid {
+    return &entity.MaxBid{
+        AuctionID: req.AuctionID,
+        UserID:    req.UserID,
+        MaxAmount: req.MaxAmount,
+    }
+}
+
+func FromMaxBidEntity(maxBid *entity.MaxBid) *MaxBidResponse {
+    if maxBid == nil {
+        return nil
+    }
+    return &MaxBidResponse{
+        ID:        maxBid.ID,
+        AuctionID: maxBid.AuctionID,
+        UserID:    maxBid.UserID,
+        MaxAmount: maxBid.MaxAmount,
+        CreatedAt: maxBid.CreatedAt,
+        UpdatedAt: maxBid.UpdatedAt,
+    }
+}
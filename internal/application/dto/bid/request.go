@@ -1,13 +1,22 @@
 package bid
 
+import "auction-system/internal/domain/entity"
+
 type PlaceBidRequest struct {
     AuctionID int64   `json:"auction_id"`
     UserID    int64   `json:"user_id"`
-    Amount    float64 `json:"amount"`
+    Amount    entity.Money `json:"amount"`
+    Quantity  int     `json:"quantity"`
 }
 
 type ListBidsRequest struct {
     AuctionID  int64 `json:"auction_id"`
     PageSize   int   `json:"page_size"`
     PageNumber int   `json:"page_number"`
-}
\ No newline at end of file
+}
+
+type SetMaxBidRequest struct {
+    AuctionID int64   `json:"auction_id"`
+    UserID    int64   `json:"user_id"`
+    MaxAmount entity.Money `json:"max_amount"`
+}
@@ -1,12 +1,17 @@
 package bid
 
-import "time"
+import (
+    "time"
+    "auction-system/internal/domain/entity"
+)
 
 type BidResponse struct {
     ID        int64     `json:"id"`
     AuctionID int64     `json:"auction_id"`
     UserID    int64     `json:"user_id"`
-    Amount    float64   `json:"amount"`
+    Amount    entity.Money `json:"amount"`
+    Quantity  int       `json:"quantity"`
+    IsAuto    bool      `json:"is_auto"`
     CreatedAt time.Time `json:"created_at"`
     UpdatedAt time.Time `json:"updated_at"`
 }
@@ -15,3 +20,14 @@ type ListBidsResponse struct {
     Bids       []BidResponse `json:"bids"`
     TotalCount int64         `json:"total_count"`
 }
+
+type MaxBidResponse struct {
+    ID           int64     `json:"id"`
+    AuctionID    int64     `json:"auction_id"`
+    UserID       int64     `json:"user_id"`
+    MaxAmount    entity.Money `json:"max_amount"`
+    CurrentPrice entity.Money `json:"current_price"`
+    IsLeading    bool      `json:"is_leading"`
+    CreatedAt    time.Time `json:"created_at"`
+    UpdatedAt    time.Time `json:"updated_at"`
+}
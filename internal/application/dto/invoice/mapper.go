@@ -0,0 +1,50 @@
+package invoice
+
+import (
+    "auction-system/internal/domain/entity"
+    domainInvoice "auction-system/internal/domain/invoice"
+)
+
+func ToInvoiceResponse(i *entity.Invoice) *InvoiceResponse {
+    return &InvoiceResponse{
+        ID:           i.ID,
+        Number:       i.Number,
+        AuctionID:    i.AuctionID,
+        PaymentID:    i.PaymentID,
+        LotID:        i.LotID,
+        LotTitle:     i.LotTitle,
+        BuyerID:      i.BuyerID,
+        BuyerName:    i.BuyerName,
+        BuyerEmail:   i.BuyerEmail,
+        SellerID:     i.SellerID,
+        SellerName:   i.SellerName,
+        SellerEmail:  i.SellerEmail,
+        Currency:     i.Total.Currency,
+        Hammer:       i.Hammer,
+        BuyerPremium: i.BuyerPremium,
+        Commission:   i.Commission,
+        TaxRate:      i.TaxRate,
+        Tax:          i.Tax,
+        Total:        i.Total,
+        IssuedAt:     i.IssuedAt,
+    }
+}
+
+func ToListInvoicesResponse(invoices []*entity.Invoice, total int64) *ListInvoicesResponse {
+    response := &ListInvoicesResponse{
+        Invoices:   make([]InvoiceResponse, len(invoices)),
+        TotalCount: total,
+    }
+    for i, invoice := range invoices {
+        response.Invoices[i] = *ToInvoiceResponse(invoice)
+    }
+    return response
+}
+
+func ToDocumentResponse(d *domainInvoice.Document) *DocumentResponse {
+    return &DocumentResponse{
+        ContentType: d.ContentType,
+        Filename:    d.Filename,
+        Content:     d.Content,
+    }
+}
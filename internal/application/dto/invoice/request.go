@@ -0,0 +1,21 @@
+package invoice
+
+// Invoices are visible to their buyer and seller only; UserID is the user
+// asking.
+type GetInvoiceRequest struct {
+    InvoiceID int64 `json:"invoice_id"`
+    UserID    int64 `json:"user_id"`
+}
+
+type ListInvoicesRequest struct {
+    UserID     int64 `json:"user_id"`
+    PageSize   int   `json:"page_size"`
+    PageNumber int   `json:"page_number"`
+}
+
+type DownloadInvoiceRequest struct {
+    InvoiceID int64  `json:"invoice_id"`
+    UserID    int64  `json:"user_id"`
+    // Format is PDF, TEXT or HTML, in any case; it defaults to PDF.
+    Format    string `json:"format"`
+}
@@ -0,0 +1,41 @@
+package invoice
+
+import (
+    "time"
+    "auction-system/internal/domain/entity"
+)
+
+type InvoiceResponse struct {
+    ID           int64        `json:"id"`
+    Number       string       `json:"number"`
+    AuctionID    int64        `json:"auction_id"`
+    PaymentID    int64        `json:"payment_id"`
+    LotID        int64        `json:"lot_id"`
+    LotTitle     string       `json:"lot_title"`
+    BuyerID      int64        `json:"buyer_id"`
+    BuyerName    string       `json:"buyer_name"`
+    BuyerEmail   string       `json:"buyer_email"`
+    SellerID     int64        `json:"seller_id"`
+    SellerName   string       `json:"seller_name"`
+    SellerEmail  string       `json:"seller_email"`
+    Currency     string       `json:"currency"`
+    Hammer       entity.Money `json:"hammer"`
+    BuyerPremium entity.Money `json:"buyer_premium"`
+    Commission   entity.Money `json:"commission"`
+    TaxRate      float64      `json:"tax_rate"`
+    Tax          entity.Money `json:"tax"`
+    Total        entity.Money `json:"total"`
+    IssuedAt     time.Time    `json:"issued_at"`
+}
+
+type ListInvoicesResponse struct {
+    Invoices   []InvoiceResponse `json:"invoices"`
+    TotalCount int64             `json:"total_count"`
+}
+
+// DocumentResponse is a rendered invoice.
+type DocumentResponse struct {
+    ContentType string `json:"content_type"`
+    Filename    string `json:"filename"`
+    Content     []byte `json:"content"`
+}
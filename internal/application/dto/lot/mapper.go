@@ -9,6 +9,8 @@ func (r *CreateLotRequest) ToEntity() *entity.Lot {
         Title:       r.Title,
         Description: r.Description,
         StartPrice:  r.StartPrice,
+        Quantity:    r.Quantity,
+        Category:    r.Category,
         CreatorID:   r.CreatorID,
     }
 }
@@ -19,6 +21,9 @@ func FromEntity(lot *entity.Lot) *LotResponse {
         Title:       lot.Title,
         Description: lot.Description,
         StartPrice:  lot.StartPrice,
+        Currency:    lot.Currency,
+        Quantity:    lot.Quantity,
+        Category:    lot.Category,
         CreatorID:   lot.CreatorID,
         CreatedAt:   lot.CreatedAt,
         UpdatedAt:   lot.UpdatedAt,
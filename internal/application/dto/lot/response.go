@@ -1,12 +1,18 @@
 package lot
 
-import "time"
+import (
+    "time"
+    "auction-system/internal/domain/entity"
+)
 
 type LotResponse struct {
     ID          int64     `json:"id"`
     Title       string    `json:"title"`
     Description string    `json:"description"`
-    StartPrice  float64   `json:"start_price"`
+    StartPrice  entity.Money `json:"start_price"`
+    Currency    string    `json:"currency"`
+    Quantity    int       `json:"quantity"`
+    Category    string    `json:"category"`
     CreatorID   int64     `json:"creator_id"`
     CreatedAt   time.Time `json:"created_at"`
     UpdatedAt   time.Time `json:"updated_at"`
@@ -0,0 +1,58 @@
+package notification
+
+import (
+    "fmt"
+    "auction-system/internal/domain/notification"
+)
+
+func FromEntity(n *notification.Notification) *NotificationResponse {
+    return &NotificationResponse{
+        ID:        n.ID,
+        UserID:    n.UserID,
+        Type:      n.Type,
+        Message:   n.Message,
+        CreatedAt: n.CreatedAt,
+        ReadAt:    n.ReadAt,
+    }
+}
+
+func ToListNotificationsResponse(notifications []*notification.Notification, total, unread int64) *ListNotificationsResponse {
+    response := &ListNotificationsResponse{
+        Notifications: make([]NotificationResponse, len(notifications)),
+        TotalCount:    total,
+        UnreadCount:   unread,
+    }
+    for i, n := range notifications {
+        response.Notifications[i] = *FromEntity(n)
+    }
+    return response
+}
+
+func ToPreferencesResponse(p *notification.Preferences) *PreferencesResponse {
+    response := &PreferencesResponse{
+        UserID:    p.UserID,
+        Channels:  make([]NotificationChannels, len(notification.Types)),
+        TimeZone:  p.TimeZone,
+        Digest:    string(p.Digest),
+        FollowedCategories: append([]string{}, p.FollowedCategories...),
+        UpdatedAt: p.UpdatedAt,
+    }
+    for i, kind := range notification.Types {
+        channels := p.ChannelsFor(kind)
+        response.Channels[i] = NotificationChannels{Type: string(kind), Channels: make([]string, len(channels))}
+        for j, channel := range channels {
+            response.Channels[i].Channels[j] = string(channel)
+        }
+    }
+    if p.QuietHours != nil {
+        response.QuietHours = &QuietHours{
+            Start: formatTimeOfDay(p.QuietHours.Start),
+            End:   formatTimeOfDay(p.QuietHours.End),
+        }
+    }
+    return response
+}
+
+func formatTimeOfDay(minutes int) string {
+    return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
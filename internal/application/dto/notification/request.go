@@ -0,0 +1,33 @@
+package notification
+
+type ListNotificationsRequest struct {
+    UserID     int64 `json:"user_id"`
+    PageSize   int   `json:"page_size"`
+    PageNumber int   `json:"page_number"`
+    UnreadOnly bool  `json:"unread_only"`
+}
+
+type MarkReadRequest struct {
+    UserID int64   `json:"user_id"`
+    IDs    []int64 `json:"ids"`
+}
+
+type NotificationChannels struct {
+    Type     string   `json:"type"`
+    Channels []string `json:"channels"`
+}
+
+// QuietHours are "HH:MM" times of day.
+type QuietHours struct {
+    Start string `json:"start"`
+    End   string `json:"end"`
+}
+
+type UpdatePreferencesRequest struct {
+    UserID     int64                  `json:"user_id"`
+    Channels   []NotificationChannels `json:"channels"`
+    QuietHours *QuietHours            `json:"quiet_hours,omitempty"`
+    TimeZone   string                 `json:"time_zone"`
+    Digest     string                 `json:"digest"`
+    FollowedCategories []string           `json:"followed_categories"`
+}
@@ -0,0 +1,38 @@
+package notification
+
+import (
+    "time"
+    "auction-system/internal/domain/notification"
+)
+
+type NotificationResponse struct {
+    ID        int64                         `json:"id"`
+    UserID    int64                         `json:"user_id"`
+    Type      notification.NotificationType `json:"type"`
+    Message   string                        `json:"message"`
+    CreatedAt time.Time                     `json:"created_at"`
+    ReadAt    *time.Time                    `json:"read_at,omitempty"`
+}
+
+type ListNotificationsResponse struct {
+    Notifications []NotificationResponse `json:"notifications"`
+    TotalCount    int64                  `json:"total_count"`
+    UnreadCount   int64                  `json:"unread_count"`
+}
+
+type MarkReadResponse struct {
+    // MarkedCount is how many notifications were unread before.
+    MarkedCount int64 `json:"marked_count"`
+    UnreadCount int64 `json:"unread_count"`
+}
+
+type PreferencesResponse struct {
+    UserID     int64                  `json:"user_id"`
+    // Channels lists every notification type.
+    Channels   []NotificationChannels `json:"channels"`
+    QuietHours *QuietHours            `json:"quiet_hours,omitempty"`
+    TimeZone   string                 `json:"time_zone"`
+    Digest     string                 `json:"digest"`
+    FollowedCategories []string           `json:"followed_categories"`
+    UpdatedAt  time.Time              `json:"updated_at"`
+}
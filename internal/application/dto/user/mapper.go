@@ -8,18 +8,32 @@ func (r *CreateUserRequest) ToEntity() *entity.User {
     return &entity.User{
         Username: r.Username,
         Email:    r.Email,
-        Balance:  r.Balance,
     }
 }
 
 func FromEntity(user *entity.User) *UserResponse {
-    return &UserResponse{
+    response := &UserResponse{
         ID:        user.ID,
         Username:  user.Username,
         Email:     user.Email,
-        Balance:   user.Balance,
+        Wallets:   make([]WalletResponse, len(user.Wallets)),
         CreatedAt: user.CreatedAt,
     }
+
+    for i, wallet := range user.Wallets {
+        response.Wallets[i] = FromWallet(wallet)
+    }
+
+    return response
+}
+
+func FromWallet(wallet *entity.Wallet) WalletResponse {
+    return WalletResponse{
+        Currency:         wallet.Currency,
+        Balance:          wallet.Balance,
+        HeldBalance:      wallet.HeldBalance,
+        AvailableBalance: wallet.AvailableBalance(),
+    }
 }
 
 func ToUserListResponse(users []*entity.User, total int64) *UserListResponse {
@@ -34,3 +48,25 @@ func ToUserListResponse(users []*entity.User, total int64) *UserListResponse {
 
     return response
 }
+
+func FromStatement(lines []*entity.StatementLine, total int64, balance entity.Money) *TransactionListResponse {
+    response := &TransactionListResponse{
+        Transactions: make([]TransactionResponse, len(lines)),
+        TotalCount:   total,
+        Balance:      balance,
+    }
+
+    for i, line := range lines {
+        response.Transactions[i] = TransactionResponse{
+            ID:           line.TransactionID,
+            Kind:         string(line.Kind),
+            AuctionID:    line.AuctionID,
+            Description:  line.Description,
+            Amount:       line.Amount,
+            BalanceAfter: line.BalanceAfter,
+            CreatedAt:    line.CreatedAt,
+        }
+    }
+
+    return response
+}
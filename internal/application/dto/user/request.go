@@ -1,13 +1,16 @@
 package user
 
+import "auction-system/internal/domain/entity"
+
 type CreateUserRequest struct {
     Username string  `json:"username" validate:"required,min=3,max=50"`
     Email    string  `json:"email" validate:"required,email"`
-    Balance  float64 `json:"balance" validate:"gte=0"`
+    // Balance is an optional opening deposit, in any currency.
+    Balance  entity.Money `json:"balance"`
 }
 
 type UpdateBalanceRequest struct {
-    Amount float64 `json:"amount" validate:"required"`
+    Amount entity.Money `json:"amount" validate:"required"`
 }
 
 type UpdateUserRequest struct {
@@ -17,5 +20,13 @@ type UpdateUserRequest struct {
 
 type UpdateBalanceInput struct {
     UserID int64   `json:"user_id"`
-    Amount float64 `json:"amount"`
+    Amount entity.Money `json:"amount"`
+}
+
+type ListTransactionsRequest struct {
+    UserID     int64 `json:"user_id"`
+    PageSize   int   `json:"page_size"`
+    PageNumber int   `json:"page_number"`
+    // Currency selects the ledger account; it defaults to USD.
+    Currency   string `json:"currency"`
 }
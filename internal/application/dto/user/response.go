@@ -1,15 +1,25 @@
 package user
 
-import "time"
+import (
+    "time"
+    "auction-system/internal/domain/entity"
+)
 
 type UserResponse struct {
     ID        int64     `json:"id"`
     Username  string    `json:"username"`
     Email     string    `json:"email"`
-    Balance   float64   `json:"balance"`
+    Wallets   []WalletResponse `json:"wallets"`
     CreatedAt time.Time `json:"created_at"`
 }
 
+type WalletResponse struct {
+    Currency         string       `json:"currency"`
+    Balance          entity.Money `json:"balance"`
+    HeldBalance      entity.Money `json:"held_balance"`
+    AvailableBalance entity.Money `json:"available_balance"`
+}
+
 type UserListResponse struct {
     Users []UserResponse `json:"users"`
     Total int64          `json:"total"`
@@ -17,6 +27,23 @@ type UserListResponse struct {
 
 type BalanceResponse struct {
     UserID    int64     `json:"user_id"`
-    Balance   float64   `json:"balance"`
+    Balance   entity.Money `json:"balance"`
     UpdatedAt time.Time `json:"updated_at"`
 }
+
+type TransactionResponse struct {
+    ID           int64     `json:"id"`
+    Kind         string    `json:"kind"`
+    AuctionID    *int64    `json:"auction_id,omitempty"`
+    Description  string    `json:"description"`
+    Amount       entity.Money `json:"amount"`
+    BalanceAfter entity.Money `json:"balance_after"`
+    CreatedAt    time.Time `json:"created_at"`
+}
+
+type TransactionListResponse struct {
+    Transactions []TransactionResponse `json:"transactions"`
+    TotalCount   int64                 `json:"total_count"`
+    // Balance is derived from the ledger postings, not the cached column.
+    Balance entity.Money `json:"balance"`
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+    "auction-system/internal/domain/entity"
+)
+
+// ToWebhookResponse leaves out the webhook's secret.
+func ToWebhookResponse(w *entity.Webhook) *WebhookResponse {
+    response := &WebhookResponse{
+        ID:         w.ID,
+        UserID:     w.UserID,
+        URL:        w.URL,
+        EventTypes: make([]string, len(w.EventTypes)),
+        CreatedAt:  w.CreatedAt,
+    }
+    for i, t := range w.EventTypes {
+        response.EventTypes[i] = string(t)
+    }
+    return response
+}
+
+func ToListWebhooksResponse(webhooks []*entity.Webhook) *ListWebhooksResponse {
+    response := &ListWebhooksResponse{Webhooks: make([]WebhookResponse, len(webhooks))}
+    for i, webhook := range webhooks {
+        response.Webhooks[i] = *ToWebhookResponse(webhook)
+    }
+    return response
+}
+
+func ToWebhookDeliveryResponse(d *entity.WebhookDelivery) *WebhookDeliveryResponse {
+    return &WebhookDeliveryResponse{
+        ID:             d.ID,
+        WebhookID:      d.WebhookID,
+        EventID:        d.EventID,
+        EventType:      d.EventType,
+        Payload:        string(d.Payload),
+        Status:         string(d.Status),
+        Attempts:       d.Attempts,
+        NextAttemptAt:  d.NextAttemptAt,
+        LastStatusCode: d.LastStatusCode,
+        LastError:      d.LastError,
+        DeliveredAt:    d.DeliveredAt,
+        CreatedAt:      d.CreatedAt,
+        UpdatedAt:      d.UpdatedAt,
+    }
+}
+
+func ToListWebhookDeliveriesResponse(deliveries []*entity.WebhookDelivery, total int64) *ListWebhookDeliveriesResponse {
+    response := &ListWebhookDeliveriesResponse{
+        Deliveries: make([]WebhookDeliveryResponse, len(deliveries)),
+        TotalCount: total,
+    }
+    for i, delivery := range deliveries {
+        response.Deliveries[i] = *ToWebhookDeliveryResponse(delivery)
+    }
+    return response
+}
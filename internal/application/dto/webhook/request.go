@@ -0,0 +1,27 @@
+package webhook
+
+type CreateWebhookRequest struct {
+    UserID     int64    `json:"user_id"`
+    URL        string   `json:"url"`
+    EventTypes []string `json:"event_types"`
+    Secret     string   `json:"secret"`
+}
+
+type DeleteWebhookRequest struct {
+    UserID    int64 `json:"user_id"`
+    WebhookID int64 `json:"webhook_id"`
+}
+
+type ListWebhookDeliveriesRequest struct {
+    UserID     int64  `json:"user_id"`
+    WebhookID  int64  `json:"webhook_id"`
+    PageSize   int    `json:"page_size"`
+    PageNumber int    `json:"page_number"`
+    Status     string `json:"status"`
+}
+
+type RedeliverWebhookRequest struct {
+    UserID     int64 `json:"user_id"`
+    WebhookID  int64 `json:"webhook_id"`
+    DeliveryID int64 `json:"delivery_id"`
+}
@@ -0,0 +1,40 @@
+package webhook
+
+import (
+    "time"
+)
+
+type WebhookResponse struct {
+    ID         int64     `json:"id"`
+    UserID     int64     `json:"user_id"`
+    URL        string    `json:"url"`
+    EventTypes []string  `json:"event_types"`
+    // Secret is only returned when the webhook is created.
+    Secret     string    `json:"secret,omitempty"`
+    CreatedAt  time.Time `json:"created_at"`
+}
+
+type WebhookDeliveryResponse struct {
+    ID             int64      `json:"id"`
+    WebhookID      int64      `json:"webhook_id"`
+    EventID        int64      `json:"event_id"`
+    EventType      string     `json:"event_type"`
+    Payload        string     `json:"payload"`
+    Status         string     `json:"status"`
+    Attempts       int        `json:"attempts"`
+    NextAttemptAt  time.Time  `json:"next_attempt_at"`
+    LastStatusCode int        `json:"last_status_code"`
+    LastError      string     `json:"last_error,omitempty"`
+    DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+    CreatedAt      time.Time  `json:"created_at"`
+    UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+type ListWebhooksResponse struct {
+    Webhooks []WebhookResponse `json:"webhooks"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+    Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+    TotalCount int64                     `json:"total_count"`
+}
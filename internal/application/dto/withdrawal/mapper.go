@@ -0,0 +1,40 @@
+package withdrawal
+
+import (
+    "auction-system/internal/domain/entity"
+)
+
+func ToWithdrawalResponse(w *entity.Withdrawal) *WithdrawalResponse {
+    response := &WithdrawalResponse{
+        ID:          w.ID,
+        UserID:      w.UserID,
+        Amount:      w.Amount,
+        Destination: w.Destination,
+        Status:      string(w.Status),
+        ReviewerID:  w.ReviewerID,
+        Reference:   w.Reference,
+        Note:        w.Note,
+        CreatedAt:   w.CreatedAt,
+        UpdatedAt:   w.UpdatedAt,
+    }
+    for _, e := range w.History {
+        response.History = append(response.History, WithdrawalEventResponse{
+            Status:    string(e.Status),
+            ActorID:   e.ActorID,
+            Note:      e.Note,
+            CreatedAt: e.CreatedAt,
+        })
+    }
+    return response
+}
+
+func ToListWithdrawalsResponse(withdrawals []*entity.Withdrawal, total int64) *ListWithdrawalsResponse {
+    response := &ListWithdrawalsResponse{
+        Withdrawals: make([]WithdrawalResponse, len(withdrawals)),
+        TotalCount:  total,
+    }
+    for i, withdrawal := range withdrawals {
+        response.Withdrawals[i] = *ToWithdrawalResponse(withdrawal)
+    }
+    return response
+}
@@ -0,0 +1,35 @@
+package withdrawal
+
+import (
+    "auction-system/internal/domain/entity"
+)
+
+type RequestWithdrawalRequest struct {
+    UserID      int64        `json:"user_id"`
+    Amount      entity.Money `json:"amount"`
+    Destination string       `json:"destination"`
+}
+
+// Withdrawals are visible to their owner and to admins; UserID is the user
+// asking.
+type GetWithdrawalRequest struct {
+    WithdrawalID int64 `json:"withdrawal_id"`
+    UserID       int64 `json:"user_id"`
+}
+
+type ListWithdrawalsRequest struct {
+    UserID     int64 `json:"user_id"`
+    PageSize   int   `json:"page_size"`
+    PageNumber int   `json:"page_number"`
+}
+
+// The reviewer of a withdrawal is the admin the request was authenticated
+// as, who must not own the withdrawal.
+type ApproveWithdrawalRequest struct {
+    WithdrawalID int64 `json:"withdrawal_id"`
+}
+
+type RejectWithdrawalRequest struct {
+    WithdrawalID int64  `json:"withdrawal_id"`
+    Reason       string `json:"reason"`
+}
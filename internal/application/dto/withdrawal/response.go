@@ -0,0 +1,32 @@
+package withdrawal
+
+import (
+    "time"
+    "auction-system/internal/domain/entity"
+)
+
+type WithdrawalResponse struct {
+    ID          int64                     `json:"id"`
+    UserID      int64                     `json:"user_id"`
+    Amount      entity.Money              `json:"amount"`
+    Destination string                    `json:"destination"`
+    Status      string                    `json:"status"`
+    ReviewerID  *int64                    `json:"reviewer_id,omitempty"`
+    Reference   string                    `json:"reference,omitempty"`
+    Note        string                    `json:"note,omitempty"`
+    History     []WithdrawalEventResponse `json:"history,omitempty"`
+    CreatedAt   time.Time                 `json:"created_at"`
+    UpdatedAt   time.Time                 `json:"updated_at"`
+}
+
+type WithdrawalEventResponse struct {
+    Status    string    `json:"status"`
+    ActorID   *int64    `json:"actor_id,omitempty"`
+    Note      string    `json:"note,omitempty"`
+    CreatedAt time.Time `json:"created_at"`
+}
+
+type ListWithdrawalsResponse struct {
+    Withdrawals []WithdrawalResponse `json:"withdrawals"`
+    TotalCount  int64                `json:"total_count"`
+}
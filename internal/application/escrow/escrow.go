@@ -0,0 +1,192 @@
+package escrow
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// Service keeps fund holds in line with the bids that could still win an
+// auction, so a user cannot lead more auctions than their wallet in the
+// auction's currency covers.
+// Its methods should run inside the transaction that holds the auction lock.
+type Service struct {
+    holdRepo repository.FundHoldRepository
+    bidRepo  repository.BidRepository
+    userRepo   repository.UserRepository
+    walletRepo repository.WalletRepository
+}
+
+func NewService(
+    holdRepo repository.FundHoldRepository,
+    bidRepo repository.BidRepository,
+    userRepo repository.UserRepository,
+    walletRepo repository.WalletRepository,
+) *Service {
+    return &Service{
+        holdRepo:   holdRepo,
+        bidRepo:    bidRepo,
+        userRepo:   userRepo,
+        walletRepo: walletRepo,
+    }
+}
+
+// CheckFunds locks the user and verifies their wallet in the amount's
+// currency can commit amount to the auction. Their existing hold on the same
+// auction counts as available, since the new bid replaces it. Bids on
+// multi-unit auctions add up instead and are checked with CheckBid.
+func (s *Service) CheckFunds(ctx context.Context, userID, auctionID int64, amount entity.Money) error {
+    available, err := s.Available(ctx, userID, auctionID, amount.Currency)
+    if err != nil {
+        return err
+    }
+    if available.LessThan(amount) {
+        return errors.New(errors.ErrorTypeValidation, "insufficient funds", nil)
+    }
+    return nil
+}
+
+// CheckBid verifies the user can cover what the auction will hold from them
+// once bid is placed. On multi-unit auctions a user's bids add up: the new
+// bid is counted in full, together with the units their other bids still
+// win after it.
+func (s *Service) CheckBid(ctx context.Context, auction *entity.Auction, bid *entity.Bid) error {
+    if !auction.IsMultiUnit() {
+        return s.CheckFunds(ctx, bid.UserID, auction.ID, bid.Amount)
+    }
+
+    bids, err := s.bidRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return err
+    }
+
+    required, err := bid.Amount.MulChecked(int64(bid.Quantity))
+    if err != nil {
+        return err
+    }
+    remaining := auction.Quantity
+    placed := false
+    for _, b := range bids {
+        // The new bid ranks after existing bids of the same amount.
+        if !placed && b.Amount.LessThan(bid.Amount) {
+            remaining -= bid.Quantity
+            placed = true
+        }
+        if remaining <= 0 {
+            break
+        }
+        quantity := min(b.Quantity, remaining)
+        if b.UserID == bid.UserID {
+            required = required.Add(b.Amount.Mul(int64(quantity)))
+        }
+        remaining -= quantity
+    }
+    return s.CheckFunds(ctx, bid.UserID, auction.ID, required)
+}
+
+// Available locks the user and returns what their wallet in currency can
+// still commit to the auction: the available balance plus their current
+// hold on it.
+func (s *Service) Available(ctx context.Context, userID, auctionID int64, currency string) (entity.Money, error) {
+    if _, err := s.userRepo.GetByIDForUpdate(ctx, userID); err != nil {
+        return entity.Money{}, err
+    }
+
+    wallet, err := s.LoadWallet(ctx, userID, currency)
+    if err != nil {
+        return entity.Money{}, err
+    }
+
+    holds, err := s.holdRepo.GetByAuctionID(ctx, auctionID)
+    if err != nil {
+        return entity.Money{}, err
+    }
+    available := wallet.AvailableBalance()
+    for _, h := range holds {
+        if h.UserID == userID {
+            available = available.Add(h.Amount)
+        }
+    }
+    return available, nil
+}
+
+// LoadWallet returns the user's wallet in currency with its HeldBalance
+// filled in.
+func (s *Service) LoadWallet(ctx context.Context, userID int64, currency string) (*entity.Wallet, error) {
+    wallet, err := s.walletRepo.Get(ctx, userID, currency)
+    if err != nil {
+        return nil, err
+    }
+    wallet.HeldBalance, err = s.holdRepo.GetTotalByUserID(ctx, userID, currency)
+    if err != nil {
+        return nil, err
+    }
+    return wallet, nil
+}
+
+// Sync recomputes the holds on the auction from its current bids: users who
+// would win keep or get a hold, everyone else is released.
+func (s *Service) Sync(ctx context.Context, auction *entity.Auction) error {
+    bids, err := s.bidRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return err
+    }
+    required := requiredHolds(auction, bids)
+
+    current, err := s.holdRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return err
+    }
+    held := make(map[int64]entity.Money, len(current))
+    for _, h := range current {
+        held[h.UserID] = h.Amount
+        if _, ok := required[h.UserID]; !ok {
+            if err := s.holdRepo.Delete(ctx, auction.ID, h.UserID); err != nil {
+                return err
+            }
+        }
+    }
+
+    for userID, amount := range required {
+        if existing, ok := held[userID]; ok && existing.Cmp(amount) == 0 {
+            continue
+        }
+        hold := &entity.FundHold{UserID: userID, AuctionID: auction.ID, Amount: amount}
+        if err := s.holdRepo.Upsert(ctx, hold); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// requiredHolds returns how much each user must have held for the auction.
+// Bids must be ordered best first. Holds are taken at the bid price, which
+// is never below what the user will be charged.
+func requiredHolds(auction *entity.Auction, bids []*entity.Bid) map[int64]entity.Money {
+    holds := make(map[int64]entity.Money)
+    if len(bids) == 0 {
+        return holds
+    }
+
+    switch {
+    case auction.IsSealed():
+        // Any sealed bid may turn out to be the winner.
+        for _, b := range bids {
+            holds[b.UserID] = holds[b.UserID].Add(b.Amount)
+        }
+    case auction.IsMultiUnit():
+        remaining := auction.Quantity
+        for _, b := range bids {
+            if remaining == 0 {
+                break
+            }
+            quantity := min(b.Quantity, remaining)
+            holds[b.UserID] = holds[b.UserID].Add(b.Amount.Mul(int64(quantity)))
+            remaining -= quantity
+        }
+    default:
+        holds[bids[0].UserID] = bids[0].Amount
+    }
+    return holds
+}
@@ -0,0 +1,239 @@
+package eventbus
+
+import (
+    "context"
+    "sync"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/event"
+    "auction-system/internal/domain/notification"
+)
+
+var (
+    // ErrSlowSubscriber ends a subscription whose buffer filled up because
+    // it was not reading its events fast enough.
+    ErrSlowSubscriber = errors.New(errors.ErrorTypeConflict, "subscriber fell behind the event stream", nil)
+    // ErrClosed ends every subscription when the bus shuts down.
+    ErrClosed = errors.New(errors.ErrorTypeInternal, "event stream is shutting down", nil)
+)
+
+// AuctionBus streams auction events, one stream per auction.
+type AuctionBus = Bus[*entity.AuctionEvent]
+
+type AuctionSubscription = Subscription[*entity.AuctionEvent]
+
+// NotificationBus streams notifications, one stream per user.
+type NotificationBus = Bus[*notification.Notification]
+
+type NotificationSubscription = Subscription[*notification.Notification]
+
+func NewAuctionBus(buffer, replay int) *AuctionBus {
+    return NewBus[*entity.AuctionEvent](buffer, replay)
+}
+
+func NewNotificationBus(buffer, replay int) *NotificationBus {
+    return NewBus[*notification.Notification](buffer, replay)
+}
+
+// Bus fans events out to the subscribers in this process. Publish never
+// blocks on a subscriber: each one has a bounded buffer, and one that lets
+// it fill up is dropped with ErrSlowSubscriber rather than holding up the
+// publisher or everyone else. The most recent events are kept so that a
+// subscriber that lost its connection can resume where it left off.
+type Bus[E event.Event] struct {
+    mu          sync.Mutex
+    subscribers map[int64]map[*Subscription[E]]struct{}
+    buffer      int
+    recent      []E
+    replay      int
+    // retainedFrom is the ID after which recent holds every event, or -1
+    // before the first event, when nothing earlier is known.
+    retainedFrom int64
+    lastID       int64
+    closed       bool
+}
+
+// NewBus gives every subscriber room for buffer undelivered events and
+// keeps the last replay events for resuming subscribers.
+func NewBus[E event.Event](buffer, replay int) *Bus[E] {
+    if buffer < 1 {
+        buffer = 1
+    }
+    return &Bus[E]{
+        subscribers:  make(map[int64]map[*Subscription[E]]struct{}),
+        buffer:       buffer,
+        replay:       replay,
+        retainedFrom: -1,
+    }
+}
+
+// Subscription receives the events of one stream until it is closed.
+type Subscription[E event.Event] struct {
+    StreamID int64
+    bus      *Bus[E]
+    events   chan E
+    // after skips events the subscriber already has.
+    after    int64
+    gap      bool
+    err      error
+}
+
+// Subscribe starts receiving the events of streamID published from now on.
+// The caller must Close the subscription when done with it.
+func (b *Bus[E]) Subscribe(streamID int64) *Subscription[E] {
+    return b.Resume(streamID, 0)
+}
+
+// Resume is Subscribe for a subscriber that has already seen the events up
+// to afterID: the retained events of streamID after it are delivered first.
+// If some of them are no longer retained, Gap reports it. An afterID of 0
+// means a new subscriber.
+func (b *Bus[E]) Resume(streamID, afterID int64) *Subscription[E] {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+
+    var missed []E
+    if afterID > 0 {
+        for _, e := range b.recent {
+            if e.StreamID() == streamID && e.EventID() > afterID {
+                missed = append(missed, e)
+            }
+        }
+    }
+
+    sub := &Subscription[E]{
+        StreamID: streamID,
+        bus:      b,
+        events:   make(chan E, b.buffer+len(missed)),
+        after:    afterID,
+        gap:      afterID > 0 && (b.retainedFrom < 0 || afterID < b.retainedFrom),
+    }
+    for _, e := range missed {
+        sub.events <- e
+    }
+
+    if b.closed {
+        sub.err = ErrClosed
+        close(sub.events)
+        return sub
+    }
+    if b.subscribers[streamID] == nil {
+        b.subscribers[streamID] = make(map[*Subscription[E]]struct{})
+    }
+    b.subscribers[streamID][sub] = struct{}{}
+    return sub
+}
+
+// Publish delivers e to the subscribers of its stream. Events that arrive
+// without an ID are numbered by the bus.
+func (b *Bus[E]) Publish(ctx context.Context, e E) error {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+
+    if b.closed {
+        return nil
+    }
+
+    if e.EventID() == 0 {
+        e.SetEventID(b.lastID + 1)
+    }
+    if e.EventID() > b.lastID {
+        b.lastID = e.EventID()
+    }
+    b.retain(e)
+
+    for sub := range b.subscribers[e.StreamID()] {
+        if e.EventID() <= sub.after {
+            continue
+        }
+        select {
+        case sub.events <- e:
+        default:
+            b.drop(sub, ErrSlowSubscriber)
+        }
+    }
+    return nil
+}
+
+// retain must be called with b.mu held.
+func (b *Bus[E]) retain(e E) {
+    if b.replay < 1 {
+        b.retainedFrom = e.EventID()
+        return
+    }
+    if b.retainedFrom < 0 {
+        b.retainedFrom = e.EventID() - 1
+    }
+
+    b.recent = append(b.recent, e)
+    if len(b.recent) > b.replay {
+        b.retainedFrom = b.recent[0].EventID()
+        b.recent = b.recent[1:]
+    }
+}
+
+// Close ends every subscription with ErrClosed. Events published afterwards
+// are discarded.
+func (b *Bus[E]) Close() {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+
+    b.closed = true
+    for _, subs := range b.subscribers {
+        for sub := range subs {
+            b.drop(sub, ErrClosed)
+        }
+    }
+}
+
+// drop must be called with b.mu held.
+func (b *Bus[E]) drop(sub *Subscription[E], err error) {
+    subs, ok := b.subscribers[sub.StreamID]
+    if !ok {
+        return
+    }
+    if _, ok := subs[sub]; !ok {
+        return
+    }
+
+    delete(subs, sub)
+    if len(subs) == 0 {
+        delete(b.subscribers, sub.StreamID)
+    }
+    sub.err = err
+    close(sub.events)
+}
+
+// Subscribers returns how many subscriptions are watching streamID.
+func (b *Bus[E]) Subscribers(streamID int64) int {
+    b.mu.Lock()
+    defer b.mu.Unlock()
+    return len(b.subscribers[streamID])
+}
+
+// Events is closed when the subscription ends; Err then tells why.
+func (s *Subscription[E]) Events() <-chan E {
+    return s.events
+}
+
+// Gap reports that the subscription resumed after an event that is too old
+// to replay everything since, so the subscriber should reload the state it
+// is following.
+func (s *Subscription[E]) Gap() bool {
+    return s.gap
+}
+
+// Err returns ErrSlowSubscriber or ErrClosed once Events has been closed by
+// the bus, and nil otherwise.
+func (s *Subscription[E]) Err() error {
+    s.bus.mu.Lock()
+    defer s.bus.mu.Unlock()
+    return s.err
+}
+
+// Close stops the subscription. It is safe to call more than once.
+func (s *Subscription[E]) Close() {
+    s.bus.mu.Lock()
+    defer s.bus.mu.Unlock()
+    s.bus.drop(s, nil)
+}
@@ -0,0 +1,88 @@
+package idempotency
+
+import (
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "strings"
+    "time"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// MaxKeyLength is the longest idempotency key accepted.
+const MaxKeyLength = 255
+
+var (
+    ErrInvalidKey = errors.New(errors.ErrorTypeValidation, "idempotency key must be 1 to 255 characters", nil)
+    // ErrKeyReused means the key was first used for a different request.
+    ErrKeyReused = errors.New(errors.ErrorTypeConflict, "idempotency key was already used for a different request", nil)
+    // ErrInProgress means the first request with the key has not finished.
+    ErrInProgress = errors.New(errors.ErrorTypeConflict, "a request with this idempotency key is still in progress", nil)
+)
+
+// Service makes requests idempotent. A request that carries a key is first
+// claimed with Begin; once it succeeds its response is stored with
+// Complete, and a retry of the same request within ttl gets that response
+// back. Failed requests are released with Release so they can be retried.
+type Service struct {
+    repo repository.IdempotencyRepository
+    ttl  time.Duration
+}
+
+func NewService(repo repository.IdempotencyRepository, ttl time.Duration) *Service {
+    return &Service{
+        repo: repo,
+        ttl:  ttl,
+    }
+}
+
+// HashRequest fingerprints a serialized request.
+func HashRequest(request []byte) string {
+    sum := sha256.Sum256(request)
+    return hex.EncodeToString(sum[:])
+}
+
+// Begin claims key for a request by userID to method with the given hash.
+// It returns the stored response if the same request has already completed,
+// or nil if the caller should carry out the request. Users never see each
+// other's keys.
+func (s *Service) Begin(ctx context.Context, userID int64, key, method, requestHash string) ([]byte, error) {
+    if strings.TrimSpace(key) == "" || len(key) > MaxKeyLength {
+        return nil, ErrInvalidKey
+    }
+
+    record := &entity.IdempotencyRecord{UserID: userID, Key: key, Method: method, RequestHash: requestHash}
+    existing, err := s.repo.Claim(ctx, record, time.Now().Add(-s.ttl))
+    if err != nil {
+        return nil, err
+    }
+    if existing == nil {
+        return nil, nil
+    }
+
+    if existing.RequestHash != requestHash {
+        return nil, ErrKeyReused
+    }
+    if !existing.Completed() {
+        return nil, ErrInProgress
+    }
+    return existing.Response, nil
+}
+
+// Complete stores the response of a request claimed by Begin.
+func (s *Service) Complete(ctx context.Context, userID int64, key, method string, response []byte) error {
+    return s.repo.Complete(ctx, userID, key, method, response)
+}
+
+// Release gives up a claim after the request failed.
+func (s *Service) Release(ctx context.Context, userID int64, key, method string) error {
+    return s.repo.Release(ctx, userID, key, method)
+}
+
+// PurgeExpired deletes the records that no longer protect against retries
+// at now.
+func (s *Service) PurgeExpired(ctx context.Context, now time.Time) (int64, error) {
+    return s.repo.DeleteCreatedBefore(ctx, now.Add(-s.ttl))
+}
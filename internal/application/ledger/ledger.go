@@ -0,0 +1,178 @@
+package ledger
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// Service is the only way money moves between accounts. Each movement is
+// recorded as a balanced journal transaction in the amount's currency, and
+// the balance cached on the user's wallet in that currency is shifted by the
+// same amount within the same database transaction.
+type Service struct {
+    ledgerRepo repository.LedgerRepository
+    walletRepo repository.WalletRepository
+    txManager  repository.TxManager
+}
+
+func NewService(
+    ledgerRepo repository.LedgerRepository,
+    walletRepo repository.WalletRepository,
+    txManager repository.TxManager,
+) *Service {
+    return &Service{
+        ledgerRepo: ledgerRepo,
+        walletRepo: walletRepo,
+        txManager:  txManager,
+    }
+}
+
+// Deposit credits the user with money coming from outside the platform.
+func (s *Service) Deposit(ctx context.Context, userID int64, amount entity.Money, description string) error {
+    return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        external, err := s.ledgerRepo.GetExternalAccount(ctx, amount.Currency)
+        if err != nil {
+            return err
+        }
+        account, err := s.ledgerRepo.GetUserAccount(ctx, userID, amount.Currency)
+        if err != nil {
+            return err
+        }
+        return s.post(ctx, entity.TransactionKindDeposit, nil, description, external, account, amount)
+    })
+}
+
+// ReserveWithdrawal moves money the user asked to withdraw to the payout
+// account, where it waits for review. Callers check that the user can
+// afford it.
+func (s *Service) ReserveWithdrawal(ctx context.Context, userID int64, amount entity.Money, description string) error {
+    return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        account, err := s.ledgerRepo.GetUserAccount(ctx, userID, amount.Currency)
+        if err != nil {
+            return err
+        }
+        payout, err := s.ledgerRepo.GetPayoutAccount(ctx, amount.Currency)
+        if err != nil {
+            return err
+        }
+        return s.post(ctx, entity.TransactionKindWithdrawal, nil, description, account, payout, amount)
+    })
+}
+
+// PayOutWithdrawal records money reserved by ReserveWithdrawal leaving the
+// platform.
+func (s *Service) PayOutWithdrawal(ctx context.Context, amount entity.Money, description string) error {
+    return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        payout, err := s.ledgerRepo.GetPayoutAccount(ctx, amount.Currency)
+        if err != nil {
+            return err
+        }
+        external, err := s.ledgerRepo.GetExternalAccount(ctx, amount.Currency)
+        if err != nil {
+            return err
+        }
+        return s.post(ctx, entity.TransactionKindWithdrawal, nil, description, payout, external, amount)
+    })
+}
+
+// ReleaseWithdrawal returns money reserved by ReserveWithdrawal to the user.
+func (s *Service) ReleaseWithdrawal(ctx context.Context, userID int64, amount entity.Money, description string) error {
+    return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        payout, err := s.ledgerRepo.GetPayoutAccount(ctx, amount.Currency)
+        if err != nil {
+            return err
+        }
+        account, err := s.ledgerRepo.GetUserAccount(ctx, userID, amount.Currency)
+        if err != nil {
+            return err
+        }
+        return s.post(ctx, entity.TransactionKindRefund, nil, description, payout, account, amount)
+    })
+}
+
+// Transfer moves money between two users, e.g. from a winner to a seller.
+func (s *Service) Transfer(ctx context.Context, kind entity.TransactionKind, fromUserID, toUserID int64, amount entity.Money, auctionID *int64, description string) error {
+    return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        from, err := s.ledgerRepo.GetUserAccount(ctx, fromUserID, amount.Currency)
+        if err != nil {
+            return err
+        }
+        to, err := s.ledgerRepo.GetUserAccount(ctx, toUserID, amount.Currency)
+        if err != nil {
+            return err
+        }
+        return s.post(ctx, kind, auctionID, description, from, to, amount)
+    })
+}
+
+// ChargeFee moves a marketplace fee from the user to the platform account.
+func (s *Service) ChargeFee(ctx context.Context, userID int64, amount entity.Money, auctionID *int64, description string) error {
+    return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        account, err := s.ledgerRepo.GetUserAccount(ctx, userID, amount.Currency)
+        if err != nil {
+            return err
+        }
+        platform, err := s.ledgerRepo.GetPlatformAccount(ctx, amount.Currency)
+        if err != nil {
+            return err
+        }
+        return s.post(ctx, entity.TransactionKindFee, auctionID, description, account, platform, amount)
+    })
+}
+
+// RefundFee returns a fee charged by ChargeFee to the user.
+func (s *Service) RefundFee(ctx context.Context, userID int64, amount entity.Money, auctionID *int64, description string) error {
+    return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        platform, err := s.ledgerRepo.GetPlatformAccount(ctx, amount.Currency)
+        if err != nil {
+            return err
+        }
+        account, err := s.ledgerRepo.GetUserAccount(ctx, userID, amount.Currency)
+        if err != nil {
+            return err
+        }
+        return s.post(ctx, entity.TransactionKindRefund, auctionID, description, platform, account, amount)
+    })
+}
+
+// Balance returns the user's balance in currency derived from their postings.
+func (s *Service) Balance(ctx context.Context, userID int64, currency string) (entity.Money, error) {
+    account, err := s.ledgerRepo.GetUserAccount(ctx, userID, currency)
+    if err != nil {
+        return entity.Money{}, err
+    }
+    return s.ledgerRepo.GetBalance(ctx, account.ID)
+}
+
+func (s *Service) post(ctx context.Context, kind entity.TransactionKind, auctionID *int64, description string, debit, credit *entity.Account, amount entity.Money) error {
+    tx := &entity.LedgerTransaction{
+        Kind:        kind,
+        AuctionID:   auctionID,
+        Description: description,
+        Entries: []*entity.LedgerEntry{
+            {AccountID: debit.ID, Direction: entity.EntryDirectionDebit, Amount: amount},
+            {AccountID: credit.ID, Direction: entity.EntryDirectionCredit, Amount: amount},
+        },
+    }
+    if !tx.IsBalanced() {
+        return errors.New(errors.ErrorTypeValidation, "ledger transaction must have positive, balanced entries", nil)
+    }
+
+    if err := s.ledgerRepo.CreateTransaction(ctx, tx); err != nil {
+        return err
+    }
+
+    if debit.UserID != nil {
+        if err := s.walletRepo.Adjust(ctx, *debit.UserID, amount.Mul(-1)); err != nil {
+            return err
+        }
+    }
+    if credit.UserID != nil {
+        if err := s.walletRepo.Adjust(ctx, *credit.UserID, amount); err != nil {
+            return err
+        }
+    }
+    return nil
+}
@@ -0,0 +1,100 @@
+package notifier
+
+import (
+    "context"
+    "log"
+    "sync"
+    "time"
+    "auction-system/internal/domain/notification"
+)
+
+// Job sends one notification through service.
+type Job func(ctx context.Context, service notification.NotificationService) error
+
+// Dispatcher sends notifications in the background so that a slow or
+// failing notification channel never holds up or fails the request that
+// caused it. Jobs wait in a bounded queue; when it is full, new jobs are
+// dropped and logged rather than blocking the caller. Failed jobs are only
+// logged.
+type Dispatcher struct {
+    service notification.NotificationService
+    jobs    chan dispatchedJob
+    timeout time.Duration
+    wg      sync.WaitGroup
+
+    mu     sync.RWMutex
+    closed bool
+}
+
+type dispatchedJob struct {
+    name string
+    run  Job
+}
+
+// NewDispatcher starts workers goroutines that send up to queue pending
+// jobs, giving each one timeout to finish.
+func NewDispatcher(service notification.NotificationService, queue, workers int, timeout time.Duration) *Dispatcher {
+    if workers < 1 {
+        workers = 1
+    }
+    d := &Dispatcher{
+        service: service,
+        jobs:    make(chan dispatchedJob, queue),
+        timeout: timeout,
+    }
+    for i := 0; i < workers; i++ {
+        d.wg.Add(1)
+        go d.work()
+    }
+    return d
+}
+
+// Dispatch queues job and returns at once. name identifies the job in logs.
+// Callers dispatch only after the change being announced has committed.
+func (d *Dispatcher) Dispatch(name string, job Job) {
+    d.mu.RLock()
+    defer d.mu.RUnlock()
+
+    if d.closed {
+        log.Printf("Notification %s dropped: dispatcher is closed", name)
+        return
+    }
+    select {
+    case d.jobs <- dispatchedJob{name: name, run: job}:
+    default:
+        log.Printf("Notification %s dropped: queue is full", name)
+    }
+}
+
+// Close stops accepting jobs and waits for the queued ones to be sent.
+func (d *Dispatcher) Close() {
+    d.mu.Lock()
+    if !d.closed {
+        d.closed = true
+        close(d.jobs)
+    }
+    d.mu.Unlock()
+
+    d.wg.Wait()
+}
+
+func (d *Dispatcher) work() {
+    defer d.wg.Done()
+    for job := range d.jobs {
+        d.run(job)
+    }
+}
+
+func (d *Dispatcher) run(job dispatchedJob) {
+    ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+    defer cancel()
+
+    defer func() {
+        if r := recover(); r != nil {
+            log.Printf("Notification %s panicked: %v", job.name, r)
+        }
+    }()
+    if err := job.run(ctx, d.service); err != nil {
+        log.Printf("Error sending notification %s: %v", job.name, err)
+    }
+}
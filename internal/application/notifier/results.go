@@ -0,0 +1,73 @@
+package notifier
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/repository"
+)
+
+// AuctionResults tells the bidders of a closed auction and its seller how
+// it ended. Every winner of a sold auction hears that it won; everyone
+// else, including the winners of a sale that was called off, hears that
+// it ended. The bidders and the seller are read when the job runs, so the
+// caller dispatches it after the close has committed.
+func AuctionResults(auction *entity.Auction, bidRepo repository.BidRepository, lotRepo repository.LotRepository) Job {
+    return func(ctx context.Context, service notification.NotificationService) error {
+        bidders, err := bidRepo.GetUniqueParticipantsByAuctionID(ctx, auction.ID)
+        if err != nil {
+            return err
+        }
+        lot, err := lotRepo.GetByID(ctx, auction.LotID)
+        if err != nil {
+            return err
+        }
+
+        var winners []int64
+        if auction.Status == entity.AuctionStatusEnded {
+            for _, w := range auction.Winners {
+                winners = appendUser(winners, w.UserID)
+            }
+        }
+        var others []int64
+        for _, userID := range append(bidders, lot.CreatorID) {
+            if !containsUser(winners, userID) {
+                others = appendUser(others, userID)
+            }
+        }
+
+        if len(winners) == 0 {
+            return service.NotifyAuctionResults(ctx, auction, 0, others)
+        }
+        // The first winner's call carries everyone else, so a single-unit
+        // auction is announced in one call. One failed call does not stop
+        // the other winners from hearing.
+        var firstErr error
+        for i, winner := range winners {
+            users := []int64{winner}
+            if i == 0 {
+                users = append(users, others...)
+            }
+            if err := service.NotifyAuctionResults(ctx, auction, winner, users); err != nil && firstErr == nil {
+                firstErr = err
+            }
+        }
+        return firstErr
+    }
+}
+
+func appendUser(userIDs []int64, userID int64) []int64 {
+    if containsUser(userIDs, userID) {
+        return userIDs
+    }
+    return append(userIDs, userID)
+}
+
+func containsUser(userIDs []int64, userID int64) bool {
+    for _, id := range userIDs {
+        if id == userID {
+            return true
+        }
+    }
+    return false
+}
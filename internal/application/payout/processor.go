@@ -0,0 +1,104 @@
+package payout
+
+import (
+    "context"
+    "fmt"
+    "log"
+    "auction-system/internal/application/ledger"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    domainPayout "auction-system/internal/domain/payout"
+    "auction-system/internal/domain/repository"
+)
+
+// Processor pays out approved withdrawals through the payout provider. A
+// paid withdrawal is COMPLETED and its reserved amount leaves the platform;
+// one the provider declines is FAILED and the amount goes back to the user.
+type Processor struct {
+    withdrawalRepo repository.WithdrawalRepository
+    ledger         *ledger.Service
+    provider       domainPayout.PayoutProvider
+    notifier       notification.NotificationService
+    txManager      repository.TxManager
+}
+
+func NewProcessor(
+    withdrawalRepo repository.WithdrawalRepository,
+    ledger *ledger.Service,
+    provider domainPayout.PayoutProvider,
+    notifier notification.NotificationService,
+    txManager repository.TxManager,
+) *Processor {
+    return &Processor{
+        withdrawalRepo: withdrawalRepo,
+        ledger:         ledger,
+        provider:       provider,
+        notifier:       notifier,
+        txManager:      txManager,
+    }
+}
+
+const payoutBatchSize = 50
+
+// ProcessApproved pays out every approved withdrawal.
+func (p *Processor) ProcessApproved(ctx context.Context) error {
+    withdrawals, err := p.withdrawalRepo.GetApproved(ctx, payoutBatchSize)
+    if err != nil {
+        return err
+    }
+
+    for _, withdrawal := range withdrawals {
+        if err := p.process(ctx, withdrawal.ID); err != nil {
+            log.Printf("Error processing withdrawal %d: %v", withdrawal.ID, err)
+        }
+    }
+    return nil
+}
+
+func (p *Processor) process(ctx context.Context, withdrawalID int64) error {
+    var processed *entity.Withdrawal
+
+    err := p.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        processed = nil
+
+        withdrawal, err := p.withdrawalRepo.GetByIDForUpdate(ctx, withdrawalID)
+        if err != nil {
+            return err
+        }
+        // Another worker may have handled the withdrawal since it was
+        // listed.
+        if withdrawal.Status != entity.WithdrawalStatusApproved {
+            return nil
+        }
+
+        description := fmt.Sprintf("withdrawal %d", withdrawal.ID)
+        reference, err := p.provider.Payout(ctx, withdrawal)
+        if err != nil {
+            withdrawal.Status = entity.WithdrawalStatusFailed
+            withdrawal.Note = err.Error()
+            err = p.ledger.ReleaseWithdrawal(ctx, withdrawal.UserID, withdrawal.Amount, description+" failed")
+        } else {
+            withdrawal.Status = entity.WithdrawalStatusCompleted
+            withdrawal.Reference = reference
+            withdrawal.Note = ""
+            err = p.ledger.PayOutWithdrawal(ctx, withdrawal.Amount, description)
+        }
+        if err != nil {
+            return err
+        }
+        if err := p.withdrawalRepo.UpdateStatus(ctx, withdrawal, nil); err != nil {
+            return err
+        }
+        processed = withdrawal
+        return nil
+    })
+    if err != nil || processed == nil {
+        return err
+    }
+
+    success := processed.Status == entity.WithdrawalStatusCompleted
+    if err := p.notifier.NotifyTransactionStatus(ctx, processed.UserID, processed.Amount, success); err != nil {
+        log.Printf("Error sending payout notification for withdrawal %d: %v", processed.ID, err)
+    }
+    return nil
+}
@@ -0,0 +1,57 @@
+package settlement
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/repository"
+)
+
+// InvoiceIssuer bills winners for what they owe. Settle issues one invoice
+// per payment, numbered in the settling transaction.
+type InvoiceIssuer struct {
+    invoiceRepo repository.InvoiceRepository
+    userRepo    repository.UserRepository
+    // taxRate is the percentage of tax included in the buyer's total.
+    taxRate     float64
+}
+
+func NewInvoiceIssuer(invoiceRepo repository.InvoiceRepository, userRepo repository.UserRepository, taxRate float64) *InvoiceIssuer {
+    return &InvoiceIssuer{
+        invoiceRepo: invoiceRepo,
+        userRepo:    userRepo,
+        taxRate:     taxRate,
+    }
+}
+
+// Issue creates the invoice for payment on lot.
+func (i *InvoiceIssuer) Issue(ctx context.Context, lot *entity.Lot, payment *entity.Payment) (*entity.Invoice, error) {
+    buyer, err := i.userRepo.GetByID(ctx, payment.UserID)
+    if err != nil {
+        return nil, err
+    }
+    seller, err := i.userRepo.GetByID(ctx, payment.SellerID)
+    if err != nil {
+        return nil, err
+    }
+
+    invoice := &entity.Invoice{
+        AuctionID:   payment.AuctionID,
+        PaymentID:   payment.ID,
+        LotID:       lot.ID,
+        LotTitle:    lot.Title,
+        BuyerID:     buyer.ID,
+        BuyerName:   buyer.Username,
+        BuyerEmail:  buyer.Email,
+        SellerID:    seller.ID,
+        SellerName:  seller.Username,
+        SellerEmail: seller.Email,
+        Fees:        payment.Fees,
+        TaxRate:     i.taxRate,
+        Tax:         entity.IncludedTax(payment.Amount, i.taxRate),
+        Total:       payment.Amount,
+    }
+    if err := i.invoiceRepo.Create(ctx, invoice); err != nil {
+        return nil, err
+    }
+    return invoice, nil
+}
@@ -0,0 +1,396 @@
+package settlement
+
+import (
+    "context"
+    "fmt"
+    "log"
+    "time"
+    "auction-system/internal/application/ledger"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/payment"
+    "auction-system/internal/domain/repository"
+)
+
+// RetryPolicy spaces out attempts to capture a payment. The delay doubles
+// after every failure, starting at Backoff and capped at MaxBackoff.
+type RetryPolicy struct {
+    MaxAttempts int
+    Backoff     time.Duration
+    MaxBackoff  time.Duration
+}
+
+func (p RetryPolicy) delay(attempts int) time.Duration {
+    d := p.Backoff
+    for i := 1; i < attempts && d < p.MaxBackoff; i++ {
+        d *= 2
+    }
+    return min(d, p.MaxBackoff)
+}
+
+// PaymentProcessor collects the pending payments opened by Settle through
+// the payment provider. A captured payment moves the hammer price from the
+// winner to the seller in the ledger and the commission and buyer premium to
+// the platform; once every payment of an auction is captured the auction is
+// COMPLETED. When a payment runs out of attempts, the other
+// payments of the auction are called off and the captured ones refunded.
+type PaymentProcessor struct {
+    paymentRepo repository.PaymentRepository
+    auctionRepo repository.AuctionRepository
+    lotRepo     repository.LotRepository
+    holdRepo    repository.FundHoldRepository
+    walletRepo  repository.WalletRepository
+    ledger      *ledger.Service
+    gateway     payment.PaymentService
+    notifier    notification.NotificationService
+    txManager   repository.TxManager
+    policy      RetryPolicy
+}
+
+func NewPaymentProcessor(
+    paymentRepo repository.PaymentRepository,
+    auctionRepo repository.AuctionRepository,
+    lotRepo repository.LotRepository,
+    holdRepo repository.FundHoldRepository,
+    walletRepo repository.WalletRepository,
+    ledger *ledger.Service,
+    gateway payment.PaymentService,
+    notifier notification.NotificationService,
+    txManager repository.TxManager,
+    policy RetryPolicy,
+) *PaymentProcessor {
+    return &PaymentProcessor{
+        paymentRepo: paymentRepo,
+        auctionRepo: auctionRepo,
+        lotRepo:     lotRepo,
+        holdRepo:    holdRepo,
+        walletRepo:  walletRepo,
+        ledger:      ledger,
+        gateway:     gateway,
+        notifier:    notifier,
+        txManager:   txManager,
+        policy:      policy,
+    }
+}
+
+const paymentBatchSize = 50
+
+// ProcessDue attempts every payment that is due at now.
+func (p *PaymentProcessor) ProcessDue(ctx context.Context, now time.Time) error {
+    payments, err := p.paymentRepo.GetDue(ctx, now, paymentBatchSize)
+    if err != nil {
+        return err
+    }
+
+    for _, payment := range payments {
+        if err := p.process(ctx, payment.AuctionID, payment.ID, now); err != nil {
+            log.Printf("Error processing payment %d: %v", payment.ID, err)
+        }
+    }
+    return nil
+}
+
+// outcome is a transaction status to report once the changes are committed.
+type outcome struct {
+    payment *entity.Payment
+    success bool
+}
+
+// result collects what a transaction decided: the statuses to report and
+// the payments to refund once it commits.
+type result struct {
+    outcomes []outcome
+    refunds  []int64
+}
+
+// process moves a due payment one step on. The provider is never called
+// inside a database transaction: the charge or refund is first committed as
+// CHARGING or REFUNDING, then the provider is called with the payment's
+// idempotency key, and its answer is recorded in a second transaction. A
+// payment whose answer was never recorded is resumed once its lease runs
+// out and repeats the call under the same key.
+func (p *PaymentProcessor) process(ctx context.Context, auctionID, paymentID int64, now time.Time) error {
+    var claimed *entity.Payment
+    refunds, err := p.within(ctx, auctionID, paymentID, func(ctx context.Context, auction *entity.Auction, payment *entity.Payment, r *result) error {
+        claimed = nil
+        // Another worker may have handled the payment since it was listed.
+        if payment.NextAttemptAt.After(now) {
+            return nil
+        }
+
+        switch payment.Status {
+        case entity.PaymentStatusPending:
+            payment.Attempts++
+            payment.Status = entity.PaymentStatusCharging
+        case entity.PaymentStatusCharging, entity.PaymentStatusRefunding:
+        default:
+            return nil
+        }
+
+        // The payment is leased to this attempt until its answer is
+        // recorded.
+        payment.NextAttemptAt = now.Add(p.policy.Backoff)
+        if err := p.paymentRepo.Update(ctx, payment); err != nil {
+            return err
+        }
+        claimed = payment
+        return nil
+    })
+    if err != nil {
+        return err
+    }
+
+    if claimed != nil {
+        var more []int64
+        switch claimed.Status {
+        case entity.PaymentStatusCharging:
+            more, err = p.charge(ctx, claimed, now)
+        case entity.PaymentStatusRefunding:
+            err = p.refund(ctx, claimed)
+        }
+        if err != nil {
+            return err
+        }
+        refunds = append(refunds, more...)
+    }
+
+    for _, id := range refunds {
+        if err := p.process(ctx, auctionID, id, now); err != nil {
+            log.Printf("Error refunding payment %d: %v", id, err)
+        }
+    }
+    return nil
+}
+
+// within locks the auction and the payment, runs fn in one transaction and
+// reports the collected statuses once it commits. It returns the payments
+// fn decided to refund.
+func (p *PaymentProcessor) within(
+    ctx context.Context,
+    auctionID, paymentID int64,
+    fn func(ctx context.Context, auction *entity.Auction, payment *entity.Payment, r *result) error,
+) ([]int64, error) {
+    var r result
+    err := p.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        r = result{}
+
+        // The auction is locked before the payment, in the same order as
+        // the closer, so the two never wait on each other.
+        auction, err := p.auctionRepo.GetByIDForUpdate(ctx, auctionID)
+        if err != nil {
+            return err
+        }
+        payment, err := p.paymentRepo.GetByIDForUpdate(ctx, paymentID)
+        if err != nil {
+            return err
+        }
+        return fn(ctx, auction, payment, &r)
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    for _, o := range r.outcomes {
+        if err := p.notifier.NotifyTransactionStatus(ctx, o.payment.UserID, o.payment.Amount, o.success); err != nil {
+            log.Printf("Error sending payment notification for payment %d: %v", o.payment.ID, err)
+        }
+    }
+    return r.refunds, nil
+}
+
+// charge takes a CHARGING payment from the winner and records the answer.
+// If the booking fails after the provider accepted, the payment stays
+// CHARGING and the next attempt repeats the charge under the same key, which
+// the provider does not carry out twice.
+func (p *PaymentProcessor) charge(ctx context.Context, claimed *entity.Payment, now time.Time) ([]int64, error) {
+    chargeErr := p.gateway.ProcessPayment(ctx, claimed.ChargeKey(), claimed.UserID, claimed.Amount)
+
+    return p.within(ctx, claimed.AuctionID, claimed.ID, func(ctx context.Context, auction *entity.Auction, payment *entity.Payment, r *result) error {
+        // Another worker may have recorded this attempt already.
+        if payment.Status != entity.PaymentStatusCharging || payment.Attempts != claimed.Attempts {
+            return nil
+        }
+        if chargeErr != nil {
+            return p.fail(ctx, auction, payment, chargeErr, now, r)
+        }
+
+        if auction.Status == entity.AuctionStatusPaymentFailed {
+            // The sale was called off while the charge was under way, so
+            // the money goes straight back without being booked.
+            payment.Status = entity.PaymentStatusRefunding
+            payment.NextAttemptAt = now
+            payment.LastError = "the auction was called off"
+            r.refunds = append(r.refunds, payment.ID)
+            return p.paymentRepo.Update(ctx, payment)
+        }
+
+        if err := p.capture(ctx, auction, payment); err != nil {
+            return err
+        }
+        r.outcomes = append(r.outcomes, outcome{payment: payment, success: true})
+        return nil
+    })
+}
+
+// fail records a declined attempt. The payment is retried after a backoff
+// or, once it runs out of attempts, fails and calls off the sale.
+func (p *PaymentProcessor) fail(ctx context.Context, auction *entity.Auction, payment *entity.Payment, cause error, now time.Time, r *result) error {
+    calledOff := auction.Status == entity.AuctionStatusPaymentFailed
+    payment.LastError = cause.Error()
+    if payment.Attempts < p.policy.MaxAttempts && !calledOff {
+        payment.Status = entity.PaymentStatusPending
+        payment.NextAttemptAt = now.Add(p.policy.delay(payment.Attempts))
+        return p.paymentRepo.Update(ctx, payment)
+    }
+
+    payment.Status = entity.PaymentStatusFailed
+    if err := p.paymentRepo.Update(ctx, payment); err != nil {
+        return err
+    }
+    r.outcomes = append(r.outcomes, outcome{payment: payment})
+    if calledOff {
+        return nil
+    }
+    return p.callOff(ctx, auction, payment.ID, now, r)
+}
+
+// refund returns a REFUNDING payment to the winner and records the answer.
+// A refund the provider rejects stays REFUNDING and is asked for again under
+// the same key once its lease runs out.
+func (p *PaymentProcessor) refund(ctx context.Context, claimed *entity.Payment) error {
+    refundErr := p.gateway.RefundPayment(ctx, claimed.RefundKey(), claimed.UserID, claimed.Amount)
+    if refundErr != nil {
+        return refundErr
+    }
+
+    _, err := p.within(ctx, claimed.AuctionID, claimed.ID, func(ctx context.Context, auction *entity.Auction, payment *entity.Payment, r *result) error {
+        if payment.Status != entity.PaymentStatusRefunding {
+            return nil
+        }
+        payment.Status = entity.PaymentStatusRefunded
+        if err := p.paymentRepo.Update(ctx, payment); err != nil {
+            return err
+        }
+        r.outcomes = append(r.outcomes, outcome{payment: payment})
+        return nil
+    })
+    return err
+}
+
+// capture books a payment the provider has accepted and completes the
+// auction once nothing is left to collect.
+func (p *PaymentProcessor) capture(ctx context.Context, auction *entity.Auction, payment *entity.Payment) error {
+    lot, err := p.lotRepo.GetByID(ctx, auction.LotID)
+    if err != nil {
+        return err
+    }
+
+    err = p.ledger.Transfer(ctx, entity.TransactionKindSettlement, payment.UserID, payment.SellerID, payment.Hammer, &auction.ID, fmt.Sprintf("lot %q", lot.Title))
+    if err != nil {
+        return err
+    }
+    if payment.Commission.IsPositive() {
+        err := p.ledger.ChargeFee(ctx, payment.SellerID, payment.Commission, &auction.ID, fmt.Sprintf("commission on lot %q", lot.Title))
+        if err != nil {
+            return err
+        }
+    }
+    if payment.BuyerPremium.IsPositive() {
+        err := p.ledger.ChargeFee(ctx, payment.UserID, payment.BuyerPremium, &auction.ID, fmt.Sprintf("buyer premium on lot %q", lot.Title))
+        if err != nil {
+            return err
+        }
+    }
+    if err := p.holdRepo.Delete(ctx, auction.ID, payment.UserID); err != nil {
+        return err
+    }
+
+    payment.Status = entity.PaymentStatusCaptured
+    payment.LastError = ""
+    if err := p.paymentRepo.Update(ctx, payment); err != nil {
+        return err
+    }
+
+    payments, err := p.paymentRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return err
+    }
+    for _, other := range payments {
+        if other.Status != entity.PaymentStatusCaptured {
+            return nil
+        }
+    }
+    if auction.Status != entity.AuctionStatusEnded {
+        return nil
+    }
+    return p.auctionRepo.UpdateStatus(ctx, auction.ID, entity.AuctionStatusCompleted)
+}
+
+// callOff compensates for a payment that failed for good: the auction's
+// other pending payments fail with it, the postings of captured ones are
+// reversed and their refunds queued, and the remaining holds are released.
+// A captured payment whose seller can no longer return the proceeds stays
+// CAPTURED with the error recorded, for manual follow-up. Payments still
+// CHARGING are refunded when their answer is recorded.
+func (p *PaymentProcessor) callOff(ctx context.Context, auction *entity.Auction, failedID int64, now time.Time, r *result) error {
+    payments, err := p.paymentRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return err
+    }
+
+    reason := fmt.Sprintf("payment %d for the auction failed", failedID)
+    for _, other := range payments {
+        switch other.Status {
+        case entity.PaymentStatusPending:
+            other.Status = entity.PaymentStatusFailed
+            other.LastError = reason
+        case entity.PaymentStatusCaptured:
+            seller, err := p.walletRepo.Get(ctx, other.SellerID, other.Amount.Currency)
+            if err != nil {
+                return err
+            }
+            if seller.Balance.LessThan(other.SellerProceeds()) {
+                other.LastError = "refund failed: the seller's balance does not cover the proceeds"
+                break
+            }
+            if err := p.reverse(ctx, auction.ID, other, reason); err != nil {
+                return err
+            }
+            other.Status = entity.PaymentStatusRefunding
+            other.NextAttemptAt = now
+            other.LastError = reason
+            r.refunds = append(r.refunds, other.ID)
+        default:
+            continue
+        }
+        if err := p.paymentRepo.Update(ctx, other); err != nil {
+            return err
+        }
+    }
+
+    if err := p.holdRepo.DeleteByAuctionID(ctx, auction.ID); err != nil {
+        return err
+    }
+    return p.auctionRepo.UpdateStatus(ctx, auction.ID, entity.AuctionStatusPaymentFailed)
+}
+
+// reverse undoes the postings of a captured payment: the platform returns
+// both fees and the seller returns the hammer price. The commission goes
+// back first, so the seller only needs to cover the proceeds.
+func (p *PaymentProcessor) reverse(ctx context.Context, auctionID int64, payment *entity.Payment, reason string) error {
+    if payment.Commission.IsPositive() {
+        if err := p.ledger.RefundFee(ctx, payment.SellerID, payment.Commission, &auctionID, reason); err != nil {
+            return err
+        }
+    }
+    err := p.ledger.Transfer(ctx, entity.TransactionKindRefund, payment.SellerID, payment.UserID, payment.Hammer, &auctionID, reason)
+    if err != nil {
+        return err
+    }
+    if payment.BuyerPremium.IsPositive() {
+        if err := p.ledger.RefundFee(ctx, payment.UserID, payment.BuyerPremium, &auctionID, reason); err != nil {
+            return err
+        }
+    }
+    return nil
+}
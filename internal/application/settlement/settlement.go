@@ -0,0 +1,180 @@
+package settlement
+
+import (
+    "context"
+    "fmt"
+    "time"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/repository"
+)
+
+// Service records the outcome of a sold auction: the winners and a pending
+// payment per winning user, priced by the fee schedule, which the
+// PaymentProcessor later collects, and an invoice for each payment. It is
+// used both when an auction closes and when a lot is bought outright, and
+// should run inside the transaction that holds the auction lock.
+type Service struct {
+    lotRepo     repository.LotRepository
+    winnerRepo  repository.AuctionWinnerRepository
+    holdRepo    repository.FundHoldRepository
+    paymentRepo repository.PaymentRepository
+    escrow      *escrow.Service
+    fees        entity.FeeSchedule
+    invoices    *InvoiceIssuer
+}
+
+func NewService(
+    lotRepo repository.LotRepository,
+    winnerRepo repository.AuctionWinnerRepository,
+    holdRepo repository.FundHoldRepository,
+    paymentRepo repository.PaymentRepository,
+    escrow *escrow.Service,
+    fees entity.FeeSchedule,
+    invoices *InvoiceIssuer,
+) *Service {
+    return &Service{
+        lotRepo:     lotRepo,
+        winnerRepo:  winnerRepo,
+        holdRepo:    holdRepo,
+        paymentRepo: paymentRepo,
+        escrow:      escrow,
+        fees:        fees,
+        invoices:    invoices,
+    }
+}
+
+// Settle records the winners, opens a pending payment for each winning user
+// with the fees on their hammer total, invoices it, and keeps their funds held for
+// exactly what they owe until the payment is captured; everyone else's holds
+// are released. Winners must be ordered best
+// first and must not be empty. The auction is marked ENDED in memory;
+// persisting it is left to the caller.
+//
+// The hold grows from the winning bids to the buyer total, premium included,
+// so it has to fit the winner's funds like any bid. If it does not, that
+// payment fails at once, the sale is called off and the auction is marked
+// PAYMENT_FAILED instead.
+func (s *Service) Settle(ctx context.Context, auction *entity.Auction, winners []*entity.AuctionWinner) error {
+    lot, err := s.lotRepo.GetByID(ctx, auction.LotID)
+    if err != nil {
+        return err
+    }
+
+    hammer := make(map[int64]entity.Money)
+    var payers []int64
+    for _, w := range winners {
+        if err := s.winnerRepo.Create(ctx, w); err != nil {
+            return err
+        }
+        if _, ok := hammer[w.UserID]; !ok {
+            payers = append(payers, w.UserID)
+        }
+        hammer[w.UserID] = hammer[w.UserID].Add(w.Total())
+    }
+
+    now := time.Now()
+    owed := make(map[int64]entity.Money, len(payers))
+    var total entity.Fees
+    payments := make([]*entity.Payment, 0, len(payers))
+    var short *entity.Payment
+    for _, userID := range payers {
+        fees := s.fees.Evaluate(lot.Category, hammer[userID])
+        total = total.Add(fees)
+        owed[userID] = fees.BuyerTotal()
+        payment := &entity.Payment{
+            AuctionID:     auction.ID,
+            UserID:        userID,
+            SellerID:      lot.CreatorID,
+            Amount:        owed[userID],
+            Fees:          fees,
+            Status:        entity.PaymentStatusPending,
+            NextAttemptAt: now,
+        }
+        payments = append(payments, payment)
+
+        available, err := s.escrow.Available(ctx, userID, auction.ID, payment.Amount.Currency)
+        if err != nil {
+            return err
+        }
+        if short == nil && available.LessThan(payment.Amount) {
+            short = payment
+        }
+    }
+
+    // WinnerID and WinnerBidID keep pointing at the best bid so single-unit
+    // clients keep working; Winners holds the full allocation.
+    auction.Status = entity.AuctionStatusEnded
+    auction.CurrentPrice = winners[len(winners)-1].UnitPrice
+    auction.WinnerID = &winners[0].UserID
+    auction.WinnerBidID = &winners[0].BidID
+    auction.Winners = winners
+    auction.Fees = &total
+
+    if short != nil {
+        return s.callOff(ctx, auction, payments, short)
+    }
+    for _, payment := range payments {
+        if err := s.paymentRepo.Create(ctx, payment); err != nil {
+            return err
+        }
+        if _, err := s.invoices.Issue(ctx, lot, payment); err != nil {
+            return err
+        }
+    }
+    return s.holdFor(ctx, auction.ID, owed)
+}
+
+// callOff records the payments of a sale whose winner short cannot cover
+// what they owe: short fails for insufficient funds, the others fail with
+// it, and every hold on the auction is released.
+func (s *Service) callOff(ctx context.Context, auction *entity.Auction, payments []*entity.Payment, short *entity.Payment) error {
+    short.Status = entity.PaymentStatusFailed
+    short.LastError = "insufficient funds"
+    if err := s.paymentRepo.Create(ctx, short); err != nil {
+        return err
+    }
+
+    reason := fmt.Sprintf("payment %d for the auction failed", short.ID)
+    for _, payment := range payments {
+        if payment == short {
+            continue
+        }
+        payment.Status = entity.PaymentStatusFailed
+        payment.LastError = reason
+        if err := s.paymentRepo.Create(ctx, payment); err != nil {
+            return err
+        }
+    }
+
+    auction.Status = entity.AuctionStatusPaymentFailed
+    return s.holdRepo.DeleteByAuctionID(ctx, auction.ID)
+}
+
+// holdFor leaves the auction holding exactly the owed amounts.
+func (s *Service) holdFor(ctx context.Context, auctionID int64, owed map[int64]entity.Money) error {
+    holds, err := s.holdRepo.GetByAuctionID(ctx, auctionID)
+    if err != nil {
+        return err
+    }
+    for _, h := range holds {
+        if _, ok := owed[h.UserID]; !ok {
+            if err := s.holdRepo.Delete(ctx, auctionID, h.UserID); err != nil {
+                return err
+            }
+        }
+    }
+    for userID, amount := range owed {
+        hold := &entity.FundHold{UserID: userID, AuctionID: auctionID, Amount: amount}
+        if err := s.holdRepo.Upsert(ctx, hold); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// ReleaseHolds drops every fund hold on the auction. The closer calls it
+// when an auction ends without a sale.
+func (s *Service) ReleaseHolds(ctx context.Context, auctionID int64) error {
+    return s.holdRepo.DeleteByAuctionID(ctx, auctionID)
+}
@@ -0,0 +1,86 @@
+package auction
+
+import (
+    "context"
+    "time"
+    "auction-system/internal/application/dto/auction"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/application/settlement"
+    "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/event"
+)
+
+type AcceptPriceUseCase struct {
+    auctionRepo repository.AuctionRepository
+    txManager   repository.TxManager
+    purchaser   *purchaser
+}
+
+func NewAcceptPriceUseCase(
+    auctionRepo repository.AuctionRepository,
+    bidRepo repository.BidRepository,
+    lotRepo repository.LotRepository,
+    txManager repository.TxManager,
+    escrow *escrow.Service,
+    settlement *settlement.Service,
+    events event.Publisher,
+    notifications *notifier.Dispatcher,
+) *AcceptPriceUseCase {
+    return &AcceptPriceUseCase{
+        auctionRepo: auctionRepo,
+        txManager:   txManager,
+        purchaser: &purchaser{
+            auctionRepo:   auctionRepo,
+            bidRepo:       bidRepo,
+            lotRepo:       lotRepo,
+            escrow:        escrow,
+            settlement:    settlement,
+            events:        events,
+            notifications: notifications,
+        },
+    }
+}
+
+// Execute accepts the current asking price of a Dutch auction. The first
+// bidder to accept wins; the row lock makes every later caller see the
+// auction as already ended.
+func (uc *AcceptPriceUseCase) Execute(ctx context.Context, req *auction.AcceptPriceRequest) (*auction.AuctionResponse, error) {
+    var result, sold *entity.Auction
+
+    err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        auctionEntity, err := uc.auctionRepo.GetByIDForUpdate(ctx, req.AuctionID)
+        if err != nil {
+            return err
+        }
+
+        if !auctionEntity.IsDutch() {
+            return errors.New(errors.ErrorTypeValidation, "only dutch auctions accept a price", nil)
+        }
+        if auctionEntity.Status != entity.AuctionStatusActive {
+            return errors.New(errors.ErrorTypeValidation, "auction is not active", nil)
+        }
+
+        now := time.Now()
+        if auctionEntity.HasEnded(now) {
+            return errors.New(errors.ErrorTypeValidation, "auction has ended", nil)
+        }
+
+        // The price worker runs on an interval, so the stored price may lag
+        // behind the schedule; the buyer pays the lower of the two.
+        price := auctionEntity.DutchPriceAt(now)
+        price = entity.MinMoney(price, auctionEntity.CurrentPrice)
+
+        sold = auctionEntity
+        result, err = uc.purchaser.purchase(ctx, auctionEntity, req.UserID, price, now)
+        return err
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    uc.purchaser.announce(sold)
+    return auction.FromEntity(result), nil
+}
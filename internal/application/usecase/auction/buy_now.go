@@ -0,0 +1,82 @@
+package auction
+
+import (
+    "context"
+    "time"
+    "auction-system/internal/application/dto/auction"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/application/settlement"
+    "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/event"
+)
+
+type BuyNowUseCase struct {
+    auctionRepo repository.AuctionRepository
+    txManager   repository.TxManager
+    purchaser   *purchaser
+}
+
+func NewBuyNowUseCase(
+    auctionRepo repository.AuctionRepository,
+    bidRepo repository.BidRepository,
+    lotRepo repository.LotRepository,
+    txManager repository.TxManager,
+    escrow *escrow.Service,
+    settlement *settlement.Service,
+    events event.Publisher,
+    notifications *notifier.Dispatcher,
+) *BuyNowUseCase {
+    return &BuyNowUseCase{
+        auctionRepo: auctionRepo,
+        txManager:   txManager,
+        purchaser: &purchaser{
+            auctionRepo:   auctionRepo,
+            bidRepo:       bidRepo,
+            lotRepo:       lotRepo,
+            escrow:        escrow,
+            settlement:    settlement,
+            events:        events,
+            notifications: notifications,
+        },
+    }
+}
+
+// Execute sells the lot to the buyer at the buy-now price and closes the
+// auction immediately.
+func (uc *BuyNowUseCase) Execute(ctx context.Context, req *auction.BuyNowRequest) (*auction.AuctionResponse, error) {
+    var result, sold *entity.Auction
+
+    err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        auctionEntity, err := uc.auctionRepo.GetByIDForUpdate(ctx, req.AuctionID)
+        if err != nil {
+            return err
+        }
+
+        if auctionEntity.Status != entity.AuctionStatusActive {
+            return errors.New(errors.ErrorTypeValidation, "auction is not active", nil)
+        }
+
+        now := time.Now()
+        if auctionEntity.HasEnded(now) {
+            return errors.New(errors.ErrorTypeValidation, "auction has ended", nil)
+        }
+
+        if !auctionEntity.BuyNowAvailable() {
+            return errors.New(errors.ErrorTypeValidation, "buy now is not available for this auction", nil)
+        }
+
+        auctionEntity.BuyNowDisabled = true
+        sold = auctionEntity
+        result, err = uc.purchaser.purchase(ctx, auctionEntity, req.UserID, auctionEntity.BuyNowPrice, now)
+        return err
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    uc.purchaser.announce(sold)
+    return auction.FromEntity(result), nil
+}
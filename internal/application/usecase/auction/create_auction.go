@@ -5,6 +5,7 @@ import (
     "auction-system/internal/application/dto/auction"
     "auction-system/internal/domain/repository"
     "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/entity"
 )
 
 type CreateAuctionUseCase struct {
@@ -23,17 +24,74 @@ func NewCreateAuctionUseCase(
 }
 
 func (uc *CreateAuctionUseCase) Execute(ctx context.Context, req *auction.CreateAuctionRequest) (*auction.AuctionResponse, error) {
-    _, err := uc.lotRepo.GetByID(ctx, req.LotID)
+    if req.ExtensionWindow < 0 || req.ExtensionDuration < 0 || req.MaxExtension < 0 {
+        return nil, errors.New(errors.ErrorTypeValidation, "soft close settings must not be negative", nil)
+    }
+    if req.ExtensionDuration > 0 && req.ExtensionWindow == 0 {
+        return nil, errors.New(errors.ErrorTypeValidation, "extension window is required when extension is set", nil)
+    }
+    // Prices are compared below, so they must share a currency before the
+    // lot's is known.
+    if !auction.ToEntity(req).PricesIn(req.StartPrice.Currency) {
+        return nil, errors.New(errors.ErrorTypeValidation, "auction prices must share one currency", nil)
+    }
+    if req.ReservePrice.IsNegative() {
+        return nil, errors.New(errors.ErrorTypeValidation, "reserve price must not be negative", nil)
+    }
+    if req.ReservePrice.IsPositive() && !req.ReservePrice.GreaterThan(req.StartPrice) {
+        return nil, errors.New(errors.ErrorTypeValidation, "reserve price must be greater than start price", nil)
+    }
+    if req.BuyNowPrice.IsNegative() {
+        return nil, errors.New(errors.ErrorTypeValidation, "buy now price must not be negative", nil)
+    }
+    if req.BuyNowPrice.IsPositive() && (!req.BuyNowPrice.GreaterThan(req.StartPrice) || req.BuyNowPrice.LessThan(req.ReservePrice)) {
+        return nil, errors.New(errors.ErrorTypeValidation, "buy now price must be greater than start price and not lower than reserve price", nil)
+    }
+
+    switch req.Type {
+    case "":
+        req.Type = entity.AuctionTypeEnglish
+    case entity.AuctionTypeEnglish:
+    case entity.AuctionTypeSealedFirstPrice, entity.AuctionTypeSealedSecondPrice:
+        if req.BuyNowPrice.IsPositive() {
+            return nil, errors.New(errors.ErrorTypeValidation, "sealed-bid auction does not support buy now price", nil)
+        }
+    case entity.AuctionTypeDutch:
+        if err := validateDutch(req); err != nil {
+            return nil, err
+        }
+    default:
+        return nil, errors.New(errors.ErrorTypeValidation, "unknown auction type", nil)
+    }
+
+    lot, err := uc.lotRepo.GetByID(ctx, req.LotID)
     if err != nil {
         return nil, err
     }
 
+    switch req.Pricing {
+    case "":
+        req.Pricing = entity.MultiUnitPricingUniform
+    case entity.MultiUnitPricingUniform, entity.MultiUnitPricingDiscriminatory:
+    default:
+        return nil, errors.New(errors.ErrorTypeValidation, "unknown multi-unit pricing", nil)
+    }
+    if lot.Quantity > 1 && (req.Type != entity.AuctionTypeEnglish || req.BuyNowPrice.IsPositive()) {
+        return nil, errors.New(errors.ErrorTypeValidation, "lots with several units can only be sold in an english auction without buy now price", nil)
+    }
+
     existingAuction, _ := uc.auctionRepo.GetByLotID(ctx, req.LotID)
     if existingAuction != nil {
         return nil, errors.New(errors.ErrorTypeValidation, "auction already exists for this lot", nil)
     }
 
     auctionEntity := auction.ToEntity(req)
+    auctionEntity.Quantity = max(lot.Quantity, 1)
+    // An auction trades in the currency of its lot.
+    if !auctionEntity.PricesIn(lot.Currency) {
+        return nil, errors.New(errors.ErrorTypeValidation, "auction prices must be in the lot currency "+lot.Currency, nil)
+    }
+    auctionEntity.SetCurrency(lot.Currency)
     
     if err := uc.auctionRepo.Create(ctx, auctionEntity); err != nil {
         return nil, err
@@ -41,3 +99,16 @@ func (uc *CreateAuctionUseCase) Execute(ctx context.Context, req *auction.Create
 
     return auction.FromEntity(auctionEntity), nil
 }
+
+func validateDutch(req *auction.CreateAuctionRequest) error {
+    if !req.PriceDecrement.IsPositive() || req.DecrementInterval <= 0 {
+        return errors.New(errors.ErrorTypeValidation, "dutch auction requires a positive price decrement and decrement interval", nil)
+    }
+    if !req.FloorPrice.IsPositive() || !req.FloorPrice.LessThan(req.StartPrice) {
+        return errors.New(errors.ErrorTypeValidation, "floor price must be positive and lower than start price", nil)
+    }
+    if req.ReservePrice.IsPositive() || req.BuyNowPrice.IsPositive() {
+        return errors.New(errors.ErrorTypeValidation, "dutch auction does not support reserve or buy now price", nil)
+    }
+    return nil
+}
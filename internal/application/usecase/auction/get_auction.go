@@ -3,16 +3,21 @@ package auction
 import (
     "context"
     "auction-system/internal/application/dto/auction"
+    "auction-system/internal/domain/entity"
     "auction-system/internal/domain/repository"
 )
 
 type GetAuctionUseCase struct {
     auctionRepo repository.AuctionRepository
+    winnerRepo  repository.AuctionWinnerRepository
+    paymentRepo repository.PaymentRepository
 }
 
-func NewGetAuctionUseCase(auctionRepo repository.AuctionRepository) *GetAuctionUseCase {
+func NewGetAuctionUseCase(auctionRepo repository.AuctionRepository, winnerRepo repository.AuctionWinnerRepository, paymentRepo repository.PaymentRepository) *GetAuctionUseCase {
     return &GetAuctionUseCase{
         auctionRepo: auctionRepo,
+        winnerRepo:  winnerRepo,
+        paymentRepo: paymentRepo,
     }
 }
 
@@ -22,5 +27,16 @@ func (uc *GetAuctionUseCase) Execute(ctx context.Context, id int64) (*auction.Au
         return nil, err
     }
 
+    auctionEntity.Winners, err = uc.winnerRepo.GetByAuctionID(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+
+    payments, err := uc.paymentRepo.GetByAuctionID(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    auctionEntity.Fees = entity.TotalFees(payments)
+
     return auction.FromEntity(auctionEntity), nil
 }
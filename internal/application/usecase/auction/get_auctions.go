@@ -3,21 +3,32 @@ package auction
 import (
     "context"
     "auction-system/internal/application/dto/auction"
+    "auction-system/internal/domain/exchange"
     "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/errors"
     "auction-system/internal/domain/entity"
 )
 
 type ListAuctionsUseCase struct {
     auctionRepo repository.AuctionRepository
+    rates       exchange.ExchangeRateProvider
 }
 
-func NewListAuctionsUseCase(auctionRepo repository.AuctionRepository) *ListAuctionsUseCase {
+func NewListAuctionsUseCase(auctionRepo repository.AuctionRepository, rates exchange.ExchangeRateProvider) *ListAuctionsUseCase {
     return &ListAuctionsUseCase{
         auctionRepo: auctionRepo,
+        rates:       rates,
     }
 }
 
-func (uc *ListAuctionsUseCase) Execute(ctx context.Context, page, pageSize int, status *string) (*auction.ListAuctionsResponse, error) {
+// Execute lists auctions. When displayCurrency is set, each auction also
+// carries its current and buy now prices converted to that currency; the
+// auction itself keeps trading in its own currency.
+func (uc *ListAuctionsUseCase) Execute(ctx context.Context, page, pageSize int, status *string, displayCurrency string) (*auction.ListAuctionsResponse, error) {
+    if displayCurrency != "" && !entity.ValidCurrency(displayCurrency) {
+        return nil, errors.New(errors.ErrorTypeValidation, "invalid display currency", nil)
+    }
+
     var auctionStatus *entity.AuctionStatus
     if status != nil {
         s := entity.AuctionStatus(*status)
@@ -37,6 +48,20 @@ func (uc *ListAuctionsUseCase) Execute(ctx context.Context, page, pageSize int,
 
     for i, a := range auctions {
         response.Auctions[i] = *auction.FromEntity(a)
+        if displayCurrency == "" {
+            continue
+        }
+
+        rate, err := uc.rates.Rate(ctx, a.Currency, displayCurrency)
+        if err != nil {
+            return nil, err
+        }
+        current := a.CurrentPrice.Convert(rate, displayCurrency)
+        response.Auctions[i].DisplayCurrentPrice = &current
+        if a.BuyNowPrice.IsPositive() {
+            buyNow := a.BuyNowPrice.Convert(rate, displayCurrency)
+            response.Auctions[i].DisplayBuyNowPrice = &buyNow
+        }
     }
 
     return response, nil
@@ -0,0 +1,49 @@
+package auction
+
+import (
+    "context"
+    "auction-system/internal/application/dto/auction"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// GetSettlementUseCase shows a seller what their sold auction was charged
+// and what they have been paid.
+type GetSettlementUseCase struct {
+    auctionRepo repository.AuctionRepository
+    lotRepo     repository.LotRepository
+    paymentRepo repository.PaymentRepository
+}
+
+func NewGetSettlementUseCase(auctionRepo repository.AuctionRepository, lotRepo repository.LotRepository, paymentRepo repository.PaymentRepository) *GetSettlementUseCase {
+    return &GetSettlementUseCase{
+        auctionRepo: auctionRepo,
+        lotRepo:     lotRepo,
+        paymentRepo: paymentRepo,
+    }
+}
+
+func (uc *GetSettlementUseCase) Execute(ctx context.Context, req *auction.GetSettlementRequest) (*auction.SettlementResponse, error) {
+    auctionEntity, err := uc.auctionRepo.GetByID(ctx, req.AuctionID)
+    if err != nil {
+        return nil, err
+    }
+
+    lot, err := uc.lotRepo.GetByID(ctx, auctionEntity.LotID)
+    if err != nil {
+        return nil, err
+    }
+    if lot.CreatorID != req.SellerID {
+        return nil, errors.New(errors.ErrorTypeUnauthorized, "only the seller can view the settlement", nil)
+    }
+
+    payments, err := uc.paymentRepo.GetByAuctionID(ctx, req.AuctionID)
+    if err != nil {
+        return nil, err
+    }
+    if len(payments) == 0 {
+        return nil, errors.New(errors.ErrorTypeNotFound, "auction has not been sold", nil)
+    }
+
+    return auction.ToSettlementResponse(auctionEntity, req.SellerID, payments), nil
+}
@@ -3,6 +3,7 @@ package auction
 import (
     "context"
     dto "auction-system/internal/application/dto/auction"
+    "auction-system/internal/application/eventbus"
 )
 
 type CreateAuctionUseCaseInterface interface {
@@ -18,5 +19,33 @@ type UpdateAuctionUseCaseInterface interface {
 }
 
 type ListAuctionsUseCaseInterface interface {
-    Execute(ctx context.Context, page, pageSize int, status *string) (*dto.ListAuctionsResponse, error)
+    Execute(ctx context.Context, page, pageSize int, status *string, displayCurrency string) (*dto.ListAuctionsResponse, error)
+}
+
+type BuyNowUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.BuyNowRequest) (*dto.AuctionResponse, error)
+}
+
+type GetSettlementUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.GetSettlementRequest) (*dto.SettlementResponse, error)
+}
+
+type AcceptPriceUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.AcceptPriceRequest) (*dto.AuctionResponse, error)
+}
+
+type AddToWatchlistUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.WatchlistRequest) (*dto.WatchlistResponse, error)
+}
+
+type RemoveFromWatchlistUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.WatchlistRequest) (*dto.WatchlistResponse, error)
+}
+
+type GetWatchlistUseCaseInterface interface {
+    Execute(ctx context.Context, userID int64) (*dto.WatchlistResponse, error)
+}
+
+type WatchAuctionUseCaseInterface interface {
+    Execute(ctx context.Context, auctionID, lastEventID int64) (*eventbus.AuctionSubscription, error)
 }
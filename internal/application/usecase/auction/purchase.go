@@ -0,0 +1,73 @@
+package auction
+
+import (
+    "context"
+    "fmt"
+    "time"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/application/settlement"
+    "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/event"
+)
+
+// purchaser closes an auction at a fixed price on behalf of a single buyer.
+// It is shared by buy-now and Dutch acceptance, which differ only in how
+// the price is determined.
+type purchaser struct {
+    auctionRepo   repository.AuctionRepository
+    bidRepo       repository.BidRepository
+    lotRepo       repository.LotRepository
+    escrow        *escrow.Service
+    settlement    *settlement.Service
+    events        event.Publisher
+    notifications *notifier.Dispatcher
+}
+
+// purchase must run inside a transaction holding the auction row lock.
+func (p *purchaser) purchase(ctx context.Context, auctionEntity *entity.Auction, userID int64, price entity.Money, now time.Time) (*entity.Auction, error) {
+    lot, err := p.lotRepo.GetByID(ctx, auctionEntity.LotID)
+    if err != nil {
+        return nil, err
+    }
+    if lot.CreatorID == userID {
+        return nil, errors.New(errors.ErrorTypeValidation, "seller cannot buy own lot", nil)
+    }
+
+    if err := p.escrow.CheckFunds(ctx, userID, auctionEntity.ID, price); err != nil {
+        return nil, err
+    }
+
+    purchase := &entity.Bid{
+        AuctionID: auctionEntity.ID,
+        UserID:    userID,
+        Amount:    price,
+        Quantity:  1,
+    }
+    if err := p.bidRepo.Create(ctx, purchase); err != nil {
+        return nil, err
+    }
+
+    if err := p.settlement.Settle(ctx, auctionEntity, auctionEntity.Allocate([]*entity.Bid{purchase})); err != nil {
+        return nil, err
+    }
+
+    auctionEntity.EndTime = now
+    updated, err := p.auctionRepo.Update(ctx, auctionEntity.ID, auctionEntity)
+    if err != nil {
+        return nil, err
+    }
+
+    if err := p.events.Publish(ctx, entity.NewAuctionEvent(entity.AuctionEventEnded, updated)); err != nil {
+        return nil, err
+    }
+    return updated, nil
+}
+
+// announce tells the buyer, the other bidders and the seller how the
+// auction ended. It runs once the purchase has committed.
+func (p *purchaser) announce(auctionEntity *entity.Auction) {
+    p.notifications.Dispatch(fmt.Sprintf("results of auction %d", auctionEntity.ID), notifier.AuctionResults(auctionEntity, p.bidRepo, p.lotRepo))
+}
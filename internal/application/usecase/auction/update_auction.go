@@ -48,6 +48,9 @@ func (uc *UpdateAuctionUseCase) Execute(ctx context.Context, id int64, req *auct
     if req.Status != nil {
         updateAuction.Status = entity.AuctionStatus(*req.Status)
     }
+    if !updateAuction.PricesIn(existingAuction.Currency) {
+        return nil, errors.New(errors.ErrorTypeValidation, "auction prices must be in the auction currency "+existingAuction.Currency, nil)
+    }
 
     updatedAuction, err := uc.auctionRepo.Update(ctx, id, updateAuction)
     if err != nil {
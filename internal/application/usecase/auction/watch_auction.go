@@ -0,0 +1,45 @@
+package auction
+
+import (
+    "context"
+    "auction-system/internal/application/eventbus"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type WatchAuctionUseCase struct {
+    auctionRepo repository.AuctionRepository
+    bus         *eventbus.AuctionBus
+}
+
+func NewWatchAuctionUseCase(auctionRepo repository.AuctionRepository, bus *eventbus.AuctionBus) *WatchAuctionUseCase {
+    return &WatchAuctionUseCase{
+        auctionRepo: auctionRepo,
+        bus:         bus,
+    }
+}
+
+// Execute subscribes to the events of a pending or active auction, starting
+// after lastEventID if the watcher is reconnecting. The subscription is
+// taken before the auction is checked, so nothing that happens in between
+// is missed.
+func (uc *WatchAuctionUseCase) Execute(ctx context.Context, auctionID, lastEventID int64) (*eventbus.AuctionSubscription, error) {
+    sub := uc.bus.Resume(auctionID, lastEventID)
+
+    auctionEntity, err := uc.auctionRepo.GetByID(ctx, auctionID)
+    if err != nil {
+        sub.Close()
+        return nil, err
+    }
+
+    // A reconnecting watcher may still be owed the events that ended the
+    // auction.
+    resuming := lastEventID > 0 && len(sub.Events()) > 0
+    if auctionEntity.Status != entity.AuctionStatusPending && auctionEntity.Status != entity.AuctionStatusActive && !resuming {
+        sub.Close()
+        return nil, errors.New(errors.ErrorTypeValidation, "auction has already ended", nil)
+    }
+
+    return sub, nil
+}
@@ -0,0 +1,90 @@
+package auction
+
+import (
+    "context"
+    "auction-system/internal/application/dto/auction"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type AddToWatchlistUseCase struct {
+    userRepo      repository.UserRepository
+    auctionRepo   repository.AuctionRepository
+    watchlistRepo repository.WatchlistRepository
+}
+
+func NewAddToWatchlistUseCase(userRepo repository.UserRepository, auctionRepo repository.AuctionRepository, watchlistRepo repository.WatchlistRepository) *AddToWatchlistUseCase {
+    return &AddToWatchlistUseCase{
+        userRepo:      userRepo,
+        auctionRepo:   auctionRepo,
+        watchlistRepo: watchlistRepo,
+    }
+}
+
+// Execute watches a pending or active auction and returns the watchlist.
+func (uc *AddToWatchlistUseCase) Execute(ctx context.Context, req *auction.WatchlistRequest) (*auction.WatchlistResponse, error) {
+    if _, err := uc.userRepo.GetByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+
+    auctionEntity, err := uc.auctionRepo.GetByID(ctx, req.AuctionID)
+    if err != nil {
+        return nil, err
+    }
+    if auctionEntity.Status != entity.AuctionStatusPending && auctionEntity.Status != entity.AuctionStatusActive {
+        return nil, errors.New(errors.ErrorTypeValidation, "only pending or active auctions can be watched", nil)
+    }
+
+    if err := uc.watchlistRepo.Add(ctx, req.UserID, req.AuctionID); err != nil {
+        return nil, err
+    }
+    return watchlist(ctx, uc.watchlistRepo, req.UserID)
+}
+
+type RemoveFromWatchlistUseCase struct {
+    watchlistRepo repository.WatchlistRepository
+}
+
+func NewRemoveFromWatchlistUseCase(watchlistRepo repository.WatchlistRepository) *RemoveFromWatchlistUseCase {
+    return &RemoveFromWatchlistUseCase{watchlistRepo: watchlistRepo}
+}
+
+func (uc *RemoveFromWatchlistUseCase) Execute(ctx context.Context, req *auction.WatchlistRequest) (*auction.WatchlistResponse, error) {
+    if err := uc.watchlistRepo.Remove(ctx, req.UserID, req.AuctionID); err != nil {
+        return nil, err
+    }
+    return watchlist(ctx, uc.watchlistRepo, req.UserID)
+}
+
+type GetWatchlistUseCase struct {
+    userRepo      repository.UserRepository
+    watchlistRepo repository.WatchlistRepository
+}
+
+func NewGetWatchlistUseCase(userRepo repository.UserRepository, watchlistRepo repository.WatchlistRepository) *GetWatchlistUseCase {
+    return &GetWatchlistUseCase{
+        userRepo:      userRepo,
+        watchlistRepo: watchlistRepo,
+    }
+}
+
+func (uc *GetWatchlistUseCase) Execute(ctx context.Context, userID int64) (*auction.WatchlistResponse, error) {
+    if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+        return nil, err
+    }
+    return watchlist(ctx, uc.watchlistRepo, userID)
+}
+
+func watchlist(ctx context.Context, watchlistRepo repository.WatchlistRepository, userID int64) (*auction.WatchlistResponse, error) {
+    auctions, err := watchlistRepo.GetByUserID(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+
+    response := &auction.WatchlistResponse{Auctions: make([]auction.AuctionResponse, len(auctions))}
+    for i, a := range auctions {
+        response.Auctions[i] = *auction.FromEntity(a)
+    }
+    return response, nil
+}
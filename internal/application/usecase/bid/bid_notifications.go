@@ -0,0 +1,71 @@
+package bid
+
+import (
+    "context"
+    "fmt"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/repository"
+)
+
+// bidNotice is who a bid request tells what once it has committed: the
+// seller hears about bid, and every user in outbid hears that leading took
+// the lead from them.
+type bidNotice struct {
+    auction *entity.Auction
+    bid     *entity.Bid
+    leading *entity.Bid
+    outbid  []int64
+}
+
+// newBidNotice tells the users in previous, other than the one who leads
+// with leading, that they were outbid.
+func newBidNotice(auction *entity.Auction, bid, leading *entity.Bid, previous ...int64) *bidNotice {
+    n := &bidNotice{auction: auction, bid: bid, leading: leading}
+    for _, userID := range previous {
+        if userID == 0 || (leading != nil && userID == leading.UserID) || containsUser(n.outbid, userID) {
+            continue
+        }
+        n.outbid = append(n.outbid, userID)
+    }
+    return n
+}
+
+// dispatch hands the notifications to notifications. The seller is looked
+// up there too, keeping the read out of the bid's transaction.
+func (n *bidNotice) dispatch(notifications *notifier.Dispatcher, lotRepo repository.LotRepository) {
+    for _, userID := range n.outbid {
+        userID := userID
+        notifications.Dispatch(fmt.Sprintf("outbid of user %d on auction %d", userID, n.auction.ID), func(ctx context.Context, service notification.NotificationService) error {
+            return service.NotifyOutbid(ctx, n.auction, userID, n.leading)
+        })
+    }
+
+    if n.bid == nil {
+        return
+    }
+    notifications.Dispatch(fmt.Sprintf("new bid %d on auction %d", n.bid.ID, n.auction.ID), func(ctx context.Context, service notification.NotificationService) error {
+        lot, err := lotRepo.GetByID(ctx, n.auction.LotID)
+        if err != nil {
+            return err
+        }
+        return service.NotifyNewBid(ctx, n.auction, lot.CreatorID, n.bid)
+    })
+}
+
+func leaderID(leading *entity.Bid) int64 {
+    if leading == nil {
+        return 0
+    }
+    return leading.UserID
+}
+
+func containsUser(userIDs []int64, userID int64) bool {
+    for _, id := range userIDs {
+        if id == userID {
+            return true
+        }
+    }
+    return false
+}
@@ -3,16 +3,19 @@ package bid
 import (
     "context"
     "auction-system/internal/application/dto/bid"
+    "auction-system/internal/domain/entity"
     "auction-system/internal/domain/repository"
 )
 
 type GetBidUseCase struct {
-    bidRepo repository.BidRepository
+    bidRepo     repository.BidRepository
+    auctionRepo repository.AuctionRepository
 }
 
-func NewGetBidUseCase(bidRepo repository.BidRepository) *GetBidUseCase {
+func NewGetBidUseCase(bidRepo repository.BidRepository, auctionRepo repository.AuctionRepository) *GetBidUseCase {
     return &GetBidUseCase{
-        bidRepo: bidRepo,
+        bidRepo:     bidRepo,
+        auctionRepo: auctionRepo,
     }
 }
 
@@ -22,5 +25,13 @@ func (uc *GetBidUseCase) Execute(ctx context.Context, id int64) (*bid.BidRespons
         return nil, err
     }
 
+    auction, err := uc.auctionRepo.GetByID(ctx, bidEntity.AuctionID)
+    if err != nil {
+        return nil, err
+    }
+    if auction.BidsHidden() {
+        bidEntity.Amount = entity.Money{}
+    }
+
     return bid.FromEntity(bidEntity), nil
 }
@@ -16,3 +16,7 @@ type GetBidUseCaseInterface interface {
 type ListBidsUseCaseInterface interface {
     Execute(ctx context.Context, req *dto.ListBidsRequest) (*dto.ListBidsResponse, error)
 }
+
+type SetMaxBidUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.SetMaxBidRequest) (*dto.MaxBidResponse, error)
+}
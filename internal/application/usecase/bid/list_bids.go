@@ -8,12 +8,14 @@ import (
 )
 
 type ListBidsUseCase struct {
-    bidRepo repository.BidRepository
+    bidRepo     repository.BidRepository
+    auctionRepo repository.AuctionRepository
 }
 
-func NewListBidsUseCase(bidRepo repository.BidRepository) *ListBidsUseCase {
+func NewListBidsUseCase(bidRepo repository.BidRepository, auctionRepo repository.AuctionRepository) *ListBidsUseCase {
     return &ListBidsUseCase{
-        bidRepo: bidRepo,
+        bidRepo:     bidRepo,
+        auctionRepo: auctionRepo,
     }
 }
 
@@ -25,12 +27,23 @@ func (uc *ListBidsUseCase) Execute(ctx context.Context, req *bid.ListBidsRequest
         return nil, errors.New(errors.ErrorTypeValidation, "page size must be greater than 0", nil)
     }
 
+    auction, err := uc.auctionRepo.GetByID(ctx, req.AuctionID)
+    if err != nil {
+        return nil, err
+    }
+
     offset := (req.PageNumber - 1) * req.PageSize
     bids, total, err := uc.bidRepo.List(ctx, req.AuctionID, offset, req.PageSize)
     if err != nil {
         return nil, err
     }
 
+    // Even the order of a sealed auction's bids would reveal their ranking,
+    // so only the count is shown until the auction closes.
+    if auction.BidsHidden() {
+        bids = nil
+    }
+
     return &bid.ListBidsResponse{
         Bids:       bid.FromEntityList(bids),
         TotalCount: total,
@@ -0,0 +1,85 @@
+package bid
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// multiUnitBidder records bids on auctions that sell several units. Bids are
+// per-unit prices; once every unit is allocated, a new bid has to beat the
+// clearing price by the minimum step. It must run inside the transaction
+// that holds the auction lock.
+type multiUnitBidder struct {
+    bidRepo repository.BidRepository
+}
+
+// submit stores the bid and moves the auction's CurrentPrice to the lowest
+// winning unit price. Persisting the auction is left to the caller. It
+// returns the users who were allocated fewer units because of the bid.
+func (m *multiUnitBidder) submit(ctx context.Context, auction *entity.Auction, bid *entity.Bid) ([]int64, error) {
+    if bid.Quantity > auction.Quantity {
+        return nil, errors.New(errors.ErrorTypeValidation, "bid quantity exceeds the units on offer", nil)
+    }
+
+    bids, err := m.bidRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return nil, err
+    }
+
+    minimum := auction.StartPrice
+    if fullyAllocated(auction, bids) {
+        minimum = auction.CurrentPrice.Add(auction.MinStep)
+    }
+    if bid.Amount.LessThan(minimum) {
+        return nil, errors.New(errors.ErrorTypeValidation, "bid amount must be greater than clearing price plus minimum step", nil)
+    }
+    before := auction.Allocate(bids)
+
+    if err := m.bidRepo.Create(ctx, bid); err != nil {
+        return nil, err
+    }
+
+    bids, err = m.bidRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return nil, err
+    }
+    winners := auction.Allocate(bids)
+    if fullyAllocated(auction, bids) {
+        auction.CurrentPrice = winners[len(winners)-1].UnitPrice
+    }
+    return displaced(before, winners), nil
+}
+
+// displaced returns the users who hold fewer units in after than in before.
+func displaced(before, after []*entity.AuctionWinner) []int64 {
+    units := make(map[int64]int)
+    for _, w := range after {
+        units[w.UserID] += w.Quantity
+    }
+    held := make(map[int64]int)
+    var order []int64
+    for _, w := range before {
+        if _, ok := held[w.UserID]; !ok {
+            order = append(order, w.UserID)
+        }
+        held[w.UserID] += w.Quantity
+    }
+
+    var users []int64
+    for _, userID := range order {
+        if units[userID] < held[userID] {
+            users = append(users, userID)
+        }
+    }
+    return users
+}
+
+func fullyAllocated(auction *entity.Auction, bids []*entity.Bid) bool {
+    allocated := 0
+    for _, w := range auction.Allocate(bids) {
+        allocated += w.Quantity
+    }
+    return allocated == auction.Quantity
+}
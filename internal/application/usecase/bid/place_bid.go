@@ -2,64 +2,199 @@ package bid
 
 import (
     "context"
+    "time"
     "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/notifier"
     "auction-system/internal/domain/repository"
     "auction-system/internal/domain/errors"
     "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/event"
 )
 
 type PlaceBidUseCase struct {
     bidRepo     repository.BidRepository
     auctionRepo repository.AuctionRepository
-    userRepo    repository.UserRepository
+    lotRepo     repository.LotRepository
+    txManager   repository.TxManager
+    escrow      *escrow.Service
+    events      event.Publisher
+    notifications *notifier.Dispatcher
+    proxy       *proxyBidder
+    sealed      *sealedBidder
+    multiUnit   *multiUnitBidder
+    buyNowDisablePercent float64
 }
 
 func NewPlaceBidUseCase(
     bidRepo repository.BidRepository,
     auctionRepo repository.AuctionRepository,
-    userRepo repository.UserRepository,
+    maxBidRepo repository.MaxBidRepository,
+    lotRepo repository.LotRepository,
+    txManager repository.TxManager,
+    escrow *escrow.Service,
+    events event.Publisher,
+    notifications *notifier.Dispatcher,
+    buyNowDisablePercent float64,
 ) *PlaceBidUseCase {
     return &PlaceBidUseCase{
         bidRepo:     bidRepo,
         auctionRepo: auctionRepo,
-        userRepo:    userRepo,
+        lotRepo:     lotRepo,
+        txManager:   txManager,
+        escrow:      escrow,
+        events:      events,
+        notifications: notifications,
+        proxy:       &proxyBidder{bidRepo: bidRepo, maxBidRepo: maxBidRepo, escrow: escrow},
+        sealed:      &sealedBidder{bidRepo: bidRepo},
+        multiUnit:   &multiUnitBidder{bidRepo: bidRepo},
+        buyNowDisablePercent: buyNowDisablePercent,
     }
 }
 
+// Execute places the bid. Once it has committed, the seller is told about it
+// and whoever it outbid is told they lost the lead; bids on sealed auctions
+// stay secret and notify nobody.
 func (uc *PlaceBidUseCase) Execute(ctx context.Context, req *bid.PlaceBidRequest) (*bid.BidResponse, error) {
-    auction, err := uc.auctionRepo.GetByID(ctx, req.AuctionID)
-    if err != nil {
-        return nil, err
-    }
+    var bidEntity *entity.Bid
+    var notice *bidNotice
 
-    if auction.Status != entity.AuctionStatusActive {
-        return nil, errors.New(errors.ErrorTypeValidation, "auction is not active", nil)
-    }
+    err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        auction, err := uc.auctionRepo.GetByIDForUpdate(ctx, req.AuctionID)
+        if err != nil {
+            return err
+        }
+
+        if auction.Status != entity.AuctionStatusActive {
+            return errors.New(errors.ErrorTypeValidation, "auction is not active", nil)
+        }
+
+        if auction.IsDutch() {
+            return errors.New(errors.ErrorTypeValidation, "dutch auctions do not take bids, accept the current price instead", nil)
+        }
+
+        now := time.Now()
+        if auction.HasEnded(now) {
+            return errors.New(errors.ErrorTypeValidation, "auction has ended", nil)
+        }
+
+        if err := checkCurrency(auction, req.Amount); err != nil {
+            return err
+        }
+
+        bidEntity = bid.ToEntity(req)
+        price, endTime := auction.CurrentPrice, auction.EndTime
+
+        if bidEntity.Quantity > 1 && !auction.IsMultiUnit() {
+            return errors.New(errors.ErrorTypeValidation, "auction sells a single unit", nil)
+        }
+
+        if err := uc.escrow.CheckBid(ctx, auction, bidEntity); err != nil {
+            return err
+        }
+
+        if auction.IsSealed() {
+            bidEntity, err = uc.sealed.submit(ctx, auction, req.UserID, req.Amount)
+            if err != nil {
+                return err
+            }
+            return uc.escrow.Sync(ctx, auction)
+        }
+
+        if auction.IsMultiUnit() {
+            outbid, err := uc.multiUnit.submit(ctx, auction, bidEntity)
+            if err != nil {
+                return err
+            }
+            notice = newBidNotice(auction, bidEntity, bidEntity, outbid...)
+            auction.ExtendForBidAt(now)
+
+            if _, err := uc.auctionRepo.Update(ctx, auction.ID, auction); err != nil {
+                return err
+            }
+            if err := uc.escrow.Sync(ctx, auction); err != nil {
+                return err
+            }
+            return publish(ctx, uc.events, bidEvents(auction, bidEntity, price, endTime))
+        }
+
+        if auction.CurrentPrice.Add(auction.MinStep).GreaterThan(req.Amount) {
+            return errors.New(errors.ErrorTypeValidation, "bid amount must be greater than current price plus minimum step", nil)
+        }
 
-    user, err := uc.userRepo.GetByID(ctx, req.UserID)
+        previous, err := uc.bidRepo.GetHighestByAuctionID(ctx, auction.ID)
+        if err != nil {
+            return err
+        }
+        if err := uc.bidRepo.Create(ctx, bidEntity); err != nil {
+            return err
+        }
+
+        auction.CurrentPrice = req.Amount
+        if err := uc.proxy.resolve(ctx, auction); err != nil {
+            return err
+        }
+        // A maximum bid may have answered at once and outbid the bidder too.
+        leading, err := uc.bidRepo.GetHighestByAuctionID(ctx, auction.ID)
+        if err != nil {
+            return err
+        }
+        notice = newBidNotice(auction, bidEntity, leading, leaderID(previous), req.UserID)
+        auction.ExtendForBidAt(now)
+        auction.DisableBuyNowAbove(uc.buyNowDisablePercent)
+
+        if _, err := uc.auctionRepo.Update(ctx, auction.ID, auction); err != nil {
+            return err
+        }
+        if err := uc.escrow.Sync(ctx, auction); err != nil {
+            return err
+        }
+        return publish(ctx, uc.events, bidEvents(auction, bidEntity, price, endTime))
+    })
     if err != nil {
         return nil, err
     }
 
-    if user.Balance < req.Amount {
-        return nil, errors.New(errors.ErrorTypeValidation, "insufficient funds", nil)
+    if notice != nil {
+        notice.dispatch(uc.notifications, uc.lotRepo)
     }
+    return bid.FromEntity(bidEntity), nil
+}
 
-    if auction.CurrentPrice + auction.MinStep > req.Amount {
-        return nil, errors.New(errors.ErrorTypeValidation, "bid amount must be greater than current price plus minimum step", nil)
+// checkCurrency rejects amounts that are not in the auction's currency.
+// Bids are never converted, so every bidder competes in the same money.
+func checkCurrency(auction *entity.Auction, amount entity.Money) error {
+    if amount.Currency != auction.Currency {
+        return errors.New(errors.ErrorTypeValidation, "bid must be placed in the auction currency "+auction.Currency, nil)
     }
+    return nil
+}
 
-    bidEntity := bid.ToEntity(req)
-    
-    if err := uc.bidRepo.Create(ctx, bidEntity); err != nil {
-        return nil, err
-    }
+// bidEvents announces bid and what it changed on auction, which was locked
+// at price and endTime. Automatic bids placed in response are covered by
+// the price change.
+func bidEvents(auction *entity.Auction, bid *entity.Bid, price entity.Money, endTime time.Time) []*entity.AuctionEvent {
+    return append([]*entity.AuctionEvent{entity.NewBidPlacedEvent(auction, bid)}, auctionChanges(auction, price, endTime)...)
+}
 
-    auction.CurrentPrice = req.Amount
-    _, err = uc.auctionRepo.Update(ctx, auction.ID, auction)
-    if err != nil {
-        return nil, err
+// auctionChanges returns the events for the changes made to auction since
+// it was locked at price and endTime.
+func auctionChanges(auction *entity.Auction, price entity.Money, endTime time.Time) []*entity.AuctionEvent {
+    var events []*entity.AuctionEvent
+    if auction.CurrentPrice.Cmp(price) != 0 {
+        events = append(events, entity.NewAuctionEvent(entity.AuctionEventPriceChanged, auction))
+    }
+    if !auction.EndTime.Equal(endTime) {
+        events = append(events, entity.NewAuctionEvent(entity.AuctionEventExtended, auction))
     }
+    return events
+}
 
-    return bid.FromEntity(bidEntity), nil
+func publish(ctx context.Context, publisher event.Publisher, events []*entity.AuctionEvent) error {
+    for _, e := range events {
+        if err := publisher.Publish(ctx, e); err != nil {
+            return err
+        }
+    }
+    return nil
 }
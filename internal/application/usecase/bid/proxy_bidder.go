@@ -0,0 +1,116 @@
+package bid
+
+import (
+    "context"
+    "sort"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/repository"
+)
+
+// proxyBidder places automatic bids on behalf of users who set a hidden
+// maximum. It must run inside the transaction that holds the auction lock.
+type proxyBidder struct {
+    bidRepo    repository.BidRepository
+    maxBidRepo repository.MaxBidRepository
+    escrow     *escrow.Service
+}
+
+// resolve lets proxies answer the current leading bid until no proxy can
+// outbid the leader any more. The auction's CurrentPrice is advanced in
+// memory; persisting it is left to the caller.
+func (p *proxyBidder) resolve(ctx context.Context, auction *entity.Auction) error {
+    maxBids, err := p.maxBidRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return err
+    }
+    if len(maxBids) == 0 {
+        return nil
+    }
+    if err := p.capToFunds(ctx, auction, maxBids); err != nil {
+        return err
+    }
+
+    leading, err := p.bidRepo.GetHighestByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return err
+    }
+
+    for {
+        var leaderID int64
+        if leading != nil {
+            leaderID = leading.UserID
+        }
+
+        challenger := nextChallenger(maxBids, leaderID, auction.CurrentPrice.Add(auction.MinStep))
+        if challenger == nil {
+            return nil
+        }
+
+        defender := findMaxBid(maxBids, leaderID)
+        var next *entity.Bid
+        if defender != nil && outranks(defender, challenger) {
+            amount := entity.MinMoney(defender.MaxAmount, challenger.MaxAmount.Add(auction.MinStep))
+            next = &entity.Bid{AuctionID: auction.ID, UserID: defender.UserID, Amount: amount, Quantity: 1, IsAuto: true}
+        } else {
+            amount := auction.CurrentPrice.Add(auction.MinStep)
+            if defender != nil {
+                amount = entity.MaxMoney(amount, defender.MaxAmount.Add(auction.MinStep))
+            }
+            amount = entity.MinMoney(amount, challenger.MaxAmount)
+            next = &entity.Bid{AuctionID: auction.ID, UserID: challenger.UserID, Amount: amount, Quantity: 1, IsAuto: true}
+        }
+
+        if err := p.bidRepo.Create(ctx, next); err != nil {
+            return err
+        }
+        auction.CurrentPrice = next.Amount
+        leading = next
+    }
+}
+
+// capToFunds lowers each maximum to what its user can still commit to the
+// auction. Funds the user committed elsewhere after setting the maximum are
+// no longer available to the proxy, so it stops there.
+func (p *proxyBidder) capToFunds(ctx context.Context, auction *entity.Auction, maxBids []*entity.MaxBid) error {
+    for _, m := range maxBids {
+        available, err := p.escrow.Available(ctx, m.UserID, auction.ID, auction.Currency)
+        if err != nil {
+            return err
+        }
+        m.MaxAmount = entity.MinMoney(m.MaxAmount, available)
+    }
+    sort.SliceStable(maxBids, func(i, j int) bool {
+        return outranks(maxBids[i], maxBids[j]) && !outranks(maxBids[j], maxBids[i])
+    })
+    return nil
+}
+
+// nextChallenger returns the highest-priority max bid of a user other than
+// the leader that can still cover minAmount.
+func nextChallenger(maxBids []*entity.MaxBid, leaderID int64, minAmount entity.Money) *entity.MaxBid {
+    for _, m := range maxBids {
+        if m.UserID != leaderID && !m.MaxAmount.LessThan(minAmount) {
+            return m
+        }
+    }
+    return nil
+}
+
+func findMaxBid(maxBids []*entity.MaxBid, userID int64) *entity.MaxBid {
+    for _, m := range maxBids {
+        if m.UserID == userID {
+            return m
+        }
+    }
+    return nil
+}
+
+// outranks reports whether a wins against b: the higher ceiling wins and
+// the earlier one wins a tie.
+func outranks(a, b *entity.MaxBid) bool {
+    if c := a.MaxAmount.Cmp(b.MaxAmount); c != 0 {
+        return c > 0
+    }
+    return !a.UpdatedAt.After(b.UpdatedAt)
+}
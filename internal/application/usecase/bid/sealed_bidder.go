@@ -0,0 +1,45 @@
+package bid
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// sealedBidder records bids on sealed-bid auctions. Each user holds a single
+// bid that they may replace until close; the auction price is left untouched
+// so nothing about other bids leaks. It must run inside the transaction that
+// holds the auction lock.
+type sealedBidder struct {
+    bidRepo repository.BidRepository
+}
+
+func (s *sealedBidder) submit(ctx context.Context, auction *entity.Auction, userID int64, amount entity.Money) (*entity.Bid, error) {
+    if amount.LessThan(auction.StartPrice) {
+        return nil, errors.New(errors.ErrorTypeValidation, "bid amount must not be lower than start price", nil)
+    }
+
+    existing, err := s.bidRepo.GetByAuctionAndUser(ctx, auction.ID, userID)
+    if err != nil {
+        return nil, err
+    }
+
+    if existing == nil {
+        bid := &entity.Bid{
+            AuctionID: auction.ID,
+            UserID:    userID,
+            Amount:    amount,
+            Quantity:  1,
+        }
+        if err := s.bidRepo.Create(ctx, bid); err != nil {
+            return nil, err
+        }
+        return bid, nil
+    }
+
+    if err := s.bidRepo.UpdateAmount(ctx, existing.ID, amount); err != nil {
+        return nil, err
+    }
+    return s.bidRepo.GetByID(ctx, existing.ID)
+}
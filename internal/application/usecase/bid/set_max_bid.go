@@ -0,0 +1,149 @@
+package bid
+
+import (
+    "context"
+    "time"
+    "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/event"
+)
+
+type SetMaxBidUseCase struct {
+    bidRepo     repository.BidRepository
+    maxBidRepo  repository.MaxBidRepository
+    auctionRepo repository.AuctionRepository
+    lotRepo     repository.LotRepository
+    txManager   repository.TxManager
+    escrow      *escrow.Service
+    events      event.Publisher
+    notifications *notifier.Dispatcher
+    proxy       *proxyBidder
+    buyNowDisablePercent float64
+}
+
+func NewSetMaxBidUseCase(
+    bidRepo repository.BidRepository,
+    maxBidRepo repository.MaxBidRepository,
+    auctionRepo repository.AuctionRepository,
+    lotRepo repository.LotRepository,
+    txManager repository.TxManager,
+    escrow *escrow.Service,
+    events event.Publisher,
+    notifications *notifier.Dispatcher,
+    buyNowDisablePercent float64,
+) *SetMaxBidUseCase {
+    return &SetMaxBidUseCase{
+        bidRepo:     bidRepo,
+        maxBidRepo:  maxBidRepo,
+        auctionRepo: auctionRepo,
+        lotRepo:     lotRepo,
+        txManager:   txManager,
+        escrow:      escrow,
+        events:      events,
+        notifications: notifications,
+        proxy:       &proxyBidder{bidRepo: bidRepo, maxBidRepo: maxBidRepo, escrow: escrow},
+        buyNowDisablePercent: buyNowDisablePercent,
+    }
+}
+
+// Execute sets the user's maximum bid. If that places automatic bids, the
+// seller hears about the new leading bid and a displaced leader that they
+// were outbid, once the change has committed.
+func (uc *SetMaxBidUseCase) Execute(ctx context.Context, req *bid.SetMaxBidRequest) (*bid.MaxBidResponse, error) {
+    var resp *bid.MaxBidResponse
+    var notice *bidNotice
+
+    err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        auction, err := uc.auctionRepo.GetByIDForUpdate(ctx, req.AuctionID)
+        if err != nil {
+            return err
+        }
+
+        if auction.Status != entity.AuctionStatusActive {
+            return errors.New(errors.ErrorTypeValidation, "auction is not active", nil)
+        }
+
+        if auction.IsDutch() {
+            return errors.New(errors.ErrorTypeValidation, "dutch auctions do not take bids, accept the current price instead", nil)
+        }
+
+        if auction.IsSealed() || auction.IsMultiUnit() {
+            return errors.New(errors.ErrorTypeValidation, "maximum bids are only supported on single-unit english auctions", nil)
+        }
+
+        now := time.Now()
+        if auction.HasEnded(now) {
+            return errors.New(errors.ErrorTypeValidation, "auction has ended", nil)
+        }
+
+        if err := checkCurrency(auction, req.MaxAmount); err != nil {
+            return err
+        }
+
+        if err := uc.escrow.CheckFunds(ctx, req.UserID, auction.ID, req.MaxAmount); err != nil {
+            return err
+        }
+
+        leading, err := uc.bidRepo.GetHighestByAuctionID(ctx, auction.ID)
+        if err != nil {
+            return err
+        }
+
+        isLeader := leading != nil && leading.UserID == req.UserID
+        if isLeader && req.MaxAmount.LessThan(auction.CurrentPrice) {
+            return errors.New(errors.ErrorTypeValidation, "max bid must not be lower than your current bid", nil)
+        }
+        if !isLeader && auction.CurrentPrice.Add(auction.MinStep).GreaterThan(req.MaxAmount) {
+            return errors.New(errors.ErrorTypeValidation, "max bid must be greater than current price plus minimum step", nil)
+        }
+
+        maxBid := bid.ToMaxBidEntity(req)
+        if err := uc.maxBidRepo.Upsert(ctx, maxBid); err != nil {
+            return err
+        }
+
+        previous := leading
+        price, endTime := auction.CurrentPrice, auction.EndTime
+        if err := uc.proxy.resolve(ctx, auction); err != nil {
+            return err
+        }
+        if auction.CurrentPrice.Cmp(price) != 0 {
+            auction.ExtendForBidAt(now)
+            auction.DisableBuyNowAbove(uc.buyNowDisablePercent)
+            if _, err := uc.auctionRepo.Update(ctx, auction.ID, auction); err != nil {
+                return err
+            }
+            if err := uc.escrow.Sync(ctx, auction); err != nil {
+                return err
+            }
+            if err := publish(ctx, uc.events, auctionChanges(auction, price, endTime)); err != nil {
+                return err
+            }
+        }
+
+        leading, err = uc.bidRepo.GetHighestByAuctionID(ctx, auction.ID)
+        if err != nil {
+            return err
+        }
+        if auction.CurrentPrice.Cmp(price) != 0 {
+            notice = newBidNotice(auction, leading, leading, leaderID(previous))
+        }
+
+        resp = bid.FromMaxBidEntity(maxBid)
+        resp.CurrentPrice = auction.CurrentPrice
+        resp.IsLeading = leading != nil && leading.UserID == req.UserID
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    if notice != nil {
+        notice.dispatch(uc.notifications, uc.lotRepo)
+    }
+    return resp, nil
+}
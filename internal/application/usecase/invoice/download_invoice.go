@@ -0,0 +1,40 @@
+package invoice
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/invoice"
+    domainInvoice "auction-system/internal/domain/invoice"
+    "auction-system/internal/domain/repository"
+)
+
+// DownloadInvoiceUseCase renders an invoice as a PDF, plain-text or HTML
+// document.
+type DownloadInvoiceUseCase struct {
+    invoiceRepo repository.InvoiceRepository
+    renderer    domainInvoice.Renderer
+}
+
+func NewDownloadInvoiceUseCase(invoiceRepo repository.InvoiceRepository, renderer domainInvoice.Renderer) *DownloadInvoiceUseCase {
+    return &DownloadInvoiceUseCase{
+        invoiceRepo: invoiceRepo,
+        renderer:    renderer,
+    }
+}
+
+func (uc *DownloadInvoiceUseCase) Execute(ctx context.Context, req *dto.DownloadInvoiceRequest) (*dto.DocumentResponse, error) {
+    format, err := domainInvoice.ParseFormat(req.Format)
+    if err != nil {
+        return nil, err
+    }
+
+    invoice, err := getVisible(ctx, uc.invoiceRepo, req.InvoiceID, req.UserID)
+    if err != nil {
+        return nil, err
+    }
+
+    document, err := uc.renderer.Render(invoice, format)
+    if err != nil {
+        return nil, err
+    }
+    return dto.ToDocumentResponse(document), nil
+}
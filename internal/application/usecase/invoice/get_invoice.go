@@ -0,0 +1,45 @@
+package invoice
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/invoice"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type GetInvoiceUseCase struct {
+    invoiceRepo repository.InvoiceRepository
+}
+
+func NewGetInvoiceUseCase(invoiceRepo repository.InvoiceRepository) *GetInvoiceUseCase {
+    return &GetInvoiceUseCase{invoiceRepo: invoiceRepo}
+}
+
+func (uc *GetInvoiceUseCase) Execute(ctx context.Context, req *dto.GetInvoiceRequest) (*dto.InvoiceResponse, error) {
+    invoice, err := getVisible(ctx, uc.invoiceRepo, req.InvoiceID, req.UserID)
+    if err != nil {
+        return nil, err
+    }
+    return dto.ToInvoiceResponse(invoice), nil
+}
+
+// getVisible loads an invoice on behalf of userID, who must be its buyer or
+// seller. The seller's commission is hidden from the buyer.
+func getVisible(ctx context.Context, invoiceRepo repository.InvoiceRepository, invoiceID, userID int64) (*entity.Invoice, error) {
+    invoice, err := invoiceRepo.GetByID(ctx, invoiceID)
+    if err != nil {
+        return nil, err
+    }
+    if !invoice.Involves(userID) {
+        return nil, errors.New(errors.ErrorTypeUnauthorized, "only the buyer and the seller can view the invoice", nil)
+    }
+    return redact(invoice, userID), nil
+}
+
+func redact(invoice *entity.Invoice, userID int64) *entity.Invoice {
+    if invoice.SellerID != userID {
+        invoice.Commission = entity.NewMoney(0, invoice.Total.Currency)
+    }
+    return invoice
+}
@@ -0,0 +1,18 @@
+package invoice
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/invoice"
+)
+
+type GetInvoiceUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.GetInvoiceRequest) (*dto.InvoiceResponse, error)
+}
+
+type ListInvoicesUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.ListInvoicesRequest) (*dto.ListInvoicesResponse, error)
+}
+
+type DownloadInvoiceUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.DownloadInvoiceRequest) (*dto.DocumentResponse, error)
+}
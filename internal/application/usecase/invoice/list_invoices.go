@@ -0,0 +1,45 @@
+package invoice
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/invoice"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type ListInvoicesUseCase struct {
+    userRepo    repository.UserRepository
+    invoiceRepo repository.InvoiceRepository
+}
+
+func NewListInvoicesUseCase(userRepo repository.UserRepository, invoiceRepo repository.InvoiceRepository) *ListInvoicesUseCase {
+    return &ListInvoicesUseCase{
+        userRepo:    userRepo,
+        invoiceRepo: invoiceRepo,
+    }
+}
+
+// Execute returns the invoices the user bought or sold on, newest first.
+func (uc *ListInvoicesUseCase) Execute(ctx context.Context, req *dto.ListInvoicesRequest) (*dto.ListInvoicesResponse, error) {
+    if req.PageNumber < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page number must be greater than 0", nil)
+    }
+    if req.PageSize < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page size must be greater than 0", nil)
+    }
+
+    if _, err := uc.userRepo.GetByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+
+    offset := (req.PageNumber - 1) * req.PageSize
+    invoices, total, err := uc.invoiceRepo.GetByUserID(ctx, req.UserID, offset, req.PageSize)
+    if err != nil {
+        return nil, err
+    }
+    for _, invoice := range invoices {
+        redact(invoice, req.UserID)
+    }
+
+    return dto.ToListInvoicesResponse(invoices, total), nil
+}
@@ -3,7 +3,9 @@ package lot
 import (
     "context"
     "auction-system/internal/application/dto/lot"
+    "auction-system/internal/domain/entity"
     "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/errors"
 )
 
 type CreateLotUseCase struct {
@@ -17,7 +19,20 @@ func NewCreateLotUseCase(lotRepo repository.LotRepository) *CreateLotUseCase {
 }
 
 func (uc *CreateLotUseCase) Execute(ctx context.Context, req *lot.CreateLotRequest) (*lot.LotResponse, error) {
+    if req.Quantity < 0 {
+        return nil, errors.New(errors.ErrorTypeValidation, "quantity must not be negative", nil)
+    }
+
+    if !entity.ValidCurrency(req.StartPrice.Currency) {
+        return nil, errors.New(errors.ErrorTypeValidation, "invalid currency", nil)
+    }
+
     lotEntity := req.ToEntity()
+    if lotEntity.Quantity == 0 {
+        lotEntity.Quantity = 1
+    }
+    // The lot is priced in the currency of its start price.
+    lotEntity.Currency = req.StartPrice.Currency
     
     if err := uc.lotRepo.Create(ctx, lotEntity); err != nil {
         return nil, err
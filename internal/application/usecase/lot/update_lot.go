@@ -4,6 +4,7 @@ import (
     "context"
     "auction-system/internal/application/dto/lot"
     "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/errors"
 )
 
 type UpdateLotUseCase struct {
@@ -28,9 +29,15 @@ func (uc *UpdateLotUseCase) Execute(ctx context.Context, id int64, req *lot.Upda
     if req.Description != "" {
         existingLot.Description = req.Description
     }
-    if req.StartPrice > 0 {
+    if req.StartPrice.IsPositive() {
+        if req.StartPrice.Currency != existingLot.Currency {
+            return nil, errors.New(errors.ErrorTypeValidation, "start price must be in the lot currency "+existingLot.Currency, nil)
+        }
         existingLot.StartPrice = req.StartPrice
     }
+    if req.Category != "" {
+        existingLot.Category = req.Category
+    }
 
     updatedLot, err := uc.lotRepo.Update(ctx, id, existingLot)
     if err != nil {
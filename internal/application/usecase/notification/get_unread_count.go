@@ -0,0 +1,25 @@
+package notification
+
+import (
+    "context"
+    "auction-system/internal/domain/repository"
+)
+
+type GetUnreadCountUseCase struct {
+    userRepo         repository.UserRepository
+    notificationRepo repository.NotificationRepository
+}
+
+func NewGetUnreadCountUseCase(userRepo repository.UserRepository, notificationRepo repository.NotificationRepository) *GetUnreadCountUseCase {
+    return &GetUnreadCountUseCase{
+        userRepo:         userRepo,
+        notificationRepo: notificationRepo,
+    }
+}
+
+func (uc *GetUnreadCountUseCase) Execute(ctx context.Context, userID int64) (int64, error) {
+    if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+        return 0, err
+    }
+    return uc.notificationRepo.CountUnread(ctx, userID)
+}
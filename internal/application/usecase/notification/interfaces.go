@@ -0,0 +1,35 @@
+package notification
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/notification"
+    "auction-system/internal/application/eventbus"
+)
+
+type WatchNotificationsUseCaseInterface interface {
+    Execute(ctx context.Context, userID, lastEventID int64) (*eventbus.NotificationSubscription, error)
+}
+
+type ListNotificationsUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.ListNotificationsRequest) (*dto.ListNotificationsResponse, error)
+}
+
+type GetUnreadCountUseCaseInterface interface {
+    Execute(ctx context.Context, userID int64) (int64, error)
+}
+
+type MarkReadUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.MarkReadRequest) (*dto.MarkReadResponse, error)
+}
+
+type MarkAllReadUseCaseInterface interface {
+    Execute(ctx context.Context, userID int64) (*dto.MarkReadResponse, error)
+}
+
+type GetPreferencesUseCaseInterface interface {
+    Execute(ctx context.Context, userID int64) (*dto.PreferencesResponse, error)
+}
+
+type UpdatePreferencesUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.UpdatePreferencesRequest) (*dto.PreferencesResponse, error)
+}
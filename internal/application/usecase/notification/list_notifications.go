@@ -0,0 +1,48 @@
+package notification
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/notification"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type ListNotificationsUseCase struct {
+    userRepo         repository.UserRepository
+    notificationRepo repository.NotificationRepository
+}
+
+func NewListNotificationsUseCase(userRepo repository.UserRepository, notificationRepo repository.NotificationRepository) *ListNotificationsUseCase {
+    return &ListNotificationsUseCase{
+        userRepo:         userRepo,
+        notificationRepo: notificationRepo,
+    }
+}
+
+// Execute returns a page of the user's inbox, newest first, with the number
+// of unread notifications.
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, req *dto.ListNotificationsRequest) (*dto.ListNotificationsResponse, error) {
+    if req.PageNumber < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page number must be greater than 0", nil)
+    }
+    if req.PageSize < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page size must be greater than 0", nil)
+    }
+
+    if _, err := uc.userRepo.GetByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+
+    offset := (req.PageNumber - 1) * req.PageSize
+    notifications, total, err := uc.notificationRepo.GetByUserID(ctx, req.UserID, req.UnreadOnly, offset, req.PageSize)
+    if err != nil {
+        return nil, err
+    }
+
+    unread, err := uc.notificationRepo.CountUnread(ctx, req.UserID)
+    if err != nil {
+        return nil, err
+    }
+
+    return dto.ToListNotificationsResponse(notifications, total, unread), nil
+}
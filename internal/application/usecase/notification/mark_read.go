@@ -0,0 +1,72 @@
+package notification
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/notification"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type MarkReadUseCase struct {
+    userRepo         repository.UserRepository
+    notificationRepo repository.NotificationRepository
+}
+
+func NewMarkReadUseCase(userRepo repository.UserRepository, notificationRepo repository.NotificationRepository) *MarkReadUseCase {
+    return &MarkReadUseCase{
+        userRepo:         userRepo,
+        notificationRepo: notificationRepo,
+    }
+}
+
+// Execute marks the given notifications of the user as read. Marking one
+// that is already read, or that belongs to someone else, does nothing.
+func (uc *MarkReadUseCase) Execute(ctx context.Context, req *dto.MarkReadRequest) (*dto.MarkReadResponse, error) {
+    if len(req.IDs) == 0 {
+        return nil, errors.New(errors.ErrorTypeValidation, "no notifications to mark as read", nil)
+    }
+
+    if _, err := uc.userRepo.GetByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+
+    marked, err := uc.notificationRepo.MarkRead(ctx, req.UserID, req.IDs)
+    if err != nil {
+        return nil, err
+    }
+    return markReadResponse(ctx, uc.notificationRepo, req.UserID, marked)
+}
+
+type MarkAllReadUseCase struct {
+    userRepo         repository.UserRepository
+    notificationRepo repository.NotificationRepository
+}
+
+func NewMarkAllReadUseCase(userRepo repository.UserRepository, notificationRepo repository.NotificationRepository) *MarkAllReadUseCase {
+    return &MarkAllReadUseCase{
+        userRepo:         userRepo,
+        notificationRepo: notificationRepo,
+    }
+}
+
+func (uc *MarkAllReadUseCase) Execute(ctx context.Context, userID int64) (*dto.MarkReadResponse, error) {
+    if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+        return nil, err
+    }
+
+    marked, err := uc.notificationRepo.MarkAllRead(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+    return markReadResponse(ctx, uc.notificationRepo, userID, marked)
+}
+
+// markReadResponse reports the new unread count along with marked, since a
+// notification may have arrived meanwhile.
+func markReadResponse(ctx context.Context, notificationRepo repository.NotificationRepository, userID, marked int64) (*dto.MarkReadResponse, error) {
+    unread, err := notificationRepo.CountUnread(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+    return &dto.MarkReadResponse{MarkedCount: marked, UnreadCount: unread}, nil
+}
@@ -0,0 +1,165 @@
+package notification
+
+import (
+    "context"
+    "fmt"
+    "slices"
+    "strings"
+    "time"
+    dto "auction-system/internal/application/dto/notification"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/repository"
+)
+
+// channelNone turns a notification type off; it cannot be combined with
+// other channels.
+const channelNone = "NONE"
+
+var channels = map[notification.Channel]bool{
+    notification.ChannelInApp:   true,
+    notification.ChannelEmail:   true,
+    notification.ChannelWebhook: true,
+}
+
+var digestFrequencies = map[notification.DigestFrequency]bool{
+    notification.DigestNone:   true,
+    notification.DigestDaily:  true,
+    notification.DigestWeekly: true,
+}
+
+type GetPreferencesUseCase struct {
+    userRepo       repository.UserRepository
+    preferenceRepo repository.NotificationPreferenceRepository
+}
+
+func NewGetPreferencesUseCase(userRepo repository.UserRepository, preferenceRepo repository.NotificationPreferenceRepository) *GetPreferencesUseCase {
+    return &GetPreferencesUseCase{
+        userRepo:       userRepo,
+        preferenceRepo: preferenceRepo,
+    }
+}
+
+func (uc *GetPreferencesUseCase) Execute(ctx context.Context, userID int64) (*dto.PreferencesResponse, error) {
+    if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+        return nil, err
+    }
+
+    preferences, err := uc.preferenceRepo.GetByUserID(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+    return dto.ToPreferencesResponse(preferences), nil
+}
+
+type UpdatePreferencesUseCase struct {
+    userRepo       repository.UserRepository
+    preferenceRepo repository.NotificationPreferenceRepository
+}
+
+func NewUpdatePreferencesUseCase(userRepo repository.UserRepository, preferenceRepo repository.NotificationPreferenceRepository) *UpdatePreferencesUseCase {
+    return &UpdatePreferencesUseCase{
+        userRepo:       userRepo,
+        preferenceRepo: preferenceRepo,
+    }
+}
+
+// Execute replaces the user's preferences. Types that are not listed get
+// the default channels.
+func (uc *UpdatePreferencesUseCase) Execute(ctx context.Context, req *dto.UpdatePreferencesRequest) (*dto.PreferencesResponse, error) {
+    preferences := notification.DefaultPreferences(req.UserID)
+    for _, c := range req.Channels {
+        kind := notification.NotificationType(c.Type)
+        if !slices.Contains(notification.Types, kind) {
+            return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("unknown notification type %q", c.Type), nil)
+        }
+        if _, ok := preferences.Channels[kind]; ok {
+            return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("notification type %s is listed twice", c.Type), nil)
+        }
+        selected, err := parseChannels(c.Channels)
+        if err != nil {
+            return nil, err
+        }
+        preferences.Channels[kind] = selected
+    }
+
+    if req.QuietHours != nil {
+        start, err := parseTimeOfDay(req.QuietHours.Start)
+        if err != nil {
+            return nil, err
+        }
+        end, err := parseTimeOfDay(req.QuietHours.End)
+        if err != nil {
+            return nil, err
+        }
+        if start == end {
+            return nil, errors.New(errors.ErrorTypeValidation, "quiet hours must not start and end at the same time", nil)
+        }
+        preferences.QuietHours = &notification.QuietHours{Start: start, End: end}
+    }
+
+    if req.TimeZone != "" {
+        if _, err := time.LoadLocation(req.TimeZone); err != nil {
+            return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("unknown time zone %q", req.TimeZone), nil)
+        }
+        preferences.TimeZone = req.TimeZone
+    }
+
+    if req.Digest != "" {
+        preferences.Digest = notification.DigestFrequency(req.Digest)
+        if !digestFrequencies[preferences.Digest] {
+            return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("unknown digest frequency %q", req.Digest), nil)
+        }
+    }
+
+    preferences.FollowedCategories = []string{}
+    for _, category := range req.FollowedCategories {
+        category = strings.TrimSpace(category)
+        if category == "" {
+            return nil, errors.New(errors.ErrorTypeValidation, "followed category must not be empty", nil)
+        }
+        if !slices.Contains(preferences.FollowedCategories, category) {
+            preferences.FollowedCategories = append(preferences.FollowedCategories, category)
+        }
+    }
+
+    if _, err := uc.userRepo.GetByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+    if err := uc.preferenceRepo.Save(ctx, preferences); err != nil {
+        return nil, err
+    }
+    return dto.ToPreferencesResponse(preferences), nil
+}
+
+func parseChannels(names []string) ([]notification.Channel, error) {
+    if len(names) == 0 {
+        return nil, errors.New(errors.ErrorTypeValidation, "choose at least one channel, or NONE", nil)
+    }
+    selected := []notification.Channel{}
+    for _, name := range names {
+        if name == channelNone {
+            if len(names) > 1 {
+                return nil, errors.New(errors.ErrorTypeValidation, "NONE cannot be combined with other channels", nil)
+            }
+            continue
+        }
+        channel := notification.Channel(name)
+        if !channels[channel] {
+            return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("unknown notification channel %q", name), nil)
+        }
+        if !slices.Contains(selected, channel) {
+            selected = append(selected, channel)
+        }
+    }
+    return selected, nil
+}
+
+// parseTimeOfDay returns the minutes after midnight of an "HH:MM" time.
+func parseTimeOfDay(value string) (int, error) {
+    t, err := time.Parse("15:04", value)
+    if err != nil {
+        return 0, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("quiet hours time %q is not HH:MM", value), nil)
+    }
+    return t.Hour()*60 + t.Minute(), nil
+}
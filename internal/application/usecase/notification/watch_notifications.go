@@ -0,0 +1,31 @@
+package notification
+
+import (
+    "context"
+    "auction-system/internal/application/eventbus"
+    "auction-system/internal/domain/repository"
+)
+
+type WatchNotificationsUseCase struct {
+    userRepo repository.UserRepository
+    bus      *eventbus.NotificationBus
+}
+
+func NewWatchNotificationsUseCase(userRepo repository.UserRepository, bus *eventbus.NotificationBus) *WatchNotificationsUseCase {
+    return &WatchNotificationsUseCase{
+        userRepo: userRepo,
+        bus:      bus,
+    }
+}
+
+// Execute subscribes to the user's notifications, starting after
+// lastEventID if the user is reconnecting.
+func (uc *WatchNotificationsUseCase) Execute(ctx context.Context, userID, lastEventID int64) (*eventbus.NotificationSubscription, error) {
+    sub := uc.bus.Resume(userID, lastEventID)
+
+    if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+        sub.Close()
+        return nil, err
+    }
+    return sub, nil
+}
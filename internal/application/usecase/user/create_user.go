@@ -3,17 +3,23 @@ package user
 import (
     "context"
     "auction-system/internal/application/dto/user"
+    "auction-system/internal/application/ledger"
+    "auction-system/internal/domain/entity"
 	"auction-system/internal/domain/repository"
 	"auction-system/internal/domain/errors"
 )
 
 type CreateUserUseCase struct {
-    userRepo repository.UserRepository
+    userRepo  repository.UserRepository
+    txManager repository.TxManager
+    ledger    *ledger.Service
 }
 
-func NewCreateUserUseCase(userRepo repository.UserRepository) *CreateUserUseCase {
+func NewCreateUserUseCase(userRepo repository.UserRepository, txManager repository.TxManager, ledger *ledger.Service) *CreateUserUseCase {
     return &CreateUserUseCase{
-        userRepo: userRepo,
+        userRepo:  userRepo,
+        txManager: txManager,
+        ledger:    ledger,
     }
 }
 
@@ -25,7 +31,31 @@ func (uc *CreateUserUseCase) Execute(ctx context.Context, req *user.CreateUserRe
 
     userEntity := req.ToEntity()
 
-    if err := uc.userRepo.Create(ctx, userEntity); err != nil {
+    // The opening balance is posted as a deposit so it shows up in the
+    // user's statement like any other top-up.
+    opening := req.Balance
+    if opening.IsNegative() {
+        return nil, errors.New(errors.ErrorTypeValidation, "opening balance must not be negative", nil)
+    }
+    if opening.IsPositive() && !entity.ValidCurrency(opening.Currency) {
+        return nil, errors.New(errors.ErrorTypeValidation, "invalid currency", nil)
+    }
+
+    err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        if err := uc.userRepo.Create(ctx, userEntity); err != nil {
+            return err
+        }
+        if opening.IsPositive() {
+            if err := uc.ledger.Deposit(ctx, userEntity.ID, opening, "opening balance"); err != nil {
+                return err
+            }
+            wallet := entity.NewWallet(userEntity.ID, opening.Currency)
+            wallet.Balance = opening
+            userEntity.Wallets = []*entity.Wallet{wallet}
+        }
+        return nil
+    })
+    if err != nil {
         return nil, err
     }
 
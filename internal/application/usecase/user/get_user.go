@@ -3,16 +3,21 @@ package user
 import (
     "context"
     userDto "auction-system/internal/application/dto/user"
+    "auction-system/internal/domain/entity"
     "auction-system/internal/domain/repository"
 )
 
 type GetUserUseCase struct {
-    userRepo repository.UserRepository
+    userRepo   repository.UserRepository
+    walletRepo repository.WalletRepository
+    holdRepo   repository.FundHoldRepository
 }
 
-func NewGetUserUseCase(userRepo repository.UserRepository) *GetUserUseCase {
+func NewGetUserUseCase(userRepo repository.UserRepository, walletRepo repository.WalletRepository, holdRepo repository.FundHoldRepository) *GetUserUseCase {
     return &GetUserUseCase{
-        userRepo: userRepo,
+        userRepo:   userRepo,
+        walletRepo: walletRepo,
+        holdRepo:   holdRepo,
     }
 }
 
@@ -22,5 +27,25 @@ func (uc *GetUserUseCase) Execute(ctx context.Context, id int64) (*userDto.UserR
         return nil, err
     }
 
+    if err := loadWallets(ctx, uc.walletRepo, uc.holdRepo, user); err != nil {
+        return nil, err
+    }
+
     return userDto.FromEntity(user), nil
 }
+
+// loadWallets fills in the user's wallets and the funds held in each.
+func loadWallets(ctx context.Context, walletRepo repository.WalletRepository, holdRepo repository.FundHoldRepository, user *entity.User) error {
+    wallets, err := walletRepo.GetByUserID(ctx, user.ID)
+    if err != nil {
+        return err
+    }
+    for _, wallet := range wallets {
+        wallet.HeldBalance, err = holdRepo.GetTotalByUserID(ctx, user.ID, wallet.Currency)
+        if err != nil {
+            return err
+        }
+    }
+    user.Wallets = wallets
+    return nil
+}
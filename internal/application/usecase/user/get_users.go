@@ -7,12 +7,16 @@ import (
 )
 
 type GetAllUserUseCase struct {
-    userRepo repository.UserRepository
+    userRepo   repository.UserRepository
+    walletRepo repository.WalletRepository
+    holdRepo   repository.FundHoldRepository
 }
 
-func NewGetAllUserUseCase(userRepo repository.UserRepository) *GetAllUserUseCase {
+func NewGetAllUserUseCase(userRepo repository.UserRepository, walletRepo repository.WalletRepository, holdRepo repository.FundHoldRepository) *GetAllUserUseCase {
     return &GetAllUserUseCase{
-        userRepo: userRepo,
+        userRepo:   userRepo,
+        walletRepo: walletRepo,
+        holdRepo:   holdRepo,
     }
 }
 
@@ -22,5 +26,11 @@ func (uc *GetAllUserUseCase) Execute(ctx context.Context) (*userDto.UserListResp
         return nil, err
     }
 
+    for _, user := range users {
+        if err := loadWallets(ctx, uc.walletRepo, uc.holdRepo, user); err != nil {
+            return nil, err
+        }
+    }
+
     return userDto.ToUserListResponse(users, int64(len(users))), nil
 }
@@ -28,3 +28,7 @@ type GetAllUsersUseCaseInterface interface {
 type UpdateBalanceUseCaseInterface interface {
     Execute(ctx context.Context, input UpdateBalanceInput) error
 }
+
+type ListTransactionsUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.ListTransactionsRequest) (*dto.TransactionListResponse, error)
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+    "context"
+    userDto "auction-system/internal/application/dto/user"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/errors"
+)
+
+type ListTransactionsUseCase struct {
+    userRepo   repository.UserRepository
+    ledgerRepo repository.LedgerRepository
+}
+
+func NewListTransactionsUseCase(userRepo repository.UserRepository, ledgerRepo repository.LedgerRepository) *ListTransactionsUseCase {
+    return &ListTransactionsUseCase{
+        userRepo:   userRepo,
+        ledgerRepo: ledgerRepo,
+    }
+}
+
+// Execute returns the user's statement in one currency, newest transactions
+// first.
+func (uc *ListTransactionsUseCase) Execute(ctx context.Context, req *userDto.ListTransactionsRequest) (*userDto.TransactionListResponse, error) {
+    if req.PageNumber < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page number must be greater than 0", nil)
+    }
+    if req.PageSize < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page size must be greater than 0", nil)
+    }
+
+    currency := req.Currency
+    if currency == "" {
+        currency = entity.DefaultCurrency
+    }
+    if !entity.ValidCurrency(currency) {
+        return nil, errors.New(errors.ErrorTypeValidation, "invalid currency", nil)
+    }
+
+    if _, err := uc.userRepo.GetByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+
+    account, err := uc.ledgerRepo.GetUserAccount(ctx, req.UserID, currency)
+    if err != nil {
+        return nil, err
+    }
+
+    offset := (req.PageNumber - 1) * req.PageSize
+    lines, total, err := uc.ledgerRepo.ListStatement(ctx, account.ID, offset, req.PageSize)
+    if err != nil {
+        return nil, err
+    }
+
+    balance, err := uc.ledgerRepo.GetBalance(ctx, account.ID)
+    if err != nil {
+        return nil, err
+    }
+
+    return userDto.FromStatement(lines, total, balance), nil
+}
@@ -2,35 +2,53 @@ package user
 
 import (
     "context"
+    "auction-system/internal/application/ledger"
+    "auction-system/internal/domain/entity"
     "auction-system/internal/domain/repository"
-	"auction-system/internal/domain/errors"
+    "auction-system/internal/domain/errors"
 )
 
 type UpdateBalanceUseCase struct {
-    userRepo repository.UserRepository
+    userRepo  repository.UserRepository
+    txManager repository.TxManager
+    ledger    *ledger.Service
 }
 
-func NewUpdateBalanceUseCase(userRepo repository.UserRepository) *UpdateBalanceUseCase {
+func NewUpdateBalanceUseCase(
+    userRepo repository.UserRepository,
+    txManager repository.TxManager,
+    ledger *ledger.Service,
+) *UpdateBalanceUseCase {
     return &UpdateBalanceUseCase{
-        userRepo: userRepo,
+        userRepo:  userRepo,
+        txManager: txManager,
+        ledger:    ledger,
     }
 }
 
 type UpdateBalanceInput struct {
-    UserID  int64   `json:"user_id"`
-    Amount  float64 `json:"amount"`
+    UserID  int64        `json:"user_id"`
+    Amount  entity.Money `json:"amount"`
 }
 
+// Execute records a top-up to the wallet in the amount's currency. Money
+// leaves the platform only through a reviewed withdrawal.
 func (uc *UpdateBalanceUseCase) Execute(ctx context.Context, input UpdateBalanceInput) error {
-    user, err := uc.userRepo.GetByID(ctx, input.UserID)
-    if err != nil {
-        return err
+    if input.Amount.IsNegative() {
+        return errors.New(errors.ErrorTypeValidation, "amount must be positive; use RequestWithdrawal to withdraw funds", nil)
     }
-
-    newBalance := user.Balance + input.Amount
-    if newBalance < 0 {
-        return errors.ErrInsufficientBalance
+    if !input.Amount.IsPositive() {
+        return errors.New(errors.ErrorTypeValidation, "amount must be positive", nil)
+    }
+    if !entity.ValidCurrency(input.Amount.Currency) {
+        return errors.New(errors.ErrorTypeValidation, "invalid currency", nil)
     }
 
-    return uc.userRepo.UpdateBalance(ctx, input.UserID, newBalance)
+    return uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        user, err := uc.userRepo.GetByIDForUpdate(ctx, input.UserID)
+        if err != nil {
+            return err
+        }
+        return uc.ledger.Deposit(ctx, user.ID, input.Amount, "top-up")
+    })
 }
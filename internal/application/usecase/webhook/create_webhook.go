@@ -0,0 +1,116 @@
+package webhook
+
+import (
+    "context"
+    "crypto/rand"
+    "encoding/hex"
+    "fmt"
+    "net"
+    "net/netip"
+    "net/url"
+    "slices"
+    dto "auction-system/internal/application/dto/webhook"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+    webhookDomain "auction-system/internal/domain/webhook"
+)
+
+// minSecretLength keeps chosen secrets from being guessable.
+const minSecretLength = 16
+
+var webhookEventTypes = map[entity.AuctionEventType]bool{
+    entity.AuctionEventBidPlaced:    true,
+    entity.AuctionEventPriceChanged: true,
+    entity.AuctionEventExtended:     true,
+    entity.AuctionEventStarted:      true,
+    entity.AuctionEventEnded:        true,
+}
+
+type CreateWebhookUseCase struct {
+    userRepo     repository.UserRepository
+    webhookRepo  repository.WebhookRepository
+    allowPrivate bool
+}
+
+// NewCreateWebhookUseCase refuses URLs whose host is or resolves to an
+// address that is not webhook.PublicAddress, unless allowPrivate is set.
+// The sender checks the address again when it connects.
+func NewCreateWebhookUseCase(userRepo repository.UserRepository, webhookRepo repository.WebhookRepository, allowPrivate bool) *CreateWebhookUseCase {
+    return &CreateWebhookUseCase{
+        userRepo:     userRepo,
+        webhookRepo:  webhookRepo,
+        allowPrivate: allowPrivate,
+    }
+}
+
+// Execute subscribes the webhook to auction events. The response is the
+// only one that includes the secret, which is generated unless given.
+func (uc *CreateWebhookUseCase) Execute(ctx context.Context, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+    target, err := url.Parse(req.URL)
+    if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+        return nil, errors.New(errors.ErrorTypeValidation, "url must be an absolute http or https URL", nil)
+    }
+    if !uc.allowPrivate {
+        if err := checkPublicHost(ctx, target.Hostname()); err != nil {
+            return nil, err
+        }
+    }
+
+    webhook := &entity.Webhook{
+        UserID: req.UserID,
+        URL:    target.String(),
+        Secret: req.Secret,
+    }
+    for _, t := range req.EventTypes {
+        eventType := entity.AuctionEventType(t)
+        if !webhookEventTypes[eventType] {
+            return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("unknown event type %q", t), nil)
+        }
+        if !slices.Contains(webhook.EventTypes, eventType) {
+            webhook.EventTypes = append(webhook.EventTypes, eventType)
+        }
+    }
+    if webhook.Secret == "" {
+        webhook.Secret = newSecret()
+    } else if len(webhook.Secret) < minSecretLength {
+        return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("secret must be at least %d characters", minSecretLength), nil)
+    }
+
+    if _, err := uc.userRepo.GetByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+    if err := uc.webhookRepo.Create(ctx, webhook); err != nil {
+        return nil, err
+    }
+
+    response := dto.ToWebhookResponse(webhook)
+    response.Secret = webhook.Secret
+    return response, nil
+}
+
+// checkPublicHost verifies that host, an IP address or a name, only leads
+// to public addresses.
+func checkPublicHost(ctx context.Context, host string) error {
+    addrs := []netip.Addr{}
+    if addr, err := netip.ParseAddr(host); err == nil {
+        addrs = append(addrs, addr)
+    } else {
+        addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+        if err != nil {
+            return errors.New(errors.ErrorTypeValidation, fmt.Sprintf("url host %q cannot be resolved", host), err)
+        }
+    }
+    for _, addr := range addrs {
+        if !webhookDomain.PublicAddress(addr) {
+            return errors.New(errors.ErrorTypeValidation, "url must not point to a private, loopback or link-local address", nil)
+        }
+    }
+    return nil
+}
+
+func newSecret() string {
+    secret := make([]byte, 32)
+    rand.Read(secret)
+    return "whsec_" + hex.EncodeToString(secret)
+}
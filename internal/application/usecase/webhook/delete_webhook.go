@@ -0,0 +1,37 @@
+package webhook
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/webhook"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type DeleteWebhookUseCase struct {
+    webhookRepo repository.WebhookRepository
+}
+
+func NewDeleteWebhookUseCase(webhookRepo repository.WebhookRepository) *DeleteWebhookUseCase {
+    return &DeleteWebhookUseCase{webhookRepo: webhookRepo}
+}
+
+func (uc *DeleteWebhookUseCase) Execute(ctx context.Context, req *dto.DeleteWebhookRequest) error {
+    if _, err := getOwnedWebhook(ctx, uc.webhookRepo, req.WebhookID, req.UserID); err != nil {
+        return err
+    }
+    return uc.webhookRepo.Delete(ctx, req.WebhookID)
+}
+
+// getOwnedWebhook returns the webhook if it belongs to userID. Other users'
+// webhooks are reported as missing, so their IDs cannot be probed.
+func getOwnedWebhook(ctx context.Context, webhookRepo repository.WebhookRepository, webhookID, userID int64) (*entity.Webhook, error) {
+    webhook, err := webhookRepo.GetByID(ctx, webhookID)
+    if err != nil {
+        return nil, err
+    }
+    if webhook.UserID != userID {
+        return nil, errors.New(errors.ErrorTypeNotFound, "webhook not found", nil)
+    }
+    return webhook, nil
+}
@@ -0,0 +1,26 @@
+package webhook
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/webhook"
+)
+
+type CreateWebhookUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error)
+}
+
+type ListWebhooksUseCaseInterface interface {
+    Execute(ctx context.Context, userID int64) (*dto.ListWebhooksResponse, error)
+}
+
+type DeleteWebhookUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.DeleteWebhookRequest) error
+}
+
+type ListWebhookDeliveriesUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.ListWebhookDeliveriesRequest) (*dto.ListWebhookDeliveriesResponse, error)
+}
+
+type RedeliverWebhookUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.RedeliverWebhookRequest) (*dto.WebhookDeliveryResponse, error)
+}
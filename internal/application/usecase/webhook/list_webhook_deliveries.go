@@ -0,0 +1,50 @@
+package webhook
+
+import (
+    "context"
+    "fmt"
+    dto "auction-system/internal/application/dto/webhook"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type ListWebhookDeliveriesUseCase struct {
+    webhookRepo  repository.WebhookRepository
+    deliveryRepo repository.WebhookDeliveryRepository
+}
+
+func NewListWebhookDeliveriesUseCase(webhookRepo repository.WebhookRepository, deliveryRepo repository.WebhookDeliveryRepository) *ListWebhookDeliveriesUseCase {
+    return &ListWebhookDeliveriesUseCase{
+        webhookRepo:  webhookRepo,
+        deliveryRepo: deliveryRepo,
+    }
+}
+
+// Execute returns the webhook's delivery log, newest first.
+func (uc *ListWebhookDeliveriesUseCase) Execute(ctx context.Context, req *dto.ListWebhookDeliveriesRequest) (*dto.ListWebhookDeliveriesResponse, error) {
+    if req.PageNumber < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page number must be greater than 0", nil)
+    }
+    if req.PageSize < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page size must be greater than 0", nil)
+    }
+    status := entity.WebhookDeliveryStatus(req.Status)
+    switch status {
+    case "", entity.WebhookDeliveryStatusPending, entity.WebhookDeliveryStatusDelivered, entity.WebhookDeliveryStatusDead:
+    default:
+        return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("unknown delivery status %q", req.Status), nil)
+    }
+
+    if _, err := getOwnedWebhook(ctx, uc.webhookRepo, req.WebhookID, req.UserID); err != nil {
+        return nil, err
+    }
+
+    offset := (req.PageNumber - 1) * req.PageSize
+    deliveries, total, err := uc.deliveryRepo.GetByWebhookID(ctx, req.WebhookID, status, offset, req.PageSize)
+    if err != nil {
+        return nil, err
+    }
+
+    return dto.ToListWebhookDeliveriesResponse(deliveries, total), nil
+}
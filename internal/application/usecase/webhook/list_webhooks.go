@@ -0,0 +1,31 @@
+package webhook
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/webhook"
+    "auction-system/internal/domain/repository"
+)
+
+type ListWebhooksUseCase struct {
+    userRepo    repository.UserRepository
+    webhookRepo repository.WebhookRepository
+}
+
+func NewListWebhooksUseCase(userRepo repository.UserRepository, webhookRepo repository.WebhookRepository) *ListWebhooksUseCase {
+    return &ListWebhooksUseCase{
+        userRepo:    userRepo,
+        webhookRepo: webhookRepo,
+    }
+}
+
+func (uc *ListWebhooksUseCase) Execute(ctx context.Context, userID int64) (*dto.ListWebhooksResponse, error) {
+    if _, err := uc.userRepo.GetByID(ctx, userID); err != nil {
+        return nil, err
+    }
+
+    webhooks, err := uc.webhookRepo.GetByUserID(ctx, userID)
+    if err != nil {
+        return nil, err
+    }
+    return dto.ToListWebhooksResponse(webhooks), nil
+}
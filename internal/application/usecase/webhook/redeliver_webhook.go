@@ -0,0 +1,63 @@
+package webhook
+
+import (
+    "context"
+    "time"
+    dto "auction-system/internal/application/dto/webhook"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// RedeliverWebhookUseCase queues a delivered or dead delivery to be sent
+// again at once, with all its attempts restored. The delivery keeps its ID,
+// so receivers that de-duplicate by it see the same delivery again.
+type RedeliverWebhookUseCase struct {
+    webhookRepo  repository.WebhookRepository
+    deliveryRepo repository.WebhookDeliveryRepository
+    txManager    repository.TxManager
+}
+
+func NewRedeliverWebhookUseCase(
+    webhookRepo repository.WebhookRepository,
+    deliveryRepo repository.WebhookDeliveryRepository,
+    txManager repository.TxManager,
+) *RedeliverWebhookUseCase {
+    return &RedeliverWebhookUseCase{
+        webhookRepo:  webhookRepo,
+        deliveryRepo: deliveryRepo,
+        txManager:    txManager,
+    }
+}
+
+func (uc *RedeliverWebhookUseCase) Execute(ctx context.Context, req *dto.RedeliverWebhookRequest) (*dto.WebhookDeliveryResponse, error) {
+    if _, err := getOwnedWebhook(ctx, uc.webhookRepo, req.WebhookID, req.UserID); err != nil {
+        return nil, err
+    }
+
+    var delivery *entity.WebhookDelivery
+    err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        var err error
+        delivery, err = uc.deliveryRepo.GetByIDForUpdate(ctx, req.DeliveryID)
+        if err != nil {
+            return err
+        }
+        if delivery.WebhookID != req.WebhookID {
+            return errors.New(errors.ErrorTypeNotFound, "webhook delivery not found", nil)
+        }
+        if delivery.Status == entity.WebhookDeliveryStatusPending {
+            return errors.New(errors.ErrorTypeConflict, "delivery is already queued", nil)
+        }
+
+        delivery.Status = entity.WebhookDeliveryStatusPending
+        delivery.Attempts = 0
+        delivery.NextAttemptAt = time.Now()
+        delivery.DeliveredAt = nil
+        return uc.deliveryRepo.Update(ctx, delivery)
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    return dto.ToWebhookDeliveryResponse(delivery), nil
+}
@@ -0,0 +1,40 @@
+package withdrawal
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/withdrawal"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type GetWithdrawalUseCase struct {
+    userRepo       repository.UserRepository
+    withdrawalRepo repository.WithdrawalRepository
+}
+
+func NewGetWithdrawalUseCase(userRepo repository.UserRepository, withdrawalRepo repository.WithdrawalRepository) *GetWithdrawalUseCase {
+    return &GetWithdrawalUseCase{
+        userRepo:       userRepo,
+        withdrawalRepo: withdrawalRepo,
+    }
+}
+
+// Execute returns the withdrawal with its status history.
+func (uc *GetWithdrawalUseCase) Execute(ctx context.Context, req *dto.GetWithdrawalRequest) (*dto.WithdrawalResponse, error) {
+    withdrawal, err := uc.withdrawalRepo.GetByID(ctx, req.WithdrawalID)
+    if err != nil {
+        return nil, err
+    }
+
+    if withdrawal.UserID != req.UserID {
+        user, err := uc.userRepo.GetByID(ctx, req.UserID)
+        if err != nil {
+            return nil, err
+        }
+        if !user.IsAdmin {
+            return nil, errors.New(errors.ErrorTypeUnauthorized, "only the owner and admins can view the withdrawal", nil)
+        }
+    }
+
+    return dto.ToWithdrawalResponse(withdrawal), nil
+}
@@ -0,0 +1,26 @@
+package withdrawal
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/withdrawal"
+)
+
+type RequestWithdrawalUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.RequestWithdrawalRequest) (*dto.WithdrawalResponse, error)
+}
+
+type GetWithdrawalUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.GetWithdrawalRequest) (*dto.WithdrawalResponse, error)
+}
+
+type ListWithdrawalsUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.ListWithdrawalsRequest) (*dto.ListWithdrawalsResponse, error)
+}
+
+type ApproveWithdrawalUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.ApproveWithdrawalRequest) (*dto.WithdrawalResponse, error)
+}
+
+type RejectWithdrawalUseCaseInterface interface {
+    Execute(ctx context.Context, req *dto.RejectWithdrawalRequest) (*dto.WithdrawalResponse, error)
+}
@@ -0,0 +1,42 @@
+package withdrawal
+
+import (
+    "context"
+    dto "auction-system/internal/application/dto/withdrawal"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+type ListWithdrawalsUseCase struct {
+    userRepo       repository.UserRepository
+    withdrawalRepo repository.WithdrawalRepository
+}
+
+func NewListWithdrawalsUseCase(userRepo repository.UserRepository, withdrawalRepo repository.WithdrawalRepository) *ListWithdrawalsUseCase {
+    return &ListWithdrawalsUseCase{
+        userRepo:       userRepo,
+        withdrawalRepo: withdrawalRepo,
+    }
+}
+
+// Execute returns the user's withdrawals, newest first.
+func (uc *ListWithdrawalsUseCase) Execute(ctx context.Context, req *dto.ListWithdrawalsRequest) (*dto.ListWithdrawalsResponse, error) {
+    if req.PageNumber < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page number must be greater than 0", nil)
+    }
+    if req.PageSize < 1 {
+        return nil, errors.New(errors.ErrorTypeValidation, "page size must be greater than 0", nil)
+    }
+
+    if _, err := uc.userRepo.GetByID(ctx, req.UserID); err != nil {
+        return nil, err
+    }
+
+    offset := (req.PageNumber - 1) * req.PageSize
+    withdrawals, total, err := uc.withdrawalRepo.GetByUserID(ctx, req.UserID, offset, req.PageSize)
+    if err != nil {
+        return nil, err
+    }
+
+    return dto.ToListWithdrawalsResponse(withdrawals, total), nil
+}
@@ -0,0 +1,91 @@
+package withdrawal
+
+import (
+    "context"
+    "fmt"
+    "strings"
+    "auction-system/internal/application/ledger"
+    dto "auction-system/internal/application/dto/withdrawal"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// RequestWithdrawalUseCase opens a withdrawal for review. The amount is
+// reserved straight away, so it can neither be bid with nor requested twice
+// while the withdrawal is open.
+type RequestWithdrawalUseCase struct {
+    userRepo       repository.UserRepository
+    walletRepo     repository.WalletRepository
+    holdRepo       repository.FundHoldRepository
+    withdrawalRepo repository.WithdrawalRepository
+    txManager      repository.TxManager
+    ledger         *ledger.Service
+}
+
+func NewRequestWithdrawalUseCase(
+    userRepo repository.UserRepository,
+    walletRepo repository.WalletRepository,
+    holdRepo repository.FundHoldRepository,
+    withdrawalRepo repository.WithdrawalRepository,
+    txManager repository.TxManager,
+    ledger *ledger.Service,
+) *RequestWithdrawalUseCase {
+    return &RequestWithdrawalUseCase{
+        userRepo:       userRepo,
+        walletRepo:     walletRepo,
+        holdRepo:       holdRepo,
+        withdrawalRepo: withdrawalRepo,
+        txManager:      txManager,
+        ledger:         ledger,
+    }
+}
+
+func (uc *RequestWithdrawalUseCase) Execute(ctx context.Context, req *dto.RequestWithdrawalRequest) (*dto.WithdrawalResponse, error) {
+    if !req.Amount.IsPositive() {
+        return nil, errors.New(errors.ErrorTypeValidation, "amount must be positive", nil)
+    }
+    if !entity.ValidCurrency(req.Amount.Currency) {
+        return nil, errors.New(errors.ErrorTypeValidation, "invalid currency", nil)
+    }
+    destination := strings.TrimSpace(req.Destination)
+    if destination == "" {
+        return nil, errors.New(errors.ErrorTypeValidation, "destination is required", nil)
+    }
+
+    withdrawal := &entity.Withdrawal{
+        UserID:      req.UserID,
+        Amount:      req.Amount,
+        Destination: destination,
+        Status:      entity.WithdrawalStatusPending,
+    }
+
+    err := uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        if _, err := uc.userRepo.GetByIDForUpdate(ctx, req.UserID); err != nil {
+            return err
+        }
+
+        // Held funds back leading bids and cannot be withdrawn.
+        wallet, err := uc.walletRepo.Get(ctx, req.UserID, req.Amount.Currency)
+        if err != nil {
+            return err
+        }
+        held, err := uc.holdRepo.GetTotalByUserID(ctx, req.UserID, req.Amount.Currency)
+        if err != nil {
+            return err
+        }
+        if wallet.Balance.Sub(held).LessThan(req.Amount) {
+            return errors.ErrInsufficientBalance
+        }
+
+        if err := uc.withdrawalRepo.Create(ctx, withdrawal); err != nil {
+            return err
+        }
+        return uc.ledger.ReserveWithdrawal(ctx, req.UserID, req.Amount, fmt.Sprintf("withdrawal %d", withdrawal.ID))
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    return dto.ToWithdrawalResponse(withdrawal), nil
+}
@@ -0,0 +1,147 @@
+package withdrawal
+
+import (
+    "context"
+    "fmt"
+    "strings"
+    "auction-system/internal/application/auth"
+    "auction-system/internal/application/ledger"
+    dto "auction-system/internal/application/dto/withdrawal"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/repository"
+)
+
+// ApproveWithdrawalUseCase lets an admin release a pending withdrawal for
+// payout; the payout worker pays it out.
+type ApproveWithdrawalUseCase struct {
+    userRepo       repository.UserRepository
+    withdrawalRepo repository.WithdrawalRepository
+    txManager      repository.TxManager
+}
+
+func NewApproveWithdrawalUseCase(
+    userRepo repository.UserRepository,
+    withdrawalRepo repository.WithdrawalRepository,
+    txManager repository.TxManager,
+) *ApproveWithdrawalUseCase {
+    return &ApproveWithdrawalUseCase{
+        userRepo:       userRepo,
+        withdrawalRepo: withdrawalRepo,
+        txManager:      txManager,
+    }
+}
+
+func (uc *ApproveWithdrawalUseCase) Execute(ctx context.Context, req *dto.ApproveWithdrawalRequest) (*dto.WithdrawalResponse, error) {
+    reviewerID, err := reviewer(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    var withdrawal *entity.Withdrawal
+    err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        var err error
+        withdrawal, err = getForReview(ctx, uc.userRepo, uc.withdrawalRepo, req.WithdrawalID, reviewerID)
+        if err != nil {
+            return err
+        }
+
+        withdrawal.Status = entity.WithdrawalStatusApproved
+        withdrawal.ReviewerID = &reviewerID
+        return uc.withdrawalRepo.UpdateStatus(ctx, withdrawal, &reviewerID)
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    return dto.ToWithdrawalResponse(withdrawal), nil
+}
+
+// RejectWithdrawalUseCase lets an admin turn down a pending withdrawal,
+// returning the reserved amount to the user.
+type RejectWithdrawalUseCase struct {
+    userRepo       repository.UserRepository
+    withdrawalRepo repository.WithdrawalRepository
+    txManager      repository.TxManager
+    ledger         *ledger.Service
+}
+
+func NewRejectWithdrawalUseCase(
+    userRepo repository.UserRepository,
+    withdrawalRepo repository.WithdrawalRepository,
+    txManager repository.TxManager,
+    ledger *ledger.Service,
+) *RejectWithdrawalUseCase {
+    return &RejectWithdrawalUseCase{
+        userRepo:       userRepo,
+        withdrawalRepo: withdrawalRepo,
+        txManager:      txManager,
+        ledger:         ledger,
+    }
+}
+
+func (uc *RejectWithdrawalUseCase) Execute(ctx context.Context, req *dto.RejectWithdrawalRequest) (*dto.WithdrawalResponse, error) {
+    reviewerID, err := reviewer(ctx)
+    if err != nil {
+        return nil, err
+    }
+    reason := strings.TrimSpace(req.Reason)
+    if reason == "" {
+        return nil, errors.New(errors.ErrorTypeValidation, "reason is required", nil)
+    }
+
+    var withdrawal *entity.Withdrawal
+    err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        var err error
+        withdrawal, err = getForReview(ctx, uc.userRepo, uc.withdrawalRepo, req.WithdrawalID, reviewerID)
+        if err != nil {
+            return err
+        }
+
+        withdrawal.Status = entity.WithdrawalStatusRejected
+        withdrawal.ReviewerID = &reviewerID
+        withdrawal.Note = reason
+        if err := uc.ledger.ReleaseWithdrawal(ctx, withdrawal.UserID, withdrawal.Amount, fmt.Sprintf("withdrawal %d rejected", withdrawal.ID)); err != nil {
+            return err
+        }
+        return uc.withdrawalRepo.UpdateStatus(ctx, withdrawal, &reviewerID)
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    return dto.ToWithdrawalResponse(withdrawal), nil
+}
+
+// reviewer returns the user the review request was authenticated as.
+func reviewer(ctx context.Context) (int64, error) {
+    reviewerID, ok := auth.UserID(ctx)
+    if !ok {
+        return 0, errors.New(errors.ErrorTypeUnauthorized, "reviewing withdrawals requires an authenticated admin", nil)
+    }
+    return reviewerID, nil
+}
+
+// getForReview locks a pending withdrawal for reviewerID, who must be an
+// admin other than the withdrawal's owner.
+func getForReview(ctx context.Context, userRepo repository.UserRepository, withdrawalRepo repository.WithdrawalRepository, withdrawalID, reviewerID int64) (*entity.Withdrawal, error) {
+    reviewer, err := userRepo.GetByID(ctx, reviewerID)
+    if err != nil {
+        return nil, err
+    }
+    if !reviewer.IsAdmin {
+        return nil, errors.New(errors.ErrorTypeUnauthorized, "only admins can review withdrawals", nil)
+    }
+
+    withdrawal, err := withdrawalRepo.GetByIDForUpdate(ctx, withdrawalID)
+    if err != nil {
+        return nil, err
+    }
+    if withdrawal.UserID == reviewerID {
+        return nil, errors.New(errors.ErrorTypeUnauthorized, "admins cannot review their own withdrawals", nil)
+    }
+    if withdrawal.Status != entity.WithdrawalStatusPending {
+        return nil, errors.New(errors.ErrorTypeConflict, fmt.Sprintf("withdrawal is %s, not PENDING", withdrawal.Status), nil)
+    }
+    return withdrawal, nil
+}
@@ -0,0 +1,150 @@
+package webhook
+
+import (
+    "context"
+    "log"
+    "time"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/webhook"
+)
+
+// RetryPolicy spaces out the attempts of a delivery. The delay doubles
+// after every failure, starting at Backoff and capped at MaxBackoff.
+type RetryPolicy struct {
+    MaxAttempts int
+    Backoff     time.Duration
+    MaxBackoff  time.Duration
+}
+
+func (p RetryPolicy) delay(attempts int) time.Duration {
+    d := p.Backoff
+    for i := 1; i < attempts && d < p.MaxBackoff; i++ {
+        d *= 2
+    }
+    return min(d, p.MaxBackoff)
+}
+
+// Deliverer sends the queued webhook deliveries. A delivery the receiver
+// does not accept is retried on the policy's schedule and is DEAD once it
+// runs out of attempts.
+type Deliverer struct {
+    webhookRepo  repository.WebhookRepository
+    deliveryRepo repository.WebhookDeliveryRepository
+    sender       webhook.Sender
+    txManager    repository.TxManager
+    policy       RetryPolicy
+}
+
+func NewDeliverer(
+    webhookRepo repository.WebhookRepository,
+    deliveryRepo repository.WebhookDeliveryRepository,
+    sender webhook.Sender,
+    txManager repository.TxManager,
+    policy RetryPolicy,
+) *Deliverer {
+    return &Deliverer{
+        webhookRepo:  webhookRepo,
+        deliveryRepo: deliveryRepo,
+        sender:       sender,
+        txManager:    txManager,
+        policy:       policy,
+    }
+}
+
+const deliveryBatchSize = 50
+
+// ProcessDue attempts every delivery that is due at now.
+func (d *Deliverer) ProcessDue(ctx context.Context, now time.Time) error {
+    deliveries, err := d.deliveryRepo.GetDue(ctx, now, deliveryBatchSize)
+    if err != nil {
+        return err
+    }
+
+    for _, delivery := range deliveries {
+        if err := d.process(ctx, delivery.ID, now); err != nil {
+            log.Printf("Error processing webhook delivery %d: %v", delivery.ID, err)
+        }
+    }
+    return nil
+}
+
+// process sends one delivery. No transaction is open while the receiver
+// is called: the attempt is first claimed and committed, leasing the
+// delivery to this worker until its retry would be due, and its result is
+// recorded in a second transaction. If the worker stops in between, the
+// delivery is sent again once the lease runs out.
+func (d *Deliverer) process(ctx context.Context, deliveryID int64, now time.Time) error {
+    delivery, hook, err := d.claim(ctx, deliveryID, now)
+    if err != nil || delivery == nil {
+        return err
+    }
+
+    statusCode, sendErr := d.sender.Send(ctx, hook, delivery)
+
+    return d.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        current, err := d.deliveryRepo.GetByIDForUpdate(ctx, deliveryID)
+        if err != nil {
+            return err
+        }
+        // The lease ran out and another worker has taken the delivery over.
+        if current.Status != entity.WebhookDeliveryStatusPending || current.Attempts != delivery.Attempts {
+            return nil
+        }
+
+        current.LastStatusCode = statusCode
+        if sendErr != nil {
+            current.LastError = sendErr.Error()
+            if current.Attempts >= d.policy.MaxAttempts {
+                current.Status = entity.WebhookDeliveryStatusDead
+            }
+            return d.deliveryRepo.Update(ctx, current)
+        }
+
+        current.Status = entity.WebhookDeliveryStatusDelivered
+        current.LastError = ""
+        current.DeliveredAt = &now
+        return d.deliveryRepo.Update(ctx, current)
+    })
+}
+
+// claim counts an attempt of the delivery and schedules the next one, so
+// that no other worker sends it meanwhile. It returns nil if the delivery
+// is not due. A delivery whose last attempt never recorded a result is DEAD.
+func (d *Deliverer) claim(ctx context.Context, deliveryID int64, now time.Time) (*entity.WebhookDelivery, *entity.Webhook, error) {
+    var delivery *entity.WebhookDelivery
+    var hook *entity.Webhook
+    err := d.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        delivery, hook = nil, nil
+
+        claimed, err := d.deliveryRepo.GetByIDForUpdate(ctx, deliveryID)
+        if err != nil {
+            return err
+        }
+        // Another worker may have sent the delivery since it was listed.
+        if claimed.Status != entity.WebhookDeliveryStatusPending || claimed.NextAttemptAt.After(now) {
+            return nil
+        }
+        if claimed.Attempts >= d.policy.MaxAttempts {
+            claimed.Status = entity.WebhookDeliveryStatusDead
+            return d.deliveryRepo.Update(ctx, claimed)
+        }
+
+        found, err := d.webhookRepo.GetByID(ctx, claimed.WebhookID)
+        if err != nil {
+            return err
+        }
+
+        claimed.Attempts++
+        claimed.NextAttemptAt = now.Add(d.policy.delay(claimed.Attempts))
+        if err := d.deliveryRepo.Update(ctx, claimed); err != nil {
+            return err
+        }
+        delivery, hook = claimed, found
+        return nil
+    })
+    if err != nil {
+        return nil, nil, err
+    }
+    return delivery, hook, nil
+}
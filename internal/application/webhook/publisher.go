@@ -0,0 +1,38 @@
+package webhook
+
+import (
+    "context"
+    "encoding/json"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/event"
+    "auction-system/internal/domain/repository"
+)
+
+// Publisher queues a delivery of every auction event to the webhooks that
+// want it. It publishes through next first, which numbers the event, and
+// queues the deliveries on the caller's transaction, so they are sent only
+// if the change the event describes commits.
+type Publisher struct {
+    next       event.Publisher
+    deliveries repository.WebhookDeliveryRepository
+}
+
+func NewPublisher(next event.Publisher, deliveries repository.WebhookDeliveryRepository) event.Publisher {
+    return &Publisher{
+        next:       next,
+        deliveries: deliveries,
+    }
+}
+
+func (p *Publisher) Publish(ctx context.Context, e *entity.AuctionEvent) error {
+    if err := p.next.Publish(ctx, e); err != nil {
+        return err
+    }
+
+    payload, err := json.Marshal(e)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to encode webhook payload", err)
+    }
+    return p.deliveries.CreateForEvent(ctx, e, payload)
+}
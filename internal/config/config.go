@@ -5,14 +5,27 @@ import (
 	"github.com/joho/godotenv"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	HTTP     HTTPConfig
-	GRPC     GRPCConfig
+	Server      ServerConfig
+	Database    DatabaseConfig
+	HTTP        HTTPConfig
+	GRPC        GRPCConfig
+	Auction     AuctionConfig
+	Exchange    ExchangeConfig
+	Payment     PaymentConfig
+	Fees        FeeConfig
+	Invoice     InvoiceConfig
+	Idempotency IdempotencyConfig
+	Admin       AdminConfig
+	Events      EventsConfig
+	Notifications NotificationsConfig
+	Email       EmailConfig
+	Webhooks    WebhooksConfig
+	Digest      DigestConfig
 }
 
 type ServerConfig struct {
@@ -41,6 +54,122 @@ type GRPCConfig struct {
 	Port int
 }
 
+type AuctionConfig struct {
+	// BuyNowDisablePercent switches buy-now off once a bid exceeds this
+	// percentage of the buy-now price.
+	BuyNowDisablePercent float64
+}
+
+type ExchangeConfig struct {
+	// RatesFile is a JSON file with display exchange rates. The built-in
+	// rates are used when it is empty.
+	RatesFile string
+}
+
+type PaymentConfig struct {
+	// MaxAttempts is how many times a winner is charged before the sale is
+	// called off.
+	MaxAttempts int
+	// RetryBackoff is the delay after the first failed attempt; it doubles
+	// with every further failure up to MaxRetryBackoff.
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+}
+
+type FeeConfig struct {
+	// ScheduleFile is a JSON fee schedule; without one no fees are charged.
+	ScheduleFile string
+}
+
+type InvoiceConfig struct {
+	// TaxPercent is the tax rate included in invoiced prices.
+	TaxPercent float64
+	// TemplateDir holds invoice.txt.tmpl and invoice.html.tmpl; the
+	// built-in templates are used when it is empty.
+	TemplateDir string
+	// FontFile is a TrueType font for PDF invoices, needed for text outside
+	// Western European scripts.
+	FontFile string
+}
+
+type IdempotencyConfig struct {
+	// KeyTTL is how long a request's idempotency key protects it against
+	// being repeated.
+	KeyTTL time.Duration
+}
+
+type AdminConfig struct {
+	// Tokens maps the bearer tokens admins authenticate with to their
+	// user IDs. Without any, withdrawals cannot be reviewed.
+	Tokens map[string]int64
+}
+
+type EventsConfig struct {
+	// SubscriberBuffer is how many events a watcher may fall behind before
+	// it is disconnected.
+	SubscriberBuffer int
+	// ReplayBuffer is how many recent events are kept for clients that
+	// reconnect with the ID of the last event they received.
+	ReplayBuffer int
+	// Heartbeat is how often SSE and WebSocket clients are pinged.
+	Heartbeat time.Duration
+	// AllowedOrigins are the browser origins besides the server's own that
+	// may open a WebSocket; "*" allows any.
+	AllowedOrigins []string
+	// UnauthenticatedNotifications serves users' notifications over the
+	// WebSocket to anyone who asks for them, as requests are not
+	// authenticated.
+	UnauthenticatedNotifications bool
+}
+
+type NotificationsConfig struct {
+	// QueueSize is how many notifications may wait to be sent before new
+	// ones are dropped.
+	QueueSize int
+	// Workers is how many notifications are sent at once.
+	Workers int
+	// Timeout bounds the sending of one notification.
+	Timeout time.Duration
+}
+
+type EmailConfig struct {
+	// SMTPHost is the mail server; notifications are not emailed when it
+	// is empty.
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	// SMTPTLS is "starttls", "tls" for implicit TLS, or "none".
+	SMTPTLS string
+	// From is the sender address, e.g. "Auctions <noreply@example.com>".
+	From string
+	// Locale selects the language of the email templates.
+	Locale string
+	// TemplateDir holds a subdirectory of email templates per locale; the
+	// built-in templates are used when it is empty.
+	TemplateDir string
+}
+
+type WebhooksConfig struct {
+	// MaxAttempts is how many times a delivery is tried before it is dead.
+	MaxAttempts int
+	// RetryBackoff is the delay after the first failed attempt; it doubles
+	// with every further failure up to MaxRetryBackoff.
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// Timeout bounds one delivery request.
+	Timeout time.Duration
+	// AllowPrivateNetworks lets webhooks point to loopback, link-local and
+	// private addresses, e.g. for local development.
+	AllowPrivateNetworks bool
+}
+
+type DigestConfig struct {
+	// Hour is the local hour of day at which digests are sent, in each
+	// user's time zone.
+	Hour int
+}
+
 func LoadConfig() (*Config, error) {
 	if err := godotenv.Load(); err != nil {
 		return nil, fmt.Errorf("error loading .env file: %w", err)
@@ -92,6 +221,163 @@ func LoadConfig() (*Config, error) {
 	}
 	cfg.GRPC.Port = grpcPort
 
+	buyNowDisablePercent, err := strconv.ParseFloat(getEnvOrDefault("BUY_NOW_DISABLE_PERCENT", "50"), 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BUY_NOW_DISABLE_PERCENT: %w", err)
+	}
+	cfg.Auction.BuyNowDisablePercent = buyNowDisablePercent
+
+	cfg.Exchange.RatesFile = os.Getenv("EXCHANGE_RATES_FILE")
+	cfg.Fees.ScheduleFile = os.Getenv("FEE_SCHEDULE_FILE")
+
+	taxPercent, err := strconv.ParseFloat(getEnvOrDefault("INVOICE_TAX_PERCENT", "0"), 64)
+	if err != nil || taxPercent < 0 || taxPercent > 100 {
+		return nil, fmt.Errorf("invalid INVOICE_TAX_PERCENT: %q", os.Getenv("INVOICE_TAX_PERCENT"))
+	}
+	cfg.Invoice.TaxPercent = taxPercent
+	cfg.Invoice.TemplateDir = os.Getenv("INVOICE_TEMPLATE_DIR")
+	cfg.Invoice.FontFile = os.Getenv("INVOICE_FONT_FILE")
+
+	maxAttempts, err := strconv.Atoi(getEnvOrDefault("PAYMENT_MAX_ATTEMPTS", "5"))
+	if err != nil || maxAttempts < 1 {
+		return nil, fmt.Errorf("invalid PAYMENT_MAX_ATTEMPTS: %q", os.Getenv("PAYMENT_MAX_ATTEMPTS"))
+	}
+	cfg.Payment.MaxAttempts = maxAttempts
+
+	retryBackoff, err := time.ParseDuration(getEnvOrDefault("PAYMENT_RETRY_BACKOFF", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PAYMENT_RETRY_BACKOFF: %w", err)
+	}
+	cfg.Payment.RetryBackoff = retryBackoff
+
+	maxRetryBackoff, err := time.ParseDuration(getEnvOrDefault("PAYMENT_MAX_RETRY_BACKOFF", "30m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PAYMENT_MAX_RETRY_BACKOFF: %w", err)
+	}
+	cfg.Payment.MaxRetryBackoff = maxRetryBackoff
+
+	keyTTL, err := time.ParseDuration(getEnvOrDefault("IDEMPOTENCY_KEY_TTL", "24h"))
+	if err != nil || keyTTL <= 0 {
+		return nil, fmt.Errorf("invalid IDEMPOTENCY_KEY_TTL: %q", os.Getenv("IDEMPOTENCY_KEY_TTL"))
+	}
+	cfg.Idempotency.KeyTTL = keyTTL
+
+	subscriberBuffer, err := strconv.Atoi(getEnvOrDefault("EVENTS_SUBSCRIBER_BUFFER", "64"))
+	if err != nil || subscriberBuffer < 1 {
+		return nil, fmt.Errorf("invalid EVENTS_SUBSCRIBER_BUFFER: %q", os.Getenv("EVENTS_SUBSCRIBER_BUFFER"))
+	}
+	cfg.Events.SubscriberBuffer = subscriberBuffer
+
+	replayBuffer, err := strconv.Atoi(getEnvOrDefault("EVENTS_REPLAY_BUFFER", "1000"))
+	if err != nil || replayBuffer < 0 {
+		return nil, fmt.Errorf("invalid EVENTS_REPLAY_BUFFER: %q", os.Getenv("EVENTS_REPLAY_BUFFER"))
+	}
+	cfg.Events.ReplayBuffer = replayBuffer
+
+	heartbeat, err := time.ParseDuration(getEnvOrDefault("EVENTS_HEARTBEAT_INTERVAL", "15s"))
+	if err != nil || heartbeat <= 0 {
+		return nil, fmt.Errorf("invalid EVENTS_HEARTBEAT_INTERVAL: %q", os.Getenv("EVENTS_HEARTBEAT_INTERVAL"))
+	}
+	cfg.Events.Heartbeat = heartbeat
+
+	for _, origin := range strings.Split(os.Getenv("EVENTS_ALLOWED_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			cfg.Events.AllowedOrigins = append(cfg.Events.AllowedOrigins, origin)
+		}
+	}
+
+	cfg.Admin.Tokens = make(map[string]int64)
+	for _, entry := range strings.Split(os.Getenv("ADMIN_TOKENS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		userID, token, ok := strings.Cut(entry, ":")
+		id, err := strconv.ParseInt(userID, 10, 64)
+		if !ok || err != nil || id <= 0 || token == "" {
+			return nil, fmt.Errorf("invalid ADMIN_TOKENS entry: want <user id>:<token>")
+		}
+		cfg.Admin.Tokens[token] = id
+	}
+
+	unauthenticatedNotifications, err := strconv.ParseBool(getEnvOrDefault("EVENTS_UNAUTHENTICATED_NOTIFICATIONS", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid EVENTS_UNAUTHENTICATED_NOTIFICATIONS: %q", os.Getenv("EVENTS_UNAUTHENTICATED_NOTIFICATIONS"))
+	}
+	cfg.Events.UnauthenticatedNotifications = unauthenticatedNotifications
+
+	queueSize, err := strconv.Atoi(getEnvOrDefault("NOTIFICATIONS_QUEUE_SIZE", "1000"))
+	if err != nil || queueSize < 1 {
+		return nil, fmt.Errorf("invalid NOTIFICATIONS_QUEUE_SIZE: %q", os.Getenv("NOTIFICATIONS_QUEUE_SIZE"))
+	}
+	cfg.Notifications.QueueSize = queueSize
+
+	workers, err := strconv.Atoi(getEnvOrDefault("NOTIFICATIONS_WORKERS", "4"))
+	if err != nil || workers < 1 {
+		return nil, fmt.Errorf("invalid NOTIFICATIONS_WORKERS: %q", os.Getenv("NOTIFICATIONS_WORKERS"))
+	}
+	cfg.Notifications.Workers = workers
+
+	notificationTimeout, err := time.ParseDuration(getEnvOrDefault("NOTIFICATIONS_TIMEOUT", "10s"))
+	if err != nil || notificationTimeout <= 0 {
+		return nil, fmt.Errorf("invalid NOTIFICATIONS_TIMEOUT: %q", os.Getenv("NOTIFICATIONS_TIMEOUT"))
+	}
+	cfg.Notifications.Timeout = notificationTimeout
+
+	cfg.Email.SMTPHost = os.Getenv("SMTP_HOST")
+	smtpPort, err := strconv.Atoi(getEnvOrDefault("SMTP_PORT", "587"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP_PORT: %w", err)
+	}
+	cfg.Email.SMTPPort = smtpPort
+	cfg.Email.SMTPUsername = os.Getenv("SMTP_USERNAME")
+	cfg.Email.SMTPPassword = os.Getenv("SMTP_PASSWORD")
+	cfg.Email.SMTPTLS = getEnvOrDefault("SMTP_TLS", "starttls")
+	switch cfg.Email.SMTPTLS {
+	case "starttls", "tls", "none":
+	default:
+		return nil, fmt.Errorf("invalid SMTP_TLS: %q", cfg.Email.SMTPTLS)
+	}
+	cfg.Email.From = getEnvOrDefault("EMAIL_FROM", "Auctions <noreply@localhost>")
+	cfg.Email.Locale = getEnvOrDefault("EMAIL_LOCALE", "en")
+	cfg.Email.TemplateDir = os.Getenv("EMAIL_TEMPLATE_DIR")
+
+	webhookMaxAttempts, err := strconv.Atoi(getEnvOrDefault("WEBHOOK_MAX_ATTEMPTS", "8"))
+	if err != nil || webhookMaxAttempts < 1 {
+		return nil, fmt.Errorf("invalid WEBHOOK_MAX_ATTEMPTS: %q", os.Getenv("WEBHOOK_MAX_ATTEMPTS"))
+	}
+	cfg.Webhooks.MaxAttempts = webhookMaxAttempts
+
+	webhookBackoff, err := time.ParseDuration(getEnvOrDefault("WEBHOOK_RETRY_BACKOFF", "30s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid WEBHOOK_RETRY_BACKOFF: %w", err)
+	}
+	cfg.Webhooks.RetryBackoff = webhookBackoff
+
+	webhookMaxBackoff, err := time.ParseDuration(getEnvOrDefault("WEBHOOK_MAX_RETRY_BACKOFF", "1h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid WEBHOOK_MAX_RETRY_BACKOFF: %w", err)
+	}
+	cfg.Webhooks.MaxRetryBackoff = webhookMaxBackoff
+
+	webhookTimeout, err := time.ParseDuration(getEnvOrDefault("WEBHOOK_TIMEOUT", "10s"))
+	if err != nil || webhookTimeout <= 0 {
+		return nil, fmt.Errorf("invalid WEBHOOK_TIMEOUT: %q", os.Getenv("WEBHOOK_TIMEOUT"))
+	}
+	cfg.Webhooks.Timeout = webhookTimeout
+
+	webhookAllowPrivate, err := strconv.ParseBool(getEnvOrDefault("WEBHOOK_ALLOW_PRIVATE_NETWORKS", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid WEBHOOK_ALLOW_PRIVATE_NETWORKS: %q", os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS"))
+	}
+	cfg.Webhooks.AllowPrivateNetworks = webhookAllowPrivate
+
+	digestHour, err := strconv.Atoi(getEnvOrDefault("DIGEST_HOUR", "8"))
+	if err != nil || digestHour < 0 || digestHour > 23 {
+		return nil, fmt.Errorf("invalid DIGEST_HOUR: %q", os.Getenv("DIGEST_HOUR"))
+	}
+	cfg.Digest.Hour = digestHour
+
 	return cfg, nil
 }
 
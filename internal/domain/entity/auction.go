@@ -7,14 +7,46 @@ import (
 type Auction struct {
     ID           int64     `json:"id"`
     LotID        int64     `json:"lot_id"`
-    StartPrice   float64   `json:"start_price"`
-    MinStep      float64   `json:"min_step"`
-    CurrentPrice float64   `json:"current_price"`
+    Type         AuctionType `json:"type"`
+    // Currency is copied from the lot; every price and bid of the auction
+    // is in this currency.
+    Currency     string    `json:"currency"`
+    StartPrice   Money     `json:"start_price"`
+    ReservePrice Money     `json:"-"`
+    BuyNowPrice  Money     `json:"buy_now_price,omitempty"`
+    // BuyNowDisabled is set once bidding has come close enough to BuyNowPrice.
+    BuyNowDisabled bool    `json:"buy_now_disabled"`
+    MinStep      Money     `json:"min_step"`
+    CurrentPrice Money     `json:"current_price"`
     StartTime    time.Time `json:"start_time"`
     EndTime      time.Time `json:"end_time"`
     Status       AuctionStatus `json:"status"`
     WinnerID     *int64    `json:"winner_id,omitempty"`
     WinnerBidID  *int64    `json:"winner_bid_id,omitempty"`
+
+    // Quantity is copied from the lot; auctions with more than one unit
+    // allocate units to several winners according to Pricing.
+    Quantity     int              `json:"quantity"`
+    Pricing      MultiUnitPricing `json:"pricing"`
+    Winners      []*AuctionWinner `json:"winners,omitempty"`
+    // Fees totals the fee breakdown of the auction's payments once it is
+    // sold.
+    Fees         *Fees            `json:"fees,omitempty"`
+
+    // Soft close: a bid placed within ExtensionWindow of EndTime pushes
+    // EndTime back by ExtensionDuration, until TotalExtension reaches
+    // MaxExtension. A zero MaxExtension means no limit.
+    ExtensionWindow   time.Duration `json:"extension_window"`
+    ExtensionDuration time.Duration `json:"extension_duration"`
+    MaxExtension      time.Duration `json:"max_extension"`
+    TotalExtension    time.Duration `json:"total_extension"`
+
+    // Dutch auctions start at StartPrice and drop by PriceDecrement every
+    // DecrementInterval, never going below FloorPrice.
+    PriceDecrement    Money         `json:"price_decrement,omitempty"`
+    DecrementInterval time.Duration `json:"decrement_interval,omitempty"`
+    FloorPrice        Money         `json:"floor_price,omitempty"`
+
     CreatedAt    time.Time `json:"created_at"`
     UpdatedAt    time.Time `json:"updated_at"`
 }
@@ -27,4 +59,227 @@ const (
     AuctionStatusEnded     AuctionStatus = "ENDED"
     AuctionStatusCompleted AuctionStatus = "COMPLETED"
     AuctionStatusCanceled  AuctionStatus = "CANCELED"
+    // AuctionStatusReserveNotMet marks an auction that ended below its reserve price.
+    AuctionStatusReserveNotMet AuctionStatus = "RESERVE_NOT_MET"
+    // AuctionStatusPaymentFailed marks an auction whose winners could not be
+    // charged; any payments already captured for it are refunded.
+    AuctionStatusPaymentFailed AuctionStatus = "PAYMENT_FAILED"
+)
+
+type AuctionType string
+
+const (
+    AuctionTypeEnglish AuctionType = "ENGLISH"
+    AuctionTypeDutch   AuctionType = "DUTCH"
+    // Sealed-bid auctions hide bids until close. The winner pays their own
+    // bid (first-price) or the runner-up's bid plus MinStep (second-price).
+    AuctionTypeSealedFirstPrice  AuctionType = "SEALED_FIRST_PRICE"
+    AuctionTypeSealedSecondPrice AuctionType = "SEALED_SECOND_PRICE"
 )
+
+// MultiUnitPricing selects what each winner of a multi-unit auction pays.
+type MultiUnitPricing string
+
+const (
+    // MultiUnitPricingUniform charges every winner the lowest winning bid.
+    MultiUnitPricingUniform MultiUnitPricing = "UNIFORM"
+    // MultiUnitPricingDiscriminatory charges every winner their own bid.
+    MultiUnitPricingDiscriminatory MultiUnitPricing = "DISCRIMINATORY"
+)
+
+// prices returns every amount stored on the auction.
+func (a *Auction) prices() []*Money {
+    return []*Money{
+        &a.StartPrice,
+        &a.ReservePrice,
+        &a.BuyNowPrice,
+        &a.MinStep,
+        &a.CurrentPrice,
+        &a.PriceDecrement,
+        &a.FloorPrice,
+    }
+}
+
+// PricesIn reports whether every price that is set is in currency.
+func (a *Auction) PricesIn(currency string) bool {
+    for _, p := range a.prices() {
+        if !p.IsZero() && p.Currency != currency {
+            return false
+        }
+    }
+    return true
+}
+
+// SetCurrency puts the auction and all of its prices in currency.
+func (a *Auction) SetCurrency(currency string) {
+    a.Currency = currency
+    for _, p := range a.prices() {
+        p.Currency = currency
+    }
+}
+
+// IsMultiUnit reports whether the auction sells more than one unit.
+func (a *Auction) IsMultiUnit() bool {
+    return a.Quantity > 1
+}
+
+// Allocate distributes the auction's units among bids ordered best first and
+// returns one winner per bid that received units. Bids below the reserve
+// price win nothing, so an empty result with bids present means the reserve
+// was not met.
+func (a *Auction) Allocate(bids []*Bid) []*AuctionWinner {
+    if !a.IsMultiUnit() {
+        if len(bids) == 0 || (a.HasReserve() && bids[0].Amount.LessThan(a.ReservePrice)) {
+            return nil
+        }
+        return []*AuctionWinner{a.newWinner(bids[0], 1, a.ClearingPrice(bids))}
+    }
+
+    var winners []*AuctionWinner
+    remaining := a.Quantity
+    for _, bid := range bids {
+        if remaining == 0 || (a.HasReserve() && bid.Amount.LessThan(a.ReservePrice)) {
+            break
+        }
+        quantity := min(bid.Quantity, remaining)
+        winners = append(winners, a.newWinner(bid, quantity, bid.Amount))
+        remaining -= quantity
+    }
+
+    if a.Pricing == MultiUnitPricingUniform && len(winners) > 0 {
+        clearing := winners[len(winners)-1].UnitPrice
+        for _, w := range winners {
+            w.UnitPrice = clearing
+        }
+    }
+    return winners
+}
+
+func (a *Auction) newWinner(bid *Bid, quantity int, unitPrice Money) *AuctionWinner {
+    return &AuctionWinner{
+        AuctionID: a.ID,
+        UserID:    bid.UserID,
+        BidID:     bid.ID,
+        Quantity:  quantity,
+        UnitPrice: unitPrice,
+    }
+}
+
+// Won returns how many units userID won when the auction closed and what
+// they cost before fees, summed over the user's winning bids.
+func (a *Auction) Won(userID int64) (int, Money) {
+    var quantity int
+    var total Money
+    for _, w := range a.Winners {
+        if w.UserID == userID {
+            quantity += w.Quantity
+            total = total.Add(w.Total())
+        }
+    }
+    return quantity, total
+}
+
+// IsDutch reports whether the auction runs with a descending price.
+func (a *Auction) IsDutch() bool {
+    return a.Type == AuctionTypeDutch
+}
+
+// IsSealed reports whether bids are hidden until the auction closes.
+func (a *Auction) IsSealed() bool {
+    return a.Type == AuctionTypeSealedFirstPrice || a.Type == AuctionTypeSealedSecondPrice
+}
+
+// BidsHidden reports whether bid amounts must not be revealed yet.
+func (a *Auction) BidsHidden() bool {
+    return a.IsSealed() && (a.Status == AuctionStatusPending || a.Status == AuctionStatusActive)
+}
+
+// ClearingPrice returns what the winner of bids pays. Bids must be ordered
+// best first and must not be empty.
+func (a *Auction) ClearingPrice(bids []*Bid) Money {
+    winning := bids[0].Amount
+    if a.Type != AuctionTypeSealedSecondPrice {
+        return winning
+    }
+
+    price := a.StartPrice
+    if len(bids) > 1 {
+        price = bids[1].Amount.Add(a.MinStep)
+    }
+    if a.HasReserve() {
+        price = MaxMoney(price, a.ReservePrice)
+    }
+    return MinMoney(price, winning)
+}
+
+// DutchPriceAt returns the asking price of a Dutch auction at the given moment.
+func (a *Auction) DutchPriceAt(at time.Time) Money {
+    if a.DecrementInterval <= 0 || !at.After(a.StartTime) {
+        return a.StartPrice
+    }
+
+    steps := int64(at.Sub(a.StartTime) / a.DecrementInterval)
+    drop, err := a.PriceDecrement.MulChecked(steps)
+    if err != nil || drop.GreaterThan(a.StartPrice.Sub(a.FloorPrice)) {
+        return a.FloorPrice
+    }
+    return MaxMoney(a.StartPrice.Sub(drop), a.FloorPrice)
+}
+
+// HasReserve reports whether the seller set a hidden reserve price.
+func (a *Auction) HasReserve() bool {
+    return a.ReservePrice.IsPositive()
+}
+
+// ReserveMet reports whether the current price has reached the reserve.
+// Auctions without a reserve always meet it. On multi-unit auctions the
+// allocation decides instead; see Allocate.
+func (a *Auction) ReserveMet() bool {
+    return !a.HasReserve() || !a.CurrentPrice.LessThan(a.ReservePrice)
+}
+
+// HasEnded reports whether bidding is closed at the given moment.
+func (a *Auction) HasEnded(at time.Time) bool {
+    return !at.Before(a.EndTime)
+}
+
+// ExtendForBidAt applies the soft-close rule to a bid placed at the given
+// moment and reports whether EndTime was moved.
+func (a *Auction) ExtendForBidAt(at time.Time) bool {
+    if a.ExtensionWindow <= 0 || a.ExtensionDuration <= 0 {
+        return false
+    }
+    if a.EndTime.Sub(at) > a.ExtensionWindow {
+        return false
+    }
+
+    extension := a.ExtensionDuration
+    if a.MaxExtension > 0 {
+        remaining := a.MaxExtension - a.TotalExtension
+        if remaining <= 0 {
+            return false
+        }
+        if extension > remaining {
+            extension = remaining
+        }
+    }
+
+    a.EndTime = a.EndTime.Add(extension)
+    a.TotalExtension += extension
+    return true
+}
+
+// BuyNowAvailable reports whether the lot can still be bought outright.
+func (a *Auction) BuyNowAvailable() bool {
+    return a.BuyNowPrice.IsPositive() && !a.BuyNowDisabled
+}
+
+// DisableBuyNowAbove turns buy-now off once the current price exceeds the
+// given percentage of the buy-now price, and reports whether it did so.
+func (a *Auction) DisableBuyNowAbove(percent float64) bool {
+    if !a.BuyNowAvailable() || !a.CurrentPrice.GreaterThan(a.BuyNowPrice.Percent(percent)) {
+        return false
+    }
+    a.BuyNowDisabled = true
+    return true
+}
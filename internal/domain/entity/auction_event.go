@@ -0,0 +1,69 @@
+package entity
+
+import (
+    "time"
+)
+
+type AuctionEventType string
+
+const (
+    // AuctionEventBidPlaced announces a visible bid. Bids on sealed auctions
+    // are never announced.
+    AuctionEventBidPlaced    AuctionEventType = "BID_PLACED"
+    AuctionEventPriceChanged AuctionEventType = "PRICE_CHANGED"
+    // AuctionEventExtended means a late bid pushed the end time back.
+    AuctionEventExtended     AuctionEventType = "EXTENDED"
+    AuctionEventStarted      AuctionEventType = "STARTED"
+    // AuctionEventEnded is the last event of an auction; Status tells how it
+    // ended.
+    AuctionEventEnded        AuctionEventType = "ENDED"
+)
+
+// AuctionEvent is something that happened to an auction, together with the
+// auction's state right after it. IDs increase in the order events are
+// published.
+type AuctionEvent struct {
+    ID           int64            `json:"id"`
+    AuctionID    int64            `json:"auction_id"`
+    Type         AuctionEventType `json:"type"`
+    // Bid is set on BID_PLACED events.
+    Bid          *Bid             `json:"bid,omitempty"`
+    CurrentPrice Money            `json:"current_price"`
+    EndTime      time.Time        `json:"end_time"`
+    Status       AuctionStatus    `json:"status"`
+    WinnerID     *int64           `json:"winner_id,omitempty"`
+    OccurredAt   time.Time        `json:"occurred_at"`
+}
+
+func (e *AuctionEvent) StreamID() int64 {
+    return e.AuctionID
+}
+
+func (e *AuctionEvent) EventID() int64 {
+    return e.ID
+}
+
+func (e *AuctionEvent) SetEventID(id int64) {
+    e.ID = id
+}
+
+// NewAuctionEvent records an event of type eventType with auction's current
+// state.
+func NewAuctionEvent(eventType AuctionEventType, auction *Auction) *AuctionEvent {
+    return &AuctionEvent{
+        AuctionID:    auction.ID,
+        Type:         eventType,
+        CurrentPrice: auction.CurrentPrice,
+        EndTime:      auction.EndTime,
+        Status:       auction.Status,
+        WinnerID:     auction.WinnerID,
+        OccurredAt:   time.Now(),
+    }
+}
+
+// NewBidPlacedEvent announces bid on auction.
+func NewBidPlacedEvent(auction *Auction, bid *Bid) *AuctionEvent {
+    event := NewAuctionEvent(AuctionEventBidPlaced, auction)
+    event.Bid = bid
+    return event
+}
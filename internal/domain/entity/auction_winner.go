@@ -0,0 +1,22 @@
+package entity
+
+import (
+    "time"
+)
+
+// AuctionWinner records the units a single bid won when an auction closed
+// and the price charged for each of them.
+type AuctionWinner struct {
+    ID        int64     `json:"id"`
+    AuctionID int64     `json:"auction_id"`
+    UserID    int64     `json:"user_id"`
+    BidID     int64     `json:"bid_id"`
+    Quantity  int       `json:"quantity"`
+    UnitPrice Money     `json:"unit_price"`
+    CreatedAt time.Time `json:"created_at"`
+}
+
+// Total returns the amount charged to the winner.
+func (w *AuctionWinner) Total() Money {
+    return w.UnitPrice.Mul(int64(w.Quantity))
+}
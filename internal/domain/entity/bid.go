@@ -8,7 +8,10 @@ type Bid struct {
     ID         int64     `json:"id"`
     AuctionID  int64     `json:"auction_id"`
     UserID     int64     `json:"user_id"`
-    Amount     float64   `json:"amount"`
+    // Amount is the price per unit; Quantity is the number of units wanted.
+    Amount     Money     `json:"amount"`
+    Quantity   int       `json:"quantity"`
+    IsAuto     bool      `json:"is_auto"`
     CreatedAt  time.Time `json:"created_at"`
     UpdatedAt  time.Time `json:"updated_at"`
 }
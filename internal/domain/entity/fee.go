@@ -0,0 +1,110 @@
+package entity
+
+// Fees breaks a sale down into what the buyer pays, what the seller
+// receives and what the platform keeps.
+type Fees struct {
+    // Hammer is the price of the units won.
+    Hammer       Money `json:"hammer"`
+    // Commission is charged to the seller out of Hammer.
+    Commission   Money `json:"commission"`
+    // BuyerPremium is charged to the buyer on top of Hammer.
+    BuyerPremium Money `json:"buyer_premium"`
+}
+
+// BuyerTotal returns what the buyer is charged.
+func (f Fees) BuyerTotal() Money {
+    return f.Hammer.Add(f.BuyerPremium)
+}
+
+// SellerProceeds returns what the seller is paid.
+func (f Fees) SellerProceeds() Money {
+    return f.Hammer.Sub(f.Commission)
+}
+
+// PlatformTotal returns what the platform keeps.
+func (f Fees) PlatformTotal() Money {
+    return f.Commission.Add(f.BuyerPremium)
+}
+
+func (f Fees) Add(o Fees) Fees {
+    return Fees{
+        Hammer:       f.Hammer.Add(o.Hammer),
+        Commission:   f.Commission.Add(o.Commission),
+        BuyerPremium: f.BuyerPremium.Add(o.BuyerPremium),
+    }
+}
+
+// FeeTier charges Percent on the part of the hammer price from From up to
+// the next tier's From.
+type FeeTier struct {
+    From    Money
+    Percent float64
+}
+
+// FeeRule prices one side of a sale: Flat plus a percentage of the hammer
+// price. Without Tiers the percentage is Percent throughout; with Tiers,
+// ordered by From and starting at zero, each band of the hammer price is
+// charged at its own rate. Flat and From carry no currency and are read in
+// the currency of the sale.
+type FeeRule struct {
+    Flat    Money
+    Percent float64
+    Tiers   []FeeTier
+}
+
+// Charge returns the fee on hammer.
+func (r FeeRule) Charge(hammer Money) Money {
+    fee := NewMoney(r.Flat.Minor, hammer.Currency)
+    if len(r.Tiers) == 0 {
+        return fee.Add(hammer.Percent(r.Percent))
+    }
+
+    for i, tier := range r.Tiers {
+        if !hammer.GreaterThan(tier.From) {
+            break
+        }
+        band := hammer
+        if i+1 < len(r.Tiers) {
+            band = MinMoney(hammer, NewMoney(r.Tiers[i+1].From.Minor, hammer.Currency))
+        }
+        band = band.Sub(NewMoney(tier.From.Minor, hammer.Currency))
+        fee = fee.Add(band.Percent(tier.Percent))
+    }
+    return fee
+}
+
+// CategoryFees overrides the schedule for lots in one category. A nil rule
+// keeps the default for that side.
+type CategoryFees struct {
+    Commission   *FeeRule
+    BuyerPremium *FeeRule
+}
+
+// FeeSchedule is the marketplace's price list: a commission charged to
+// sellers and a premium charged to buyers, with per-category overrides.
+// The zero schedule charges nothing.
+type FeeSchedule struct {
+    Commission   FeeRule
+    BuyerPremium FeeRule
+    Categories   map[string]CategoryFees
+}
+
+// Evaluate returns the fees on a sale of hammer in category. The commission
+// never exceeds the hammer price.
+func (s FeeSchedule) Evaluate(category string, hammer Money) Fees {
+    commission, premium := s.Commission, s.BuyerPremium
+    if override, ok := s.Categories[category]; ok {
+        if override.Commission != nil {
+            commission = *override.Commission
+        }
+        if override.BuyerPremium != nil {
+            premium = *override.BuyerPremium
+        }
+    }
+
+    return Fees{
+        Hammer:       hammer,
+        Commission:   MinMoney(commission.Charge(hammer), hammer),
+        BuyerPremium: premium.Charge(hammer),
+    }
+}
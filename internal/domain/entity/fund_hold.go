@@ -0,0 +1,16 @@
+package entity
+
+import (
+    "time"
+)
+
+// FundHold reserves part of a user's balance for a bid that may still win
+// an auction. A user has at most one hold per auction.
+type FundHold struct {
+    ID        int64     `json:"id"`
+    UserID    int64     `json:"user_id"`
+    AuctionID int64     `json:"auction_id"`
+    Amount    Money     `json:"amount"`
+    CreatedAt time.Time `json:"created_at"`
+    UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+    "time"
+)
+
+// IdempotencyRecord remembers a request made with an idempotency key, so a
+// retry of the same request gets the original response instead of being
+// carried out again. Keys are scoped to the calling user and an RPC method.
+type IdempotencyRecord struct {
+    UserID      int64     `json:"user_id"`
+    Key         string    `json:"key"`
+    Method      string    `json:"method"`
+    // RequestHash identifies the request the key was first used for.
+    RequestHash string    `json:"request_hash"`
+    // Response is the serialized response, or nil while the request is
+    // being processed.
+    Response    []byte    `json:"response,omitempty"`
+    CreatedAt   time.Time `json:"created_at"`
+}
+
+// Completed reports whether the original request has finished.
+func (r *IdempotencyRecord) Completed() bool {
+    return r.Response != nil
+}
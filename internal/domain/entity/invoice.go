@@ -0,0 +1,60 @@
+package entity
+
+import (
+    "fmt"
+    "math"
+    "time"
+)
+
+// Invoice is the bill a winner receives for a sold auction. It is issued
+// together with the winner's payment and keeps a copy of the lot and party
+// details as they were at the sale, so later edits to the lot or the users
+// do not change an issued invoice.
+//
+// Prices are tax-inclusive: Total is what the buyer is charged,
+// Fees.BuyerTotal(), and Tax is the part of it due at TaxRate.
+type Invoice struct {
+    ID          int64     `json:"id"`
+    // Number is sequential across all invoices, without gaps.
+    Number      string    `json:"number"`
+    AuctionID   int64     `json:"auction_id"`
+    PaymentID   int64     `json:"payment_id"`
+    LotID       int64     `json:"lot_id"`
+    LotTitle    string    `json:"lot_title"`
+    BuyerID     int64     `json:"buyer_id"`
+    BuyerName   string    `json:"buyer_name"`
+    BuyerEmail  string    `json:"buyer_email"`
+    SellerID    int64     `json:"seller_id"`
+    SellerName  string    `json:"seller_name"`
+    SellerEmail string    `json:"seller_email"`
+    Fees
+    // TaxRate is a percentage.
+    TaxRate     float64   `json:"tax_rate"`
+    Tax         Money     `json:"tax"`
+    Total       Money     `json:"total"`
+    IssuedAt    time.Time `json:"issued_at"`
+}
+
+// InvoiceNumber formats the n-th invoice number.
+func InvoiceNumber(n int64) string {
+    return fmt.Sprintf("INV-%06d", n)
+}
+
+// IncludedTax returns the tax contained in the tax-inclusive amount at rate
+// per cent, rounded half away from zero to the minor unit.
+func IncludedTax(amount Money, rate float64) Money {
+    if rate <= 0 {
+        return NewMoney(0, amount.Currency)
+    }
+    return NewMoney(int64(math.Round(float64(amount.Minor)*rate/(100+rate))), amount.Currency)
+}
+
+// Net returns the total before tax.
+func (i *Invoice) Net() Money {
+    return i.Total.Sub(i.Tax)
+}
+
+// Involves reports whether userID is the buyer or the seller.
+func (i *Invoice) Involves(userID int64) bool {
+    return i.BuyerID == userID || i.SellerID == userID
+}
@@ -0,0 +1,101 @@
+package entity
+
+import (
+    "time"
+)
+
+type AccountType string
+
+const (
+    AccountTypeUser     AccountType = "USER"
+    AccountTypeExternal AccountType = "EXTERNAL"
+    AccountTypePlatform AccountType = "PLATFORM"
+    AccountTypePayout   AccountType = "PAYOUT"
+)
+
+// Account is one side of a ledger posting. Every user has an account per
+// currency; the external account of each currency stands for money outside
+// the platform, the platform account collects marketplace fees and the
+// payout account holds money reserved for withdrawals until it is paid out.
+type Account struct {
+    ID        int64       `json:"id"`
+    Type      AccountType `json:"type"`
+    UserID    *int64      `json:"user_id,omitempty"`
+    Currency  string      `json:"currency"`
+    CreatedAt time.Time   `json:"created_at"`
+}
+
+type EntryDirection string
+
+const (
+    EntryDirectionDebit  EntryDirection = "DEBIT"
+    EntryDirectionCredit EntryDirection = "CREDIT"
+)
+
+type LedgerEntry struct {
+    ID            int64          `json:"id"`
+    TransactionID int64          `json:"transaction_id"`
+    AccountID     int64          `json:"account_id"`
+    Direction     EntryDirection `json:"direction"`
+    Amount        Money          `json:"amount"`
+    CreatedAt     time.Time      `json:"created_at"`
+}
+
+// Signed returns the entry's effect on the account balance. User funds are
+// owed by the platform, so credits raise a balance and debits lower it.
+func (e *LedgerEntry) Signed() Money {
+    if e.Direction == EntryDirectionDebit {
+        return e.Amount.Mul(-1)
+    }
+    return e.Amount
+}
+
+type TransactionKind string
+
+const (
+    TransactionKindDeposit    TransactionKind = "DEPOSIT"
+    TransactionKindWithdrawal TransactionKind = "WITHDRAWAL"
+    TransactionKindSettlement TransactionKind = "SETTLEMENT"
+    TransactionKindRefund     TransactionKind = "REFUND"
+    TransactionKindFee        TransactionKind = "FEE"
+)
+
+// LedgerTransaction is a journal entry: a set of postings whose debits and
+// credits cancel out.
+type LedgerTransaction struct {
+    ID          int64           `json:"id"`
+    Kind        TransactionKind `json:"kind"`
+    AuctionID   *int64          `json:"auction_id,omitempty"`
+    Description string          `json:"description"`
+    Entries     []*LedgerEntry  `json:"entries"`
+    CreatedAt   time.Time       `json:"created_at"`
+}
+
+// IsBalanced reports whether the transaction has at least two positive
+// entries in a single currency and its debits equal its credits.
+func (t *LedgerTransaction) IsBalanced() bool {
+    if len(t.Entries) < 2 {
+        return false
+    }
+    var sum Money
+    for _, e := range t.Entries {
+        if !e.Amount.IsPositive() || e.Amount.Currency != t.Entries[0].Amount.Currency {
+            return false
+        }
+        sum = sum.Add(e.Signed())
+    }
+    return sum.IsZero()
+}
+
+// StatementLine is a ledger transaction as seen from a single account.
+type StatementLine struct {
+    TransactionID int64           `json:"transaction_id"`
+    Kind          TransactionKind `json:"kind"`
+    AuctionID     *int64          `json:"auction_id,omitempty"`
+    Description   string          `json:"description"`
+    // Amount is signed: positive when money came into the account.
+    Amount Money `json:"amount"`
+    // BalanceAfter is the account balance once the transaction was posted.
+    BalanceAfter Money     `json:"balance_after"`
+    CreatedAt    time.Time `json:"created_at"`
+}
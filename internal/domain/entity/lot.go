@@ -8,7 +8,13 @@ type Lot struct {
     ID          int64     `json:"id"`
     Title       string    `json:"title"`
     Description string    `json:"description"`
-    StartPrice  float64   `json:"start_price"`
+    StartPrice  Money     `json:"start_price"`
+    // Currency is the currency of StartPrice and of the lot's auction.
+    Currency    string    `json:"currency"`
+    // Quantity is the number of identical units sold in the lot.
+    Quantity    int       `json:"quantity"`
+    // Category is free-form and selects per-category fee overrides.
+    Category    string    `json:"category"`
     CreatorID   int64     `json:"creator_id"`
     CreatedAt   time.Time `json:"created_at"`
     UpdatedAt   time.Time `json:"updated_at"`
@@ -0,0 +1,16 @@
+package entity
+
+import (
+    "time"
+)
+
+// MaxBid is a hidden ceiling up to which the system bids on the user's behalf.
+// UpdatedAt is when the ceiling was last set and breaks ties between equal ceilings.
+type MaxBid struct {
+    ID         int64     `json:"id"`
+    AuctionID  int64     `json:"auction_id"`
+    UserID     int64     `json:"user_id"`
+    MaxAmount  Money     `json:"max_amount"`
+    CreatedAt  time.Time `json:"created_at"`
+    UpdatedAt  time.Time `json:"updated_at"`
+}
@@ -0,0 +1,274 @@
+package entity
+
+import (
+    "database/sql/driver"
+    "fmt"
+    "math"
+    "strconv"
+    "strings"
+
+    "auction-system/internal/domain/errors"
+)
+
+// DefaultCurrency is used wherever an amount arrives without a currency.
+const DefaultCurrency = "USD"
+
+// ValidCurrency reports whether code looks like an ISO 4217 code: three
+// upper-case letters.
+func ValidCurrency(code string) bool {
+    if len(code) != 3 {
+        return false
+    }
+    for _, c := range code {
+        if c < 'A' || c > 'Z' {
+            return false
+        }
+    }
+    return true
+}
+
+// minorPerUnit is the number of minor units (cents) in one currency unit.
+const minorPerUnit = 100
+
+// Money is an exact amount in minor units. Arithmetic never goes through
+// floating point, so comparisons such as "current price plus step" are
+// exact. Operands must share a currency; an amount with no currency, such as
+// the zero value, adopts the other operand's. Mixing currencies or
+// overflowing is a programming error and panics, so callers check the
+// currency and bound amounts that come from requests first.
+type Money struct {
+    Minor    int64  `json:"minor"`
+    Currency string `json:"currency"`
+}
+
+func NewMoney(minor int64, currency string) Money {
+    return Money{Minor: minor, Currency: currency}
+}
+
+// ParseMoney parses a decimal string such as "150000.50". More than two
+// fractional digits are rejected rather than rounded.
+func ParseMoney(s, currency string) (Money, error) {
+    s = strings.TrimSpace(s)
+    negative := strings.HasPrefix(s, "-")
+    digits := strings.TrimPrefix(s, "-")
+
+    // Only the leading minus is a sign; ParseInt would also accept one at
+    // the start of either part.
+    whole, frac, _ := strings.Cut(digits, ".")
+    frac = strings.TrimRight(frac, "0")
+    if whole == "" || len(frac) > 2 || !onlyDigits(whole) || !onlyDigits(frac) {
+        return Money{}, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("invalid amount %q", s), nil)
+    }
+    for len(frac) < 2 {
+        frac += "0"
+    }
+
+    units, err := strconv.ParseInt(whole, 10, 64)
+    if err != nil {
+        return Money{}, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("invalid amount %q", s), err)
+    }
+    cents, err := strconv.ParseInt(frac, 10, 64)
+    if err != nil {
+        return Money{}, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("invalid amount %q", s), err)
+    }
+
+    if units > (math.MaxInt64-cents)/minorPerUnit {
+        return Money{}, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("amount %q is too large", s), nil)
+    }
+    minor := units*minorPerUnit + cents
+    if negative {
+        minor = -minor
+    }
+    return NewMoney(minor, currency), nil
+}
+
+func onlyDigits(s string) bool {
+    for _, c := range s {
+        if c < '0' || c > '9' {
+            return false
+        }
+    }
+    return true
+}
+
+// MoneyFromUnits builds an amount from whole units and nanos (billionths of
+// a unit), the representation used by the API. Nanos beyond the minor unit
+// are rounded half away from zero. Like google.type.Money, nanos must be
+// below one unit and carry the sign of units; amounts that do not fit in
+// minor units and currencies that are not ISO 4217 codes are rejected.
+func MoneyFromUnits(units int64, nanos int32, currency string) (Money, error) {
+    if !ValidCurrency(currency) {
+        return Money{}, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("invalid currency %q", currency), nil)
+    }
+    if nanos <= -1_000_000_000 || nanos >= 1_000_000_000 {
+        return Money{}, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("nanos %d out of range", nanos), nil)
+    }
+    if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+        return Money{}, errors.New(errors.ErrorTypeValidation, "units and nanos must have the same sign", nil)
+    }
+    // Rounded nanos add at most one unit.
+    if units >= math.MaxInt64/minorPerUnit || units <= math.MinInt64/minorPerUnit {
+        return Money{}, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("amount of %d units is too large", units), nil)
+    }
+
+    const nanosPerMinor = 1_000_000_000 / minorPerUnit
+    minor := int64(nanos) / nanosPerMinor
+    if rest := int64(nanos) % nanosPerMinor; rest*2 >= nanosPerMinor {
+        minor++
+    } else if rest*2 <= -nanosPerMinor {
+        minor--
+    }
+    return NewMoney(units*minorPerUnit+minor, currency), nil
+}
+
+// Units splits the amount into whole units and nanos.
+func (m Money) Units() (int64, int32) {
+    const nanosPerMinor = 1_000_000_000 / minorPerUnit
+    return m.Minor / minorPerUnit, int32(m.Minor%minorPerUnit) * nanosPerMinor
+}
+
+func (m Money) String() string {
+    sign := ""
+    minor := m.Minor
+    if minor < 0 {
+        sign = "-"
+        minor = -minor
+    }
+    return fmt.Sprintf("%s%d.%02d", sign, minor/minorPerUnit, minor%minorPerUnit)
+}
+
+// currencyWith returns the currency shared by m and o and panics if they
+// have different ones.
+func (m Money) currencyWith(o Money) string {
+    switch {
+    case m.Currency == "":
+        return o.Currency
+    case o.Currency == "" || o.Currency == m.Currency:
+        return m.Currency
+    }
+    panic(fmt.Sprintf("money: mixing %s and %s", m.Currency, o.Currency))
+}
+
+func (m Money) Add(o Money) Money {
+    return NewMoney(m.Minor+o.Minor, m.currencyWith(o))
+}
+
+func (m Money) Sub(o Money) Money {
+    return NewMoney(m.Minor-o.Minor, m.currencyWith(o))
+}
+
+// Mul multiplies the amount by a whole number, e.g. a unit price by a
+// quantity. It panics if the product overflows; see MulChecked.
+func (m Money) Mul(n int64) Money {
+    product, err := m.MulChecked(n)
+    if err != nil {
+        panic("money: " + err.Error())
+    }
+    return product
+}
+
+// MulChecked is Mul for operands that come from requests: it returns a
+// validation error instead of overflowing.
+func (m Money) MulChecked(n int64) (Money, error) {
+    if n != 0 {
+        product := m.Minor * n
+        if product/n != m.Minor || (m.Minor == math.MinInt64 && n == -1) {
+            return Money{}, errors.New(errors.ErrorTypeValidation, "amount is too large", nil)
+        }
+    }
+    return NewMoney(m.Minor*n, m.Currency), nil
+}
+
+// Percent returns percent per cent of the amount, rounded half away from
+// zero to the minor unit.
+func (m Money) Percent(percent float64) Money {
+    return NewMoney(int64(math.Round(float64(m.Minor)*percent/100)), m.Currency)
+}
+
+// Convert returns the amount in currency at rate units of currency per unit
+// of m, rounded half away from zero to the minor unit. Rates are inexact,
+// so converted amounts are for display only.
+func (m Money) Convert(rate float64, currency string) Money {
+    return NewMoney(int64(math.Round(float64(m.Minor)*rate)), currency)
+}
+
+func (m Money) Cmp(o Money) int {
+    m.currencyWith(o)
+    switch {
+    case m.Minor < o.Minor:
+        return -1
+    case m.Minor > o.Minor:
+        return 1
+    }
+    return 0
+}
+
+func (m Money) LessThan(o Money) bool {
+    return m.Cmp(o) < 0
+}
+
+func (m Money) GreaterThan(o Money) bool {
+    return m.Cmp(o) > 0
+}
+
+func (m Money) IsZero() bool {
+    return m.Minor == 0
+}
+
+func (m Money) IsPositive() bool {
+    return m.Minor > 0
+}
+
+func (m Money) IsNegative() bool {
+    return m.Minor < 0
+}
+
+// MinMoney returns the smaller of a and b.
+func MinMoney(a, b Money) Money {
+    if b.LessThan(a) {
+        return b
+    }
+    return a
+}
+
+// MaxMoney returns the larger of a and b.
+func MaxMoney(a, b Money) Money {
+    if b.GreaterThan(a) {
+        return b
+    }
+    return a
+}
+
+// Scan reads a DECIMAL column. NULL reads as zero; the currency is not
+// stored in the column and is set to DefaultCurrency, so repositories select
+// the row's currency column after the amount and scan it into Currency.
+func (m *Money) Scan(src interface{}) error {
+    var s string
+    switch v := src.(type) {
+    case nil:
+        *m = NewMoney(0, DefaultCurrency)
+        return nil
+    case []byte:
+        s = string(v)
+    case string:
+        s = v
+    case int64:
+        *m = NewMoney(v*minorPerUnit, DefaultCurrency)
+        return nil
+    default:
+        return fmt.Errorf("cannot scan %T into Money", src)
+    }
+
+    parsed, err := ParseMoney(s, DefaultCurrency)
+    if err != nil {
+        return err
+    }
+    *m = parsed
+    return nil
+}
+
+// Value writes the amount as a decimal string so the database never sees a
+// float.
+func (m Money) Value() (driver.Value, error) {
+    return m.String(), nil
+}
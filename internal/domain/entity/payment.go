@@ -0,0 +1,67 @@
+package entity
+
+import (
+    "fmt"
+    "time"
+)
+
+type PaymentStatus string
+
+const (
+    PaymentStatusPending   PaymentStatus = "PENDING"
+    PaymentStatusCharging  PaymentStatus = "CHARGING"
+    PaymentStatusCaptured  PaymentStatus = "CAPTURED"
+    PaymentStatusFailed    PaymentStatus = "FAILED"
+    PaymentStatusRefunding PaymentStatus = "REFUNDING"
+    PaymentStatusRefunded  PaymentStatus = "REFUNDED"
+)
+
+// Payment collects what one winner owes the seller for an auction. It is
+// created PENDING when the auction is settled and retried until it is
+// CAPTURED or runs out of attempts and FAILED. A captured payment is
+// REFUNDED when another payment for the same auction fails.
+//
+// CHARGING and REFUNDING mark a call to the payment provider that has been
+// decided but whose result is not recorded yet. A payment left in either
+// state is resumed with the same ChargeKey or RefundKey, so the provider
+// never carries out the same charge or refund twice.
+//
+// Amount is what the winner is charged, Fees.BuyerTotal(); once captured
+// the seller is paid Fees.SellerProceeds() and the platform keeps the rest.
+type Payment struct {
+    ID            int64         `json:"id"`
+    AuctionID     int64         `json:"auction_id"`
+    UserID        int64         `json:"user_id"`
+    SellerID      int64         `json:"seller_id"`
+    Amount        Money         `json:"amount"`
+    Fees
+    Status        PaymentStatus `json:"status"`
+    Attempts      int           `json:"attempts"`
+    NextAttemptAt time.Time     `json:"next_attempt_at"`
+    LastError     string        `json:"last_error,omitempty"`
+    CreatedAt     time.Time     `json:"created_at"`
+    UpdatedAt     time.Time     `json:"updated_at"`
+}
+
+// ChargeKey is the provider idempotency key of the current charge attempt.
+func (p *Payment) ChargeKey() string {
+    return fmt.Sprintf("payment-%d-charge-%d", p.ID, p.Attempts)
+}
+
+// RefundKey is the provider idempotency key of the payment's refund.
+func (p *Payment) RefundKey() string {
+    return fmt.Sprintf("payment-%d-refund", p.ID)
+}
+
+// TotalFees adds up the fee breakdown of payments, or returns nil if there
+// are none.
+func TotalFees(payments []*Payment) *Fees {
+    if len(payments) == 0 {
+        return nil
+    }
+    var total Fees
+    for _, p := range payments {
+        total = total.Add(p.Fees)
+    }
+    return &total
+}
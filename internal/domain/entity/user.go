@@ -6,7 +6,22 @@ type User struct {
     ID        int64     `json:"id"`
     Username  string    `json:"username"`
     Email     string    `json:"email"`
-    Balance   float64   `json:"balance"`
+    // IsAdmin grants the right to review withdrawals. It is set in the
+    // database only.
+    IsAdmin   bool      `json:"is_admin"`
+    // Wallets holds one balance per currency the user has funds in.
+    Wallets   []*Wallet `json:"wallets,omitempty"`
     CreatedAt time.Time `json:"created_at"`
     UpdatedAt time.Time `json:"updated_at"`
 }
+
+// Wallet returns the user's wallet in currency, or an empty one if the user
+// has never held that currency.
+func (u *User) Wallet(currency string) *Wallet {
+    for _, w := range u.Wallets {
+        if w.Currency == currency {
+            return w
+        }
+    }
+    return NewWallet(u.ID, currency)
+}
@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// Wallet is a user's balance in one currency. The balance is a cache of the
+// user's ledger account in that currency.
+type Wallet struct {
+    UserID   int64  `json:"user_id"`
+    Currency string `json:"currency"`
+    Balance  Money  `json:"balance"`
+    // HeldBalance is the part of Balance reserved by bids that may still win.
+    HeldBalance Money     `json:"held_balance"`
+    UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func NewWallet(userID int64, currency string) *Wallet {
+    return &Wallet{
+        UserID:      userID,
+        Currency:    currency,
+        Balance:     NewMoney(0, currency),
+        HeldBalance: NewMoney(0, currency),
+    }
+}
+
+// AvailableBalance returns the funds the user can still commit.
+func (w *Wallet) AvailableBalance() Money {
+    return w.Balance.Sub(w.HeldBalance)
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+    "time"
+)
+
+// Webhook is a partner's subscription to auction events, which are POSTed
+// to URL and signed with Secret.
+type Webhook struct {
+    ID         int64              `json:"id"`
+    UserID     int64              `json:"user_id"`
+    URL        string             `json:"url"`
+    // EventTypes are the event types sent to the webhook; empty means all.
+    EventTypes []AuctionEventType `json:"event_types"`
+    Secret     string             `json:"-"`
+    CreatedAt  time.Time          `json:"created_at"`
+}
+
+// Wants reports whether events of eventType are sent to the webhook.
+func (w *Webhook) Wants(eventType AuctionEventType) bool {
+    if len(w.EventTypes) == 0 {
+        return true
+    }
+    for _, t := range w.EventTypes {
+        if t == eventType {
+            return true
+        }
+    }
+    return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+    WebhookDeliveryStatusPending   WebhookDeliveryStatus = "PENDING"
+    WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "DELIVERED"
+    WebhookDeliveryStatusDead      WebhookDeliveryStatus = "DEAD"
+)
+
+// WebhookDelivery is one auction event or notification on its way to one
+// webhook. It is created PENDING with the event and retried until the
+// receiver accepts it, DELIVERED, or it runs out of attempts, DEAD.
+// Redelivering makes it PENDING again.
+type WebhookDelivery struct {
+    ID             int64                 `json:"id"`
+    WebhookID      int64                 `json:"webhook_id"`
+    EventID        int64                 `json:"event_id"`
+    // EventType is the auction event type, or the notification type for
+    // notifications, whose EventID is 0.
+    EventType      string                `json:"event_type"`
+    // Payload is the JSON body that is POSTed.
+    Payload        []byte                `json:"payload"`
+    Status         WebhookDeliveryStatus `json:"status"`
+    Attempts       int                   `json:"attempts"`
+    NextAttemptAt  time.Time             `json:"next_attempt_at"`
+    // LastStatusCode is the HTTP status of the last attempt, or 0 if no
+    // response arrived.
+    LastStatusCode int                   `json:"last_status_code"`
+    LastError      string                `json:"last_error,omitempty"`
+    DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+    CreatedAt      time.Time             `json:"created_at"`
+    UpdatedAt      time.Time             `json:"updated_at"`
+}
@@ -0,0 +1,53 @@
+package entity
+
+import (
+    "time"
+)
+
+type WithdrawalStatus string
+
+const (
+    WithdrawalStatusPending   WithdrawalStatus = "PENDING"
+    WithdrawalStatusApproved  WithdrawalStatus = "APPROVED"
+    WithdrawalStatusCompleted WithdrawalStatus = "COMPLETED"
+    WithdrawalStatusRejected  WithdrawalStatus = "REJECTED"
+    WithdrawalStatusFailed    WithdrawalStatus = "FAILED"
+)
+
+// Withdrawal is a user's request to pay money out of the platform. The
+// amount is reserved when the request is made and stays reserved while it
+// is PENDING review and once it is APPROVED; the payout provider then
+// either pays it out, COMPLETED, or declines it, FAILED. A REJECTED or
+// FAILED withdrawal returns the reserved amount to the user.
+type Withdrawal struct {
+    ID          int64              `json:"id"`
+    UserID      int64              `json:"user_id"`
+    Amount      Money              `json:"amount"`
+    // Destination says where the money goes, e.g. an IBAN; it is passed to
+    // the payout provider as is.
+    Destination string             `json:"destination"`
+    Status      WithdrawalStatus   `json:"status"`
+    // ReviewerID is the admin who approved or rejected the withdrawal.
+    ReviewerID  *int64             `json:"reviewer_id,omitempty"`
+    // Reference is the payout provider's reference for a completed payout.
+    Reference   string             `json:"reference,omitempty"`
+    // Note is the reviewer's reason for a rejection or the provider's error
+    // for a failure.
+    Note        string             `json:"note,omitempty"`
+    // History lists every status the withdrawal has been in, oldest first.
+    History     []*WithdrawalEvent `json:"history,omitempty"`
+    CreatedAt   time.Time          `json:"created_at"`
+    UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// WithdrawalEvent records a withdrawal entering a status.
+type WithdrawalEvent struct {
+    ID           int64            `json:"id"`
+    WithdrawalID int64            `json:"withdrawal_id"`
+    Status       WithdrawalStatus `json:"status"`
+    // ActorID is the user who caused the change, or nil for the payout
+    // worker.
+    ActorID      *int64           `json:"actor_id,omitempty"`
+    Note         string           `json:"note,omitempty"`
+    CreatedAt    time.Time        `json:"created_at"`
+}
@@ -0,0 +1,28 @@
+package event
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+)
+
+// Event is anything streamed to the subscribers of a stream: an auction's
+// events, or a user's notifications. Events of one kind share a sequence of
+// IDs, so a subscriber can resume after the last ID it saw.
+type Event interface {
+    StreamID() int64
+    EventID() int64
+    SetEventID(id int64)
+}
+
+// Publisher announces auction events to everyone watching the auction.
+// Use cases publish from inside the transaction that made the change.
+type Publisher interface {
+    Publish(ctx context.Context, event *entity.AuctionEvent) error
+}
+
+// NotificationPublisher delivers notifications to the user's open
+// connections.
+type NotificationPublisher interface {
+    Publish(ctx context.Context, n *notification.Notification) error
+}
@@ -0,0 +1,11 @@
+package exchange
+
+import (
+    "context"
+)
+
+// ExchangeRateProvider quotes conversion rates between currencies.
+type ExchangeRateProvider interface {
+    // Rate returns how many units of to one unit of from buys.
+    Rate(ctx context.Context, from, to string) (float64, error)
+}
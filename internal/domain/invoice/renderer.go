@@ -0,0 +1,51 @@
+package invoice
+
+import (
+    "fmt"
+    "strings"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type Format string
+
+const (
+    FormatPDF  Format = "PDF"
+    FormatText Format = "TEXT"
+    FormatHTML Format = "HTML"
+)
+
+// ParseFormat reads a format name in any case; an empty name means PDF.
+func ParseFormat(s string) (Format, error) {
+    switch format := Format(strings.ToUpper(s)); format {
+    case "":
+        return FormatPDF, nil
+    case FormatPDF, FormatText, FormatHTML:
+        return format, nil
+    default:
+        return "", errors.New(errors.ErrorTypeValidation, fmt.Sprintf("unsupported invoice format %q", s), nil)
+    }
+}
+
+// Extension returns the file name extension for the format.
+func (f Format) Extension() string {
+    switch f {
+    case FormatText:
+        return ".txt"
+    case FormatHTML:
+        return ".html"
+    default:
+        return ".pdf"
+    }
+}
+
+// Document is a rendered invoice, ready to be downloaded.
+type Document struct {
+    ContentType string
+    Filename    string
+    Content     []byte
+}
+
+type Renderer interface {
+    Render(invoice *entity.Invoice, format Format) (*Document, error)
+}
@@ -0,0 +1,37 @@
+package notification
+
+import (
+    "time"
+    "auction-system/internal/domain/entity"
+)
+
+// DigestReport is a summary of a user's auctions for one period.
+type DigestReport struct {
+    UserID      int64           `json:"user_id"`
+    Frequency   DigestFrequency `json:"frequency"`
+    // PeriodStart is the first day of the period in the user's time zone.
+    PeriodStart time.Time       `json:"period_start"`
+    // EndingSoon are the user's watched auctions that end within the
+    // period.
+    EndingSoon  []*entity.Auction `json:"ending_soon"`
+    // Leading and Outbid are the running auctions the user has bid on,
+    // split by whether the user has the highest bid.
+    Leading     []*entity.Auction `json:"leading"`
+    Outbid      []*entity.Auction `json:"outbid"`
+    // NewLots are lots created during the last period in the categories
+    // the user follows.
+    NewLots     []*entity.Lot     `json:"new_lots"`
+}
+
+// Empty reports whether there is nothing to tell the user.
+func (d *DigestReport) Empty() bool {
+    return len(d.EndingSoon) == 0 && len(d.Leading) == 0 && len(d.Outbid) == 0 && len(d.NewLots) == 0
+}
+
+// Length is how long a period of frequency is.
+func (f DigestFrequency) Length() time.Duration {
+    if f == DigestWeekly {
+        return 7 * 24 * time.Hour
+    }
+    return 24 * time.Hour
+}
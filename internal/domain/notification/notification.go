@@ -9,13 +9,30 @@ const (
     AuctionClosed   NotificationType = "AUCTION_CLOSED"
     AuctionWon      NotificationType = "AUCTION_WON"
     NewBid          NotificationType = "NEW_BID"
+    Outbid          NotificationType = "OUTBID"
     TransactionComplete NotificationType = "TRANSACTION_COMPLETE"
     TransactionFailed  NotificationType = "TRANSACTION_FAILED"
+    Digest             NotificationType = "DIGEST"
 )
 
 type Notification struct {
+    ID        int64           `json:"id"`
     UserID    int64           `json:"user_id"`
     Type      NotificationType `json:"type"`
     Message   string          `json:"message"`
     CreatedAt time.Time       `json:"created_at"`
+    // ReadAt is nil while the user has not read the notification.
+    ReadAt    *time.Time      `json:"read_at,omitempty"`
+}
+
+func (n *Notification) StreamID() int64 {
+    return n.UserID
+}
+
+func (n *Notification) EventID() int64 {
+    return n.ID
+}
+
+func (n *Notification) SetEventID(id int64) {
+    n.ID = id
 }
@@ -0,0 +1,115 @@
+package notification
+
+import "time"
+
+// Channel is a way of delivering notifications to a user.
+type Channel string
+
+const (
+    // ChannelInApp is the in-app inbox and the user's event streams.
+    ChannelInApp   Channel = "IN_APP"
+    ChannelEmail   Channel = "EMAIL"
+    // ChannelWebhook posts notifications to the user's webhooks.
+    ChannelWebhook Channel = "WEBHOOK"
+)
+
+// Types are all the notification types.
+var Types = []NotificationType{
+    AuctionStarted,
+    AuctionClosed,
+    AuctionWon,
+    NewBid,
+    Outbid,
+    TransactionComplete,
+    TransactionFailed,
+    Digest,
+}
+
+// DefaultChannels are used for the notification types a user has not
+// chosen channels for.
+var DefaultChannels = []Channel{ChannelInApp, ChannelEmail}
+
+type DigestFrequency string
+
+const (
+    DigestNone   DigestFrequency = "NONE"
+    DigestDaily  DigestFrequency = "DAILY"
+    DigestWeekly DigestFrequency = "WEEKLY"
+)
+
+// QuietHours is a daily period in the user's time zone during which
+// notifications are only delivered in-app. Start and End are minutes after
+// midnight; the period wraps past midnight when End is before Start.
+type QuietHours struct {
+    Start int `json:"start"`
+    End   int `json:"end"`
+}
+
+// Contains reports whether the time of day of t in loc is quiet.
+func (q *QuietHours) Contains(t time.Time, loc *time.Location) bool {
+    local := t.In(loc)
+    minute := local.Hour()*60 + local.Minute()
+    if q.Start <= q.End {
+        return minute >= q.Start && minute < q.End
+    }
+    return minute >= q.Start || minute < q.End
+}
+
+// Preferences are a user's choices of how to be notified.
+type Preferences struct {
+    UserID     int64                                `json:"user_id"`
+    // Channels are the channels of each notification type. An empty list
+    // turns the type off; types missing from the map use DefaultChannels.
+    Channels   map[NotificationType][]Channel       `json:"channels"`
+    // QuietHours is nil if the user has none.
+    QuietHours *QuietHours                          `json:"quiet_hours,omitempty"`
+    // TimeZone is the IANA name of the user's time zone.
+    TimeZone   string                               `json:"time_zone"`
+    Digest     DigestFrequency                      `json:"digest"`
+    // FollowedCategories are the lot categories whose new lots the digest
+    // lists.
+    FollowedCategories []string                     `json:"followed_categories"`
+    UpdatedAt  time.Time                            `json:"updated_at"`
+}
+
+// DefaultPreferences are the preferences of a user who has not set any.
+func DefaultPreferences(userID int64) *Preferences {
+    return &Preferences{
+        UserID:   userID,
+        Channels: map[NotificationType][]Channel{},
+        TimeZone: "UTC",
+        Digest:   DigestNone,
+    }
+}
+
+// ChannelsFor returns the channels notifications of kind go to.
+func (p *Preferences) ChannelsFor(kind NotificationType) []Channel {
+    if channels, ok := p.Channels[kind]; ok {
+        return channels
+    }
+    return DefaultChannels
+}
+
+// Location is the user's time zone, or UTC if it is unknown.
+func (p *Preferences) Location() *time.Location {
+    loc, err := time.LoadLocation(p.TimeZone)
+    if err != nil {
+        return time.UTC
+    }
+    return loc
+}
+
+// Allows reports whether a notification of kind sent at now goes to
+// channel. During quiet hours only the in-app channel is used.
+func (p *Preferences) Allows(kind NotificationType, channel Channel, now time.Time) bool {
+    enabled := false
+    for _, c := range p.ChannelsFor(kind) {
+        if c == channel {
+            enabled = true
+        }
+    }
+    if !enabled {
+        return false
+    }
+    return channel == ChannelInApp || p.QuietHours == nil || !p.QuietHours.Contains(now, p.Location())
+}
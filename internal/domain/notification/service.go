@@ -8,5 +8,10 @@ import (
 type NotificationService interface {
     NotifyAuctionStarted(ctx context.Context, auction *entity.Auction, participants []int64) error
     NotifyAuctionResults(ctx context.Context, auction *entity.Auction, winner int64, participants []int64) error
-    NotifyTransactionStatus(ctx context.Context, userID int64, amount float64, success bool) error
+    NotifyTransactionStatus(ctx context.Context, userID int64, amount entity.Money, success bool) error
+    // NotifyOutbid tells userID that bid took the lead from them.
+    NotifyOutbid(ctx context.Context, auction *entity.Auction, userID int64, bid *entity.Bid) error
+    // NotifyNewBid tells the seller that bid was placed on their auction.
+    NotifyNewBid(ctx context.Context, auction *entity.Auction, sellerID int64, bid *entity.Bid) error
+    NotifyDigest(ctx context.Context, digest *DigestReport) error
 }
@@ -2,9 +2,13 @@ package payment
 
 import (
     "context"
+    "auction-system/internal/domain/entity"
 )
 
+// PaymentService moves money through the payment provider. key identifies
+// the operation: the provider carries out an operation at most once per key
+// and answers a repeated call with the result of the first one.
 type PaymentService interface {
-    ProcessPayment(ctx context.Context, userID int64, amount float64) error
-    RefundPayment(ctx context.Context, userID int64, amount float64) error
+    ProcessPayment(ctx context.Context, key string, userID int64, amount entity.Money) error
+    RefundPayment(ctx context.Context, key string, userID int64, amount entity.Money) error
 }
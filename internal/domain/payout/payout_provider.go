@@ -0,0 +1,14 @@
+package payout
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+// PayoutProvider sends the money of an approved withdrawal to its
+// destination and returns the provider's reference for the transfer.
+// A payout may be attempted again if booking it failed, so providers must
+// treat repeated calls for the same withdrawal ID as one payout.
+type PayoutProvider interface {
+    Payout(ctx context.Context, withdrawal *entity.Withdrawal) (string, error)
+}
@@ -8,6 +8,7 @@ import (
 type AuctionRepository interface {
     Create(ctx context.Context, auction *entity.Auction) error
     GetByID(ctx context.Context, id int64) (*entity.Auction, error)
+    GetByIDForUpdate(ctx context.Context, id int64) (*entity.Auction, error)
     Update(ctx context.Context, id int64, auction *entity.Auction) (*entity.Auction, error)
     List(ctx context.Context, offset, limit int, status *entity.AuctionStatus) ([]*entity.Auction, int64, error)
     
@@ -16,4 +17,5 @@ type AuctionRepository interface {
     GetActiveAuctions(ctx context.Context) ([]*entity.Auction, error)
     GetPendingAuctionsToStart(ctx context.Context) ([]*entity.Auction, error)
     GetEndedAuctions(ctx context.Context) ([]*entity.Auction, error)
+    GetActiveDutchAuctions(ctx context.Context) ([]*entity.Auction, error)
 }
@@ -0,0 +1,11 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+type AuctionWinnerRepository interface {
+    Create(ctx context.Context, winner *entity.AuctionWinner) error
+    GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.AuctionWinner, error)
+}
@@ -8,7 +8,13 @@ import (
 type BidRepository interface {
     Create(ctx context.Context, bid *entity.Bid) error
     GetByID(ctx context.Context, id int64) (*entity.Bid, error)
+    GetByAuctionAndUser(ctx context.Context, auctionID, userID int64) (*entity.Bid, error)
+    UpdateAmount(ctx context.Context, id int64, amount entity.Money) error
+    // GetByAuctionID, GetHighestByAuctionID and List rank bids the same
+    // way: by amount, then whoever reached the amount first (updated_at),
+    // then by ID.
     GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.Bid, error)
+    GetHighestByAuctionID(ctx context.Context, auctionID int64) (*entity.Bid, error)
     List(ctx context.Context, auctionID int64, offset, limit int) ([]*entity.Bid, int64, error)
     GetUniqueParticipantsByAuctionID(ctx context.Context, auctionID int64) ([]int64, error)
 }
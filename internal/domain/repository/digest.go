@@ -0,0 +1,20 @@
+package repository
+
+import (
+    "context"
+    "time"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+)
+
+type DigestRepository interface {
+    // Claim records the user's digest for the period starting on the day of
+    // periodStart and reports whether it was not recorded before.
+    Claim(ctx context.Context, userID int64, frequency notification.DigestFrequency, periodStart time.Time) (bool, error)
+    // GetBidAuctions returns the active single-unit auctions with open
+    // bidding that the user has bid on.
+    GetBidAuctions(ctx context.Context, userID int64) ([]*entity.Auction, error)
+    // GetNewLots returns the lots in the given categories created after
+    // since, newest first.
+    GetNewLots(ctx context.Context, categories []string, since time.Time) ([]*entity.Lot, error)
+}
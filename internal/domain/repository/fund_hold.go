@@ -0,0 +1,15 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+type FundHoldRepository interface {
+    Upsert(ctx context.Context, hold *entity.FundHold) error
+    GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.FundHold, error)
+    // GetTotalByUserID sums the user's holds in currency.
+    GetTotalByUserID(ctx context.Context, userID int64, currency string) (entity.Money, error)
+    Delete(ctx context.Context, auctionID, userID int64) error
+    DeleteByAuctionID(ctx context.Context, auctionID int64) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+    "context"
+    "time"
+    "auction-system/internal/domain/entity"
+)
+
+type IdempotencyRepository interface {
+    // Claim stores record unless its key is already taken for the user and
+    // method by a record created at or after staleBefore, which is then returned
+    // instead. Older records are replaced. A nil result means the claim
+    // succeeded.
+    Claim(ctx context.Context, record *entity.IdempotencyRecord, staleBefore time.Time) (*entity.IdempotencyRecord, error)
+    // Complete saves the response of a claimed request.
+    Complete(ctx context.Context, userID int64, key, method string, response []byte) error
+    // Release drops a claim so the key can be used again.
+    Release(ctx context.Context, userID int64, key, method string) error
+    // DeleteCreatedBefore removes records created before t.
+    DeleteCreatedBefore(ctx context.Context, t time.Time) (int64, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+type InvoiceRepository interface {
+    // Create assigns the next invoice number and stores the invoice. It must
+    // run in the transaction that settles the sale, so a rolled back sale
+    // does not use up a number.
+    Create(ctx context.Context, invoice *entity.Invoice) error
+    GetByID(ctx context.Context, id int64) (*entity.Invoice, error)
+    // GetByUserID returns the invoices the user is the buyer or the seller
+    // on, newest first.
+    GetByUserID(ctx context.Context, userID int64, offset, limit int) ([]*entity.Invoice, int64, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+type LedgerRepository interface {
+    // GetUserAccount returns the user's account in currency, opening it on
+    // first use.
+    GetUserAccount(ctx context.Context, userID int64, currency string) (*entity.Account, error)
+    // GetExternalAccount returns the external account in currency, opening
+    // it on first use.
+    GetExternalAccount(ctx context.Context, currency string) (*entity.Account, error)
+    // GetPlatformAccount returns the account collecting fees in currency,
+    // opening it on first use.
+    GetPlatformAccount(ctx context.Context, currency string) (*entity.Account, error)
+    // GetPayoutAccount returns the account holding withdrawals awaiting
+    // payout in currency, opening it on first use.
+    GetPayoutAccount(ctx context.Context, currency string) (*entity.Account, error)
+    // CreateTransaction stores the transaction together with its entries.
+    CreateTransaction(ctx context.Context, tx *entity.LedgerTransaction) error
+    GetBalance(ctx context.Context, accountID int64) (entity.Money, error)
+    ListStatement(ctx context.Context, accountID int64, offset, limit int) ([]*entity.StatementLine, int64, error)
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+type MaxBidRepository interface {
+    Upsert(ctx context.Context, maxBid *entity.MaxBid) error
+    GetByAuctionAndUser(ctx context.Context, auctionID, userID int64) (*entity.MaxBid, error)
+    GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.MaxBid, error)
+}
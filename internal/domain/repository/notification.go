@@ -0,0 +1,34 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/notification"
+)
+
+type NotificationRepository interface {
+    // Create stores a notification in its user's inbox and sets its ID and
+    // CreatedAt.
+    Create(ctx context.Context, n *notification.Notification) error
+    // GetByUserID returns the user's notifications, newest first, and how
+    // many there are. unreadOnly leaves out the ones already read.
+    GetByUserID(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]*notification.Notification, int64, error)
+    // CountUnread returns how many of the user's notifications are unread.
+    CountUnread(ctx context.Context, userID int64) (int64, error)
+    // MarkRead marks the user's notifications with the given IDs as read
+    // and returns how many were unread. IDs of other users' notifications
+    // are ignored.
+    MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error)
+    // MarkAllRead marks all of the user's notifications as read and returns
+    // how many were unread.
+    MarkAllRead(ctx context.Context, userID int64) (int64, error)
+}
+
+type NotificationPreferenceRepository interface {
+    // GetByUserID returns the user's preferences, or the default ones if the
+    // user has not set any.
+    GetByUserID(ctx context.Context, userID int64) (*notification.Preferences, error)
+    // Save replaces the user's preferences and sets UpdatedAt.
+    Save(ctx context.Context, preferences *notification.Preferences) error
+    // GetWithDigest returns the preferences of all users who get a digest.
+    GetWithDigest(ctx context.Context) ([]*notification.Preferences, error)
+}
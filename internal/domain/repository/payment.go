@@ -0,0 +1,17 @@
+package repository
+
+import (
+    "context"
+    "time"
+    "auction-system/internal/domain/entity"
+)
+
+type PaymentRepository interface {
+    Create(ctx context.Context, payment *entity.Payment) error
+    GetByIDForUpdate(ctx context.Context, id int64) (*entity.Payment, error)
+    GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.Payment, error)
+    // GetDue returns the PENDING, CHARGING and REFUNDING payments whose
+    // next attempt is due at now, oldest first.
+    GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.Payment, error)
+    Update(ctx context.Context, payment *entity.Payment) error
+}
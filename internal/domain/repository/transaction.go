@@ -0,0 +1,12 @@
+package repository
+
+import (
+    "context"
+)
+
+// TxManager runs a unit of work inside a single database transaction.
+// Repositories called with the context passed to fn participate in that
+// transaction; nested calls reuse the outer one.
+type TxManager interface {
+    WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
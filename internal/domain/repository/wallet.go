@@ -0,0 +1,17 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+type WalletRepository interface {
+    GetByUserID(ctx context.Context, userID int64) ([]*entity.Wallet, error)
+    // Get returns the user's wallet in currency, or an empty wallet if the
+    // user has none.
+    Get(ctx context.Context, userID int64, currency string) (*entity.Wallet, error)
+    // Adjust shifts the wallet in delta's currency by delta, opening it on
+    // first use. Only the ledger calls it, in the transaction that records
+    // the matching postings.
+    Adjust(ctx context.Context, userID int64, delta entity.Money) error
+}
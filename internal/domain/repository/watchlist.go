@@ -0,0 +1,16 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+type WatchlistRepository interface {
+    // Add puts the auction on the user's watchlist. Adding an auction that
+    // is already there does nothing.
+    Add(ctx context.Context, userID, auctionID int64) error
+    // Remove takes the auction off the user's watchlist.
+    Remove(ctx context.Context, userID, auctionID int64) error
+    // GetByUserID returns the user's watched auctions, ending soonest first.
+    GetByUserID(ctx context.Context, userID int64) ([]*entity.Auction, error)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+    "context"
+    "time"
+    "auction-system/internal/domain/entity"
+)
+
+type WebhookRepository interface {
+    Create(ctx context.Context, webhook *entity.Webhook) error
+    GetByID(ctx context.Context, id int64) (*entity.Webhook, error)
+    // GetByUserID returns the user's webhooks, oldest first.
+    GetByUserID(ctx context.Context, userID int64) ([]*entity.Webhook, error)
+    // Delete removes the webhook together with its deliveries.
+    Delete(ctx context.Context, id int64) error
+}
+
+type WebhookDeliveryRepository interface {
+    // CreateForEvent queues a pending delivery of event, encoded as
+    // payload, for every webhook that wants events of its type.
+    CreateForEvent(ctx context.Context, event *entity.AuctionEvent, payload []byte) error
+    // CreateForUser queues a pending delivery of a notification of
+    // eventType, encoded as payload, for every webhook of the user. Event
+    // filters do not apply to notifications.
+    CreateForUser(ctx context.Context, userID int64, eventType string, payload []byte) error
+    GetByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error)
+    // GetByIDForUpdate locks the delivery until the surrounding
+    // transaction ends.
+    GetByIDForUpdate(ctx context.Context, id int64) (*entity.WebhookDelivery, error)
+    // GetByWebhookID returns the webhook's deliveries, newest first, only
+    // those in status unless it is empty.
+    GetByWebhookID(ctx context.Context, webhookID int64, status entity.WebhookDeliveryStatus, offset, limit int) ([]*entity.WebhookDelivery, int64, error)
+    // GetDue returns pending deliveries whose next attempt is due at now,
+    // oldest first.
+    GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.WebhookDelivery, error)
+    // Update saves the delivery's status and attempt details.
+    Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
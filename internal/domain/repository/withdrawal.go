@@ -0,0 +1,25 @@
+package repository
+
+import (
+    "context"
+    "auction-system/internal/domain/entity"
+)
+
+type WithdrawalRepository interface {
+    // Create stores a new withdrawal and records its first status.
+    Create(ctx context.Context, withdrawal *entity.Withdrawal) error
+    // GetByID returns the withdrawal with its history.
+    GetByID(ctx context.Context, id int64) (*entity.Withdrawal, error)
+    // GetByIDForUpdate locks the withdrawal until the surrounding
+    // transaction ends. The history is not loaded.
+    GetByIDForUpdate(ctx context.Context, id int64) (*entity.Withdrawal, error)
+    // GetByUserID returns the user's withdrawals, newest first, without
+    // their history.
+    GetByUserID(ctx context.Context, userID int64, offset, limit int) ([]*entity.Withdrawal, int64, error)
+    // GetApproved returns approved withdrawals awaiting payout, oldest
+    // first.
+    GetApproved(ctx context.Context, limit int) ([]*entity.Withdrawal, error)
+    // UpdateStatus saves the withdrawal's status, reviewer, reference and
+    // note, and records the change in its history on behalf of actorID.
+    UpdateStatus(ctx context.Context, withdrawal *entity.Withdrawal, actorID *int64) error
+}
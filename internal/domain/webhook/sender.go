@@ -0,0 +1,55 @@
+package webhook
+
+import (
+    "context"
+    "crypto/hmac"
+    "crypto/sha256"
+    "encoding/hex"
+    "net/netip"
+    "strconv"
+    "auction-system/internal/domain/entity"
+)
+
+// Headers of a webhook request.
+const (
+    DeliveryHeader  = "X-Webhook-Delivery"
+    EventHeader     = "X-Webhook-Event"
+    TimestampHeader = "X-Webhook-Timestamp"
+    // SignatureHeader is "sha256=" followed by the hex Signature of the
+    // request.
+    SignatureHeader = "X-Webhook-Signature"
+)
+
+// Sender POSTs a delivery's payload to its webhook. It returns the HTTP
+// status of the response, or 0 if none arrived, and an error unless the
+// receiver accepted the delivery with a 2xx status.
+type Sender interface {
+    Send(ctx context.Context, webhook *entity.Webhook, delivery *entity.WebhookDelivery) (int, error)
+}
+
+// Signature is the HMAC-SHA256, keyed with secret, of the request's Unix
+// timestamp, a dot and the payload. Signing the timestamp lets receivers
+// reject old requests replayed at them.
+func Signature(secret string, timestamp int64, payload []byte) string {
+    mac := hmac.New(sha256.New, []byte(secret))
+    mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+    mac.Write([]byte("."))
+    mac.Write(payload)
+    return hex.EncodeToString(mac.Sum(nil))
+}
+
+// PublicAddress reports whether addr may receive webhooks. Loopback,
+// link-local, private, multicast and unspecified addresses reach the
+// platform's own network rather than the receiver's, so they are refused
+// unless private networks are explicitly allowed.
+func PublicAddress(addr netip.Addr) bool {
+    addr = addr.Unmap()
+    return addr.IsValid() &&
+        !addr.IsLoopback() &&
+        !addr.IsLinkLocalUnicast() &&
+        !addr.IsLinkLocalMulticast() &&
+        !addr.IsInterfaceLocalMulticast() &&
+        !addr.IsMulticast() &&
+        !addr.IsPrivate() &&
+        !addr.IsUnspecified()
+}
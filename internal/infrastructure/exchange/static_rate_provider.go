@@ -0,0 +1,81 @@
+package exchange
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "os"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/exchange"
+)
+
+// StaticRateProvider quotes fixed rates against a base currency. Rates
+// between two non-base currencies are crossed through the base.
+type StaticRateProvider struct {
+    base  string
+    rates map[string]float64
+}
+
+// DefaultRates are used when no rates file is configured.
+var DefaultRates = map[string]float64{
+    "USD": 1,
+    "EUR": 0.92,
+    "GBP": 0.79,
+    "RUB": 92.5,
+}
+
+// NewStaticRateProvider takes rates as units of each currency per unit of
+// base.
+func NewStaticRateProvider(base string, rates map[string]float64) exchange.ExchangeRateProvider {
+    copied := make(map[string]float64, len(rates)+1)
+    for currency, rate := range rates {
+        copied[currency] = rate
+    }
+    copied[base] = 1
+    return &StaticRateProvider{base: base, rates: copied}
+}
+
+type ratesFile struct {
+    Base  string             `json:"base"`
+    Rates map[string]float64 `json:"rates"`
+}
+
+// LoadStaticRateProvider reads rates from a JSON file of the form
+// {"base": "USD", "rates": {"EUR": 0.92}}.
+func LoadStaticRateProvider(path string) (exchange.ExchangeRateProvider, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("read exchange rates: %w", err)
+    }
+
+    var file ratesFile
+    if err := json.Unmarshal(data, &file); err != nil {
+        return nil, fmt.Errorf("parse exchange rates: %w", err)
+    }
+    if !entity.ValidCurrency(file.Base) {
+        return nil, fmt.Errorf("invalid base currency %q", file.Base)
+    }
+    for currency, rate := range file.Rates {
+        if !entity.ValidCurrency(currency) || rate <= 0 {
+            return nil, fmt.Errorf("invalid exchange rate %q: %v", currency, rate)
+        }
+    }
+
+    return NewStaticRateProvider(file.Base, file.Rates), nil
+}
+
+func (p *StaticRateProvider) Rate(ctx context.Context, from, to string) (float64, error) {
+    if from == to {
+        return 1, nil
+    }
+    fromRate, ok := p.rates[from]
+    if !ok {
+        return 0, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("no exchange rate for %s", from), nil)
+    }
+    toRate, ok := p.rates[to]
+    if !ok {
+        return 0, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("no exchange rate for %s", to), nil)
+    }
+    return toRate / fromRate, nil
+}
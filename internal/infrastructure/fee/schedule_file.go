@@ -0,0 +1,120 @@
+package fee
+
+import (
+    "encoding/json"
+    "fmt"
+    "os"
+    "auction-system/internal/domain/entity"
+)
+
+type ruleFile struct {
+    Flat    string     `json:"flat"`
+    Percent float64    `json:"percent"`
+    Tiers   []tierFile `json:"tiers"`
+}
+
+type tierFile struct {
+    From    string  `json:"from"`
+    Percent float64 `json:"percent"`
+}
+
+type categoryFile struct {
+    Commission   *ruleFile `json:"commission"`
+    BuyerPremium *ruleFile `json:"buyer_premium"`
+}
+
+type scheduleFile struct {
+    Commission   ruleFile                `json:"commission"`
+    BuyerPremium ruleFile                `json:"buyer_premium"`
+    Categories   map[string]categoryFile `json:"categories"`
+}
+
+// LoadSchedule reads a fee schedule from a JSON file of the form
+//
+//  {
+//      "commission": {"percent": 10, "flat": "0.50"},
+//      "buyer_premium": {"tiers": [{"from": "0", "percent": 25}, {"from": "1000", "percent": 20}]},
+//      "categories": {"art": {"commission": {"percent": 5}}}
+//  }
+//
+// Amounts are decimal strings in the currency of the sale.
+func LoadSchedule(path string) (entity.FeeSchedule, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return entity.FeeSchedule{}, fmt.Errorf("read fee schedule: %w", err)
+    }
+
+    var file scheduleFile
+    if err := json.Unmarshal(data, &file); err != nil {
+        return entity.FeeSchedule{}, fmt.Errorf("parse fee schedule: %w", err)
+    }
+
+    var schedule entity.FeeSchedule
+    if schedule.Commission, err = file.Commission.toRule(); err != nil {
+        return entity.FeeSchedule{}, fmt.Errorf("commission: %w", err)
+    }
+    if schedule.BuyerPremium, err = file.BuyerPremium.toRule(); err != nil {
+        return entity.FeeSchedule{}, fmt.Errorf("buyer premium: %w", err)
+    }
+
+    if len(file.Categories) > 0 {
+        schedule.Categories = make(map[string]entity.CategoryFees, len(file.Categories))
+    }
+    for category, override := range file.Categories {
+        var fees entity.CategoryFees
+        if override.Commission != nil {
+            rule, err := override.Commission.toRule()
+            if err != nil {
+                return entity.FeeSchedule{}, fmt.Errorf("category %q commission: %w", category, err)
+            }
+            fees.Commission = &rule
+        }
+        if override.BuyerPremium != nil {
+            rule, err := override.BuyerPremium.toRule()
+            if err != nil {
+                return entity.FeeSchedule{}, fmt.Errorf("category %q buyer premium: %w", category, err)
+            }
+            fees.BuyerPremium = &rule
+        }
+        schedule.Categories[category] = fees
+    }
+
+    return schedule, nil
+}
+
+func (f ruleFile) toRule() (entity.FeeRule, error) {
+    rule := entity.FeeRule{Percent: f.Percent}
+    if f.Flat != "" {
+        flat, err := entity.ParseMoney(f.Flat, "")
+        if err != nil || flat.IsNegative() {
+            return entity.FeeRule{}, fmt.Errorf("invalid flat fee %q", f.Flat)
+        }
+        rule.Flat = flat
+    }
+    if !validPercent(f.Percent) {
+        return entity.FeeRule{}, fmt.Errorf("invalid percent %v", f.Percent)
+    }
+
+    for i, t := range f.Tiers {
+        from, err := entity.ParseMoney(t.From, "")
+        if err != nil {
+            return entity.FeeRule{}, fmt.Errorf("invalid tier start %q", t.From)
+        }
+        if i == 0 && !from.IsZero() {
+            return entity.FeeRule{}, fmt.Errorf("the first tier must start at 0")
+        }
+        if i > 0 && !from.GreaterThan(rule.Tiers[i-1].From) {
+            return entity.FeeRule{}, fmt.Errorf("tiers must be in ascending order")
+        }
+        if !validPercent(t.Percent) {
+            return entity.FeeRule{}, fmt.Errorf("invalid tier percent %v", t.Percent)
+        }
+        rule.Tiers = append(rule.Tiers, entity.FeeTier{From: from, Percent: t.Percent})
+    }
+
+    return rule, nil
+}
+
+func validPercent(percent float64) bool {
+    return percent >= 0 && percent <= 100
+}
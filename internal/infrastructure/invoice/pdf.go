@@ -0,0 +1,34 @@
+package invoice
+
+import (
+    "io"
+    "strings"
+    "auction-system/internal/domain/entity"
+    "github.com/jung-kurt/gofpdf"
+)
+
+const (
+    pdfFontSize   = 10
+    pdfLineHeight = 5
+)
+
+// writePDF sets text on A4 pages, one template line per line.
+func (r *TemplateRenderer) writePDF(w io.Writer, inv *entity.Invoice, text string) error {
+    pdf := gofpdf.New("P", "mm", "A4", "")
+    pdf.SetTitle("Invoice "+inv.Number, true)
+    // A fixed creation date keeps the document the same on every download.
+    pdf.SetCreationDate(inv.IssuedAt)
+
+    family, translate := "Courier", pdf.UnicodeTranslatorFromDescriptor("")
+    if r.fontFile != "" {
+        pdf.AddUTF8Font("invoice", "", r.fontFile)
+        family, translate = "invoice", func(s string) string { return s }
+    }
+
+    pdf.AddPage()
+    pdf.SetFont(family, "", pdfFontSize)
+    for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+        pdf.MultiCell(0, pdfLineHeight, translate(line), "", "L", false)
+    }
+    return pdf.Output(w)
+}
@@ -0,0 +1,122 @@
+package invoice
+
+import (
+    "bytes"
+    "embed"
+    "fmt"
+    htmlTemplate "html/template"
+    "io/fs"
+    "os"
+    "strconv"
+    textTemplate "text/template"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/invoice"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+const (
+    textTemplateName = "invoice.txt.tmpl"
+    htmlTemplateName = "invoice.html.tmpl"
+)
+
+var templateFuncs = map[string]any{
+    "money": func(m entity.Money) string {
+        return m.String() + " " + m.Currency
+    },
+    "percent": func(rate float64) string {
+        return strconv.FormatFloat(rate, 'f', -1, 64) + "%"
+    },
+}
+
+// TemplateRenderer renders invoices from a plain-text and an HTML template.
+// The PDF is the plain-text rendering set in a fixed-width font, so every
+// format says the same thing.
+type TemplateRenderer struct {
+    text     *textTemplate.Template
+    html     *htmlTemplate.Template
+    // fontFile is a TrueType font for the PDF; without one the PDF uses
+    // Courier, which only covers Western European characters.
+    fontFile string
+}
+
+// NewTemplateRenderer renders with the built-in templates.
+func NewTemplateRenderer() invoice.Renderer {
+    renderer, err := loadTemplates(builtinTemplates())
+    if err != nil {
+        panic(err)
+    }
+    return renderer
+}
+
+// LoadTemplateRenderer reads invoice.txt.tmpl and invoice.html.tmpl from
+// dir, or uses the built-in ones if dir is empty. The templates are
+// executed with an entity.Invoice and may use the money and percent
+// functions. fontFile is an optional TrueType font for the PDF.
+func LoadTemplateRenderer(dir, fontFile string) (invoice.Renderer, error) {
+    templates := builtinTemplates()
+    if dir != "" {
+        templates = os.DirFS(dir)
+    }
+    renderer, err := loadTemplates(templates)
+    if err != nil {
+        return nil, err
+    }
+    if fontFile != "" {
+        if _, err := os.Stat(fontFile); err != nil {
+            return nil, fmt.Errorf("invoice font: %w", err)
+        }
+    }
+    renderer.fontFile = fontFile
+    return renderer, nil
+}
+
+func builtinTemplates() fs.FS {
+    templates, err := fs.Sub(defaultTemplates, "templates")
+    if err != nil {
+        panic(err)
+    }
+    return templates
+}
+
+func loadTemplates(fsys fs.FS) (*TemplateRenderer, error) {
+    text, err := textTemplate.New(textTemplateName).Funcs(templateFuncs).ParseFS(fsys, textTemplateName)
+    if err != nil {
+        return nil, fmt.Errorf("parse invoice text template: %w", err)
+    }
+    html, err := htmlTemplate.New(htmlTemplateName).Funcs(templateFuncs).ParseFS(fsys, htmlTemplateName)
+    if err != nil {
+        return nil, fmt.Errorf("parse invoice html template: %w", err)
+    }
+    return &TemplateRenderer{text: text, html: html}, nil
+}
+
+func (r *TemplateRenderer) Render(inv *entity.Invoice, format invoice.Format) (*invoice.Document, error) {
+    var text, out bytes.Buffer
+    doc := &invoice.Document{Filename: inv.Number + format.Extension()}
+
+    var err error
+    switch format {
+    case invoice.FormatText:
+        doc.ContentType = "text/plain; charset=utf-8"
+        err = r.text.Execute(&out, inv)
+    case invoice.FormatHTML:
+        doc.ContentType = "text/html; charset=utf-8"
+        err = r.html.Execute(&out, inv)
+    case invoice.FormatPDF:
+        doc.ContentType = "application/pdf"
+        if err = r.text.Execute(&text, inv); err == nil {
+            err = r.writePDF(&out, inv, text.String())
+        }
+    default:
+        return nil, errors.New(errors.ErrorTypeValidation, fmt.Sprintf("unsupported invoice format %q", format), nil)
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to render invoice", err)
+    }
+
+    doc.Content = out.Bytes()
+    return doc, nil
+}
@@ -0,0 +1,94 @@
+package notification
+
+import (
+    "context"
+    "fmt"
+    "log"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/repository"
+)
+
+// EmailNotificationAdapter emails notifications to the users' addresses.
+// Users without an email address are skipped. When a notification goes to
+// several users, a failure for one of them does not stop the others.
+type EmailNotificationAdapter struct {
+    userRepo  repository.UserRepository
+    templates *EmailTemplates
+    mailer    Mailer
+}
+
+func NewEmailNotificationAdapter(userRepo repository.UserRepository, templates *EmailTemplates, mailer Mailer) notification.NotificationService {
+    return &EmailNotificationAdapter{
+        userRepo:  userRepo,
+        templates: templates,
+        mailer:    mailer,
+    }
+}
+
+func (a *EmailNotificationAdapter) NotifyAuctionStarted(ctx context.Context, auction *entity.Auction, participants []int64) error {
+    var firstErr error
+    for _, userID := range participants {
+        if err := a.send(ctx, userID, notification.AuctionStarted, &EmailData{Auction: auction}); err != nil && firstErr == nil {
+            firstErr = err
+        }
+    }
+    return firstErr
+}
+
+func (a *EmailNotificationAdapter) NotifyAuctionResults(ctx context.Context, auction *entity.Auction, winner int64, participants []int64) error {
+    var firstErr error
+    for _, userID := range participants {
+        kind, data := notification.AuctionClosed, &EmailData{Auction: auction}
+        if userID == winner {
+            kind = notification.AuctionWon
+            data.Quantity, data.Amount = auction.Won(userID)
+        }
+        if err := a.send(ctx, userID, kind, data); err != nil && firstErr == nil {
+            firstErr = err
+        }
+    }
+    return firstErr
+}
+
+func (a *EmailNotificationAdapter) NotifyTransactionStatus(ctx context.Context, userID int64, amount entity.Money, success bool) error {
+    kind := notification.TransactionComplete
+    if !success {
+        kind = notification.TransactionFailed
+    }
+    return a.send(ctx, userID, kind, &EmailData{Amount: amount})
+}
+
+func (a *EmailNotificationAdapter) NotifyOutbid(ctx context.Context, auction *entity.Auction, userID int64, bid *entity.Bid) error {
+    return a.send(ctx, userID, notification.Outbid, &EmailData{Auction: auction, Bid: bid})
+}
+
+func (a *EmailNotificationAdapter) NotifyNewBid(ctx context.Context, auction *entity.Auction, sellerID int64, bid *entity.Bid) error {
+    return a.send(ctx, sellerID, notification.NewBid, &EmailData{Auction: auction, Bid: bid})
+}
+
+func (a *EmailNotificationAdapter) NotifyDigest(ctx context.Context, digest *notification.DigestReport) error {
+    return a.send(ctx, digest.UserID, notification.Digest, &EmailData{Digest: digest})
+}
+
+func (a *EmailNotificationAdapter) send(ctx context.Context, userID int64, kind notification.NotificationType, data *EmailData) error {
+    user, err := a.userRepo.GetByID(ctx, userID)
+    if err != nil {
+        return err
+    }
+    if user.Email == "" {
+        log.Printf("User %d has no email address, %s email not sent", userID, kind)
+        return nil
+    }
+
+    data.User = user
+    email, err := a.templates.Render(kind, data)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to render email", err)
+    }
+    if err := a.mailer.Send(ctx, email); err != nil {
+        return errors.New(errors.ErrorTypeInternal, fmt.Sprintf("failed to email user %d", userID), err)
+    }
+    return nil
+}
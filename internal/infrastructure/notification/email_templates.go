@@ -0,0 +1,142 @@
+package notification
+
+import (
+    "bytes"
+    "embed"
+    "fmt"
+    htmlTemplate "html/template"
+    "io/fs"
+    "os"
+    "path"
+    "strings"
+    textTemplate "text/template"
+    "time"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+// emailTypes are the notifications sent by email; each needs a template.
+var emailTypes = []notification.NotificationType{
+    notification.AuctionStarted,
+    notification.AuctionClosed,
+    notification.AuctionWon,
+    notification.NewBid,
+    notification.Outbid,
+    notification.TransactionComplete,
+    notification.TransactionFailed,
+    notification.Digest,
+}
+
+const htmlLayoutName = "layout.html.tmpl"
+
+var templateFuncs = map[string]any{
+    "money": func(m entity.Money) string {
+        return m.String() + " " + m.Currency
+    },
+    "datetime": func(t time.Time) string {
+        return t.Format("2006-01-02 15:04 MST")
+    },
+}
+
+// EmailData is what email templates are executed with. Auction and Bid are
+// nil for transaction notifications. Amount is the transaction amount, or
+// for AUCTION_WON what the Quantity units won cost before fees; it is zero
+// for the others. Digest is only set for digests.
+type EmailData struct {
+    User     *entity.User
+    Auction  *entity.Auction
+    Bid      *entity.Bid
+    Amount   entity.Money
+    Quantity int
+    Digest   *notification.DigestReport
+}
+
+// Email is a rendered email for one recipient.
+type Email struct {
+    To      string
+    Subject string
+    Text    string
+    HTML    string
+}
+
+type emailTemplate struct {
+    text *textTemplate.Template
+    html *htmlTemplate.Template
+}
+
+// EmailTemplates renders notification emails in one language. Every
+// notification type has a plain-text template, whose "subject" block is the
+// subject line, and an HTML template, which fills the "content" block of
+// layout.html.tmpl.
+type EmailTemplates struct {
+    templates map[notification.NotificationType]*emailTemplate
+}
+
+// NewEmailTemplates renders with the built-in templates for locale, "en" or
+// "ru".
+func NewEmailTemplates(locale string) (*EmailTemplates, error) {
+    return LoadEmailTemplates("", locale)
+}
+
+// LoadEmailTemplates reads the templates for locale from the locale
+// subdirectory of dir, or uses the built-in ones if dir is empty. A type's
+// templates are named after it in lower case, e.g. auction_won.txt.tmpl and
+// auction_won.html.tmpl.
+func LoadEmailTemplates(dir, locale string) (*EmailTemplates, error) {
+    var templates fs.FS = defaultTemplates
+    root := "templates"
+    if dir != "" {
+        templates, root = os.DirFS(dir), "."
+    }
+    localized, err := fs.Sub(templates, path.Join(root, locale))
+    if err != nil {
+        return nil, fmt.Errorf("email templates for locale %q: %w", locale, err)
+    }
+
+    t := &EmailTemplates{templates: make(map[notification.NotificationType]*emailTemplate)}
+    for _, kind := range emailTypes {
+        name := strings.ToLower(string(kind))
+        text, err := textTemplate.New(name + ".txt.tmpl").Funcs(templateFuncs).ParseFS(localized, name+".txt.tmpl")
+        if err != nil {
+            return nil, fmt.Errorf("parse %s email text template: %w", locale, err)
+        }
+        if text.Lookup("subject") == nil {
+            return nil, fmt.Errorf("%s email text template %s.txt.tmpl has no subject", locale, name)
+        }
+        html, err := htmlTemplate.New(htmlLayoutName).Funcs(templateFuncs).ParseFS(localized, htmlLayoutName, name+".html.tmpl")
+        if err != nil {
+            return nil, fmt.Errorf("parse %s email html template: %w", locale, err)
+        }
+        t.templates[kind] = &emailTemplate{text: text, html: html}
+    }
+    return t, nil
+}
+
+// Render renders the email about a notification of kind for data.User.
+func (t *EmailTemplates) Render(kind notification.NotificationType, data *EmailData) (*Email, error) {
+    tmpl, ok := t.templates[kind]
+    if !ok {
+        return nil, fmt.Errorf("no email template for %s notifications", kind)
+    }
+
+    var subject, text, html bytes.Buffer
+    if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+        return nil, fmt.Errorf("render %s email subject: %w", kind, err)
+    }
+    if err := tmpl.text.Execute(&text, data); err != nil {
+        return nil, fmt.Errorf("render %s email text: %w", kind, err)
+    }
+    if err := tmpl.html.Execute(&html, data); err != nil {
+        return nil, fmt.Errorf("render %s email html: %w", kind, err)
+    }
+
+    return &Email{
+        To:      data.User.Email,
+        Subject: strings.TrimSpace(subject.String()),
+        Text:    strings.TrimSpace(text.String()) + "\n",
+        HTML:    html.String(),
+    }, nil
+}
@@ -0,0 +1,33 @@
+package notification
+
+import (
+    "context"
+    "auction-system/internal/domain/event"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/repository"
+)
+
+// InboxNotificationAdapter stores every notification in the user's in-app
+// inbox and sends it to the user's open event streams under the same ID.
+// Inside a transaction, both happen only if the transaction commits.
+type InboxNotificationAdapter struct {
+    messageNotifier
+    inbox     repository.NotificationRepository
+    publisher event.NotificationPublisher
+}
+
+func NewInboxNotificationAdapter(inbox repository.NotificationRepository, publisher event.NotificationPublisher) notification.NotificationService {
+    a := &InboxNotificationAdapter{
+        inbox:     inbox,
+        publisher: publisher,
+    }
+    a.deliver = a.publish
+    return a
+}
+
+func (a *InboxNotificationAdapter) publish(ctx context.Context, n *notification.Notification) error {
+    if err := a.inbox.Create(ctx, n); err != nil {
+        return err
+    }
+    return a.publisher.Publish(ctx, n)
+}
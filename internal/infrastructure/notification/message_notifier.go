@@ -0,0 +1,83 @@
+package notification
+
+import (
+    "context"
+    "fmt"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+)
+
+// messageNotifier turns notifications into short text messages, one per
+// user, and hands them to deliver. It stops at the first failed delivery,
+// so inside a transaction nothing is delivered unless all of them are.
+type messageNotifier struct {
+    deliver func(ctx context.Context, n *notification.Notification) error
+}
+
+func (m *messageNotifier) NotifyAuctionStarted(ctx context.Context, auction *entity.Auction, participants []int64) error {
+    for _, userID := range participants {
+        if err := m.send(ctx, userID, notification.AuctionStarted, fmt.Sprintf("Auction %d has started", auction.ID)); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func (m *messageNotifier) NotifyAuctionResults(ctx context.Context, auction *entity.Auction, winner int64, participants []int64) error {
+    for _, userID := range participants {
+        kind, message := notification.AuctionClosed, fmt.Sprintf("Auction %d has ended", auction.ID)
+        if userID == winner {
+            kind, message = notification.AuctionWon, wonMessage(auction, userID)
+        }
+        if err := m.send(ctx, userID, kind, message); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// wonMessage tells userID what they won: the units allocated to them and
+// what those cost at the clearing price.
+func wonMessage(auction *entity.Auction, userID int64) string {
+    quantity, total := auction.Won(userID)
+    if quantity > 1 {
+        return fmt.Sprintf("You won %d units in auction %d for %s %s", quantity, auction.ID, total, total.Currency)
+    }
+    return fmt.Sprintf("You won auction %d for %s %s", auction.ID, total, total.Currency)
+}
+
+func (m *messageNotifier) NotifyTransactionStatus(ctx context.Context, userID int64, amount entity.Money, success bool) error {
+    kind, message := notification.TransactionComplete, fmt.Sprintf("Transaction of %s %s succeeded", amount, amount.Currency)
+    if !success {
+        kind, message = notification.TransactionFailed, fmt.Sprintf("Transaction of %s %s failed", amount, amount.Currency)
+    }
+    return m.send(ctx, userID, kind, message)
+}
+
+func (m *messageNotifier) NotifyOutbid(ctx context.Context, auction *entity.Auction, userID int64, bid *entity.Bid) error {
+    message := fmt.Sprintf("You were outbid on auction %d, the leading bid is %s %s", auction.ID, bid.Amount, bid.Amount.Currency)
+    return m.send(ctx, userID, notification.Outbid, message)
+}
+
+func (m *messageNotifier) NotifyNewBid(ctx context.Context, auction *entity.Auction, sellerID int64, bid *entity.Bid) error {
+    message := fmt.Sprintf("New bid of %s %s on auction %d", bid.Amount, bid.Amount.Currency, auction.ID)
+    return m.send(ctx, sellerID, notification.NewBid, message)
+}
+
+func (m *messageNotifier) NotifyDigest(ctx context.Context, digest *notification.DigestReport) error {
+    period := "Daily"
+    if digest.Frequency == notification.DigestWeekly {
+        period = "Weekly"
+    }
+    message := fmt.Sprintf("%s digest: %d watched auctions ending soon, leading in %d, outbid in %d, %d new lots",
+        period, len(digest.EndingSoon), len(digest.Leading), len(digest.Outbid), len(digest.NewLots))
+    return m.send(ctx, digest.UserID, notification.Digest, message)
+}
+
+func (m *messageNotifier) send(ctx context.Context, userID int64, kind notification.NotificationType, message string) error {
+    return m.deliver(ctx, &notification.Notification{
+        UserID:  userID,
+        Type:    kind,
+        Message: message,
+    })
+}
@@ -28,11 +28,27 @@ func (a *MockNotificationAdapter) NotifyAuctionResults(ctx context.Context, auct
     return nil
 }
 
-func (a *MockNotificationAdapter) NotifyTransactionStatus(ctx context.Context, userID int64, amount float64, success bool) error {
+func (a *MockNotificationAdapter) NotifyTransactionStatus(ctx context.Context, userID int64, amount entity.Money, success bool) error {
     status := "succeeded"
     if !success {
         status = "failed"
     }
-    a.logger.Printf("Transaction %s for user %d: %.2f", status, userID, amount)
+    a.logger.Printf("Transaction %s for user %d: %s", status, userID, amount)
+    return nil
+}
+
+func (a *MockNotificationAdapter) NotifyOutbid(ctx context.Context, auction *entity.Auction, userID int64, bid *entity.Bid) error {
+    a.logger.Printf("User %d was outbid on auction %d: %s", userID, auction.ID, bid.Amount)
+    return nil
+}
+
+func (a *MockNotificationAdapter) NotifyNewBid(ctx context.Context, auction *entity.Auction, sellerID int64, bid *entity.Bid) error {
+    a.logger.Printf("New bid of %s on auction %d. Notifying seller %d", bid.Amount, auction.ID, sellerID)
+    return nil
+}
+
+func (a *MockNotificationAdapter) NotifyDigest(ctx context.Context, digest *notification.DigestReport) error {
+    a.logger.Printf("%s digest for user %d: %d ending soon, leading %d, outbid %d, %d new lots",
+        digest.Frequency, digest.UserID, len(digest.EndingSoon), len(digest.Leading), len(digest.Outbid), len(digest.NewLots))
     return nil
 }
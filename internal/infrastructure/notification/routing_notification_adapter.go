@@ -0,0 +1,123 @@
+package notification
+
+import (
+    "context"
+    "time"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/repository"
+)
+
+// routingOrder is the order channels are notified in.
+var routingOrder = []notification.Channel{
+    notification.ChannelInApp,
+    notification.ChannelEmail,
+    notification.ChannelWebhook,
+}
+
+// RoutingNotificationAdapter sends every notification to the channels its
+// user has enabled for its type. Channels without a service are skipped. A
+// failing channel does not stop the others; the first error is returned.
+type RoutingNotificationAdapter struct {
+    preferences repository.NotificationPreferenceRepository
+    channels    map[notification.Channel]notification.NotificationService
+}
+
+func NewRoutingNotificationAdapter(preferences repository.NotificationPreferenceRepository, channels map[notification.Channel]notification.NotificationService) notification.NotificationService {
+    return &RoutingNotificationAdapter{
+        preferences: preferences,
+        channels:    channels,
+    }
+}
+
+func (a *RoutingNotificationAdapter) NotifyAuctionStarted(ctx context.Context, auction *entity.Auction, participants []int64) error {
+    kind := func(int64) notification.NotificationType { return notification.AuctionStarted }
+    return a.route(ctx, participants, kind, func(service notification.NotificationService, users []int64) error {
+        return service.NotifyAuctionStarted(ctx, auction, users)
+    })
+}
+
+func (a *RoutingNotificationAdapter) NotifyAuctionResults(ctx context.Context, auction *entity.Auction, winner int64, participants []int64) error {
+    kind := func(userID int64) notification.NotificationType {
+        if userID == winner {
+            return notification.AuctionWon
+        }
+        return notification.AuctionClosed
+    }
+    return a.route(ctx, participants, kind, func(service notification.NotificationService, users []int64) error {
+        return service.NotifyAuctionResults(ctx, auction, winner, users)
+    })
+}
+
+func (a *RoutingNotificationAdapter) NotifyTransactionStatus(ctx context.Context, userID int64, amount entity.Money, success bool) error {
+    kind := func(int64) notification.NotificationType {
+        if success {
+            return notification.TransactionComplete
+        }
+        return notification.TransactionFailed
+    }
+    return a.route(ctx, []int64{userID}, kind, func(service notification.NotificationService, _ []int64) error {
+        return service.NotifyTransactionStatus(ctx, userID, amount, success)
+    })
+}
+
+func (a *RoutingNotificationAdapter) NotifyOutbid(ctx context.Context, auction *entity.Auction, userID int64, bid *entity.Bid) error {
+    kind := func(int64) notification.NotificationType { return notification.Outbid }
+    return a.route(ctx, []int64{userID}, kind, func(service notification.NotificationService, _ []int64) error {
+        return service.NotifyOutbid(ctx, auction, userID, bid)
+    })
+}
+
+func (a *RoutingNotificationAdapter) NotifyNewBid(ctx context.Context, auction *entity.Auction, sellerID int64, bid *entity.Bid) error {
+    kind := func(int64) notification.NotificationType { return notification.NewBid }
+    return a.route(ctx, []int64{sellerID}, kind, func(service notification.NotificationService, _ []int64) error {
+        return service.NotifyNewBid(ctx, auction, sellerID, bid)
+    })
+}
+
+func (a *RoutingNotificationAdapter) NotifyDigest(ctx context.Context, digest *notification.DigestReport) error {
+    kind := func(int64) notification.NotificationType { return notification.Digest }
+    return a.route(ctx, []int64{digest.UserID}, kind, func(service notification.NotificationService, _ []int64) error {
+        return service.NotifyDigest(ctx, digest)
+    })
+}
+
+// route calls send once per channel with the users, of those given, who
+// get their notification of type kind over it.
+func (a *RoutingNotificationAdapter) route(
+    ctx context.Context,
+    users []int64,
+    kind func(userID int64) notification.NotificationType,
+    send func(service notification.NotificationService, users []int64) error,
+) error {
+    preferences := make(map[int64]*notification.Preferences, len(users))
+    for _, userID := range users {
+        p, err := a.preferences.GetByUserID(ctx, userID)
+        if err != nil {
+            return err
+        }
+        preferences[userID] = p
+    }
+
+    now := time.Now()
+    var firstErr error
+    for _, channel := range routingOrder {
+        service, ok := a.channels[channel]
+        if !ok {
+            continue
+        }
+        var recipients []int64
+        for _, userID := range users {
+            if preferences[userID].Allows(kind(userID), channel, now) {
+                recipients = append(recipients, userID)
+            }
+        }
+        if len(recipients) == 0 {
+            continue
+        }
+        if err := send(service, recipients); err != nil && firstErr == nil {
+            firstErr = err
+        }
+    }
+    return firstErr
+}
@@ -0,0 +1,195 @@
+package notification
+
+import (
+    "bytes"
+    "context"
+    "crypto/rand"
+    "crypto/tls"
+    "encoding/hex"
+    "fmt"
+    "mime"
+    "mime/multipart"
+    "mime/quotedprintable"
+    "net"
+    "net/mail"
+    "net/smtp"
+    "net/textproto"
+    "strconv"
+    "time"
+)
+
+// TLS modes of an SMTP connection.
+const (
+    // SMTPTLSNone sends in plain text; only for local mail sinks.
+    SMTPTLSNone = "none"
+    // SMTPTLSStartTLS upgrades the connection with STARTTLS, usually on
+    // port 587.
+    SMTPTLSStartTLS = "starttls"
+    // SMTPTLSImplicit connects over TLS, usually on port 465.
+    SMTPTLSImplicit = "tls"
+)
+
+type SMTPConfig struct {
+    Host string
+    Port int
+    // Username and Password authenticate with PLAIN auth; no
+    // authentication is done without a username.
+    Username string
+    Password string
+    // From is the sender address, optionally with a name.
+    From string
+    // TLS is SMTPTLSNone, SMTPTLSStartTLS or SMTPTLSImplicit.
+    TLS string
+}
+
+// Mailer sends rendered emails.
+type Mailer interface {
+    Send(ctx context.Context, email *Email) error
+}
+
+// SMTPMailer sends each email over its own SMTP connection.
+type SMTPMailer struct {
+    config SMTPConfig
+    from   *mail.Address
+    // tlsConfig is used for STARTTLS and implicit TLS.
+    tlsConfig *tls.Config
+}
+
+func NewSMTPMailer(config SMTPConfig) (*SMTPMailer, error) {
+    from, err := mail.ParseAddress(config.From)
+    if err != nil {
+        return nil, fmt.Errorf("invalid sender address %q: %w", config.From, err)
+    }
+    switch config.TLS {
+    case SMTPTLSNone, SMTPTLSStartTLS, SMTPTLSImplicit:
+    default:
+        return nil, fmt.Errorf("invalid SMTP TLS mode %q", config.TLS)
+    }
+    return &SMTPMailer{
+        config:    config,
+        from:      from,
+        tlsConfig: &tls.Config{ServerName: config.Host},
+    }, nil
+}
+
+// Send delivers email, giving up when ctx is done.
+func (m *SMTPMailer) Send(ctx context.Context, email *Email) error {
+    to, err := mail.ParseAddress(email.To)
+    if err != nil {
+        return fmt.Errorf("invalid recipient address %q: %w", email.To, err)
+    }
+    message, err := m.message(to, email)
+    if err != nil {
+        return err
+    }
+
+    conn, err := m.dial(ctx)
+    if err != nil {
+        return fmt.Errorf("connect to SMTP server: %w", err)
+    }
+    defer conn.Close()
+    stop := context.AfterFunc(ctx, func() {
+        conn.SetDeadline(time.Now())
+    })
+    defer stop()
+
+    if err := m.deliver(conn, to.Address, message); err != nil {
+        if ctx.Err() != nil {
+            return ctx.Err()
+        }
+        return err
+    }
+    return nil
+}
+
+func (m *SMTPMailer) dial(ctx context.Context) (net.Conn, error) {
+    addr := net.JoinHostPort(m.config.Host, strconv.Itoa(m.config.Port))
+    if m.config.TLS == SMTPTLSImplicit {
+        dialer := &tls.Dialer{Config: m.tlsConfig}
+        return dialer.DialContext(ctx, "tcp", addr)
+    }
+    var dialer net.Dialer
+    return dialer.DialContext(ctx, "tcp", addr)
+}
+
+func (m *SMTPMailer) deliver(conn net.Conn, to string, message []byte) error {
+    client, err := smtp.NewClient(conn, m.config.Host)
+    if err != nil {
+        return fmt.Errorf("SMTP greeting: %w", err)
+    }
+    defer client.Close()
+
+    if m.config.TLS == SMTPTLSStartTLS {
+        if err := client.StartTLS(m.tlsConfig); err != nil {
+            return fmt.Errorf("SMTP STARTTLS: %w", err)
+        }
+    }
+    if m.config.Username != "" {
+        if err := client.Auth(smtp.PlainAuth("", m.config.Username, m.config.Password, m.config.Host)); err != nil {
+            return fmt.Errorf("SMTP auth: %w", err)
+        }
+    }
+    if err := client.Mail(m.from.Address); err != nil {
+        return fmt.Errorf("SMTP MAIL FROM: %w", err)
+    }
+    if err := client.Rcpt(to); err != nil {
+        return fmt.Errorf("SMTP RCPT TO: %w", err)
+    }
+    w, err := client.Data()
+    if err != nil {
+        return fmt.Errorf("SMTP DATA: %w", err)
+    }
+    if _, err := w.Write(message); err != nil {
+        return fmt.Errorf("SMTP DATA: %w", err)
+    }
+    if err := w.Close(); err != nil {
+        return fmt.Errorf("SMTP DATA: %w", err)
+    }
+    return client.Quit()
+}
+
+// message builds a multipart/alternative message with the plain-text and
+// the HTML version of email.
+func (m *SMTPMailer) message(to *mail.Address, email *Email) ([]byte, error) {
+    var body bytes.Buffer
+    parts := multipart.NewWriter(&body)
+    for _, part := range []struct{ contentType, content string }{
+        {"text/plain; charset=utf-8", email.Text},
+        {"text/html; charset=utf-8", email.HTML},
+    } {
+        w, err := parts.CreatePart(textproto.MIMEHeader{
+            "Content-Type":              {part.contentType},
+            "Content-Transfer-Encoding": {"quoted-printable"},
+        })
+        if err != nil {
+            return nil, err
+        }
+        qp := quotedprintable.NewWriter(w)
+        if _, err := qp.Write([]byte(part.content)); err != nil {
+            return nil, err
+        }
+        if err := qp.Close(); err != nil {
+            return nil, err
+        }
+    }
+    if err := parts.Close(); err != nil {
+        return nil, err
+    }
+
+    var message bytes.Buffer
+    fmt.Fprintf(&message, "From: %s\r\n", m.from.String())
+    fmt.Fprintf(&message, "To: %s\r\n", to.String())
+    fmt.Fprintf(&message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+    fmt.Fprintf(&message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+    fmt.Fprintf(&message, "Message-ID: <%s@%s>\r\n", messageID(), m.config.Host)
+    fmt.Fprintf(&message, "MIME-Version: 1.0\r\n")
+    fmt.Fprintf(&message, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", parts.Boundary())
+    message.Write(body.Bytes())
+    return message.Bytes(), nil
+}
+
+func messageID() string {
+    id := make([]byte, 16)
+    rand.Read(id)
+    return hex.EncodeToString(id)
+}
@@ -0,0 +1,33 @@
+package notification
+
+import (
+    "context"
+    "encoding/json"
+    "time"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/repository"
+)
+
+// WebhookNotificationAdapter queues every notification for delivery to the
+// user's webhooks. The payload is the notification as JSON, with ID 0,
+// and X-Webhook-Event is its type.
+type WebhookNotificationAdapter struct {
+    messageNotifier
+    deliveries repository.WebhookDeliveryRepository
+}
+
+func NewWebhookNotificationAdapter(deliveries repository.WebhookDeliveryRepository) notification.NotificationService {
+    a := &WebhookNotificationAdapter{deliveries: deliveries}
+    a.deliver = a.queue
+    return a
+}
+
+func (a *WebhookNotificationAdapter) queue(ctx context.Context, n *notification.Notification) error {
+    n.CreatedAt = time.Now()
+    payload, err := json.Marshal(n)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to encode webhook payload", err)
+    }
+    return a.deliveries.CreateForUser(ctx, n.UserID, string(n.Type), payload)
+}
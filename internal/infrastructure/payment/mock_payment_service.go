@@ -4,6 +4,7 @@ import (
     "context"
     "log"
     "os"
+    "auction-system/internal/domain/entity"
     "auction-system/internal/domain/payment"
 )
 
@@ -17,12 +18,12 @@ func NewMockPaymentAdapter() payment.PaymentService {
     }
 }
 
-func (a *MockPaymentAdapter) ProcessPayment(ctx context.Context, userID int64, amount float64) error {
-    a.logger.Printf("Processing payment: UserID=%d, Amount=%.2f", userID, amount)
+func (a *MockPaymentAdapter) ProcessPayment(ctx context.Context, key string, userID int64, amount entity.Money) error {
+    a.logger.Printf("Processing payment: Key=%s, UserID=%d, Amount=%s", key, userID, amount)
     return nil
 }
 
-func (a *MockPaymentAdapter) RefundPayment(ctx context.Context, userID int64, amount float64) error {
-    a.logger.Printf("Refunding payment: UserID=%d, Amount=%.2f", userID, amount)
+func (a *MockPaymentAdapter) RefundPayment(ctx context.Context, key string, userID int64, amount entity.Money) error {
+    a.logger.Printf("Refunding payment: Key=%s, UserID=%d, Amount=%s", key, userID, amount)
     return nil
 }
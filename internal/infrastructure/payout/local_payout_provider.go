@@ -0,0 +1,27 @@
+package payout
+
+import (
+    "context"
+    "fmt"
+    "log"
+    "os"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/payout"
+)
+
+// LocalPayoutProvider pays out nothing: it logs each payout and accepts it.
+type LocalPayoutProvider struct {
+    logger *log.Logger
+}
+
+func NewLocalPayoutProvider() payout.PayoutProvider {
+    return &LocalPayoutProvider{
+        logger: log.New(os.Stdout, "[PAYOUT] ", log.LstdFlags),
+    }
+}
+
+func (p *LocalPayoutProvider) Payout(ctx context.Context, withdrawal *entity.Withdrawal) (string, error) {
+    p.logger.Printf("Paying out withdrawal: ID=%d, UserID=%d, Amount=%s %s, Destination=%s",
+        withdrawal.ID, withdrawal.UserID, withdrawal.Amount, withdrawal.Amount.Currency, withdrawal.Destination)
+    return fmt.Sprintf("LOCAL-%d", withdrawal.ID), nil
+}
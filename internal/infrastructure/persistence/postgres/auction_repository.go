@@ -7,6 +7,7 @@ import (
     "auction-system/internal/domain/errors"
     "fmt"
     "strings"
+    "time"
 )
 
 type AuctionRepository struct {
@@ -17,15 +18,83 @@ func NewAuctionRepository(db *sql.DB) *AuctionRepository {
     return &AuctionRepository{db: db}
 }
 
+const auctionColumns = `id, lot_id, type, start_price, min_step, current_price,
+               start_time, end_time, status, winner_id, winner_bid_id,
+               extension_window_seconds, extension_seconds, max_extension_seconds,
+               extended_seconds, reserve_price, buy_now_price, buy_now_disabled,
+               price_decrement, decrement_interval_seconds, floor_price,
+               quantity, pricing, currency, created_at, updated_at`
+
+type rowScanner interface {
+    Scan(dest ...interface{}) error
+}
+
+// scanAuction reads a row selected with auctionColumns.
+func scanAuction(row rowScanner) (*entity.Auction, error) {
+    auction := &entity.Auction{}
+    var extensionWindow, extension, maxExtension, extended, decrementInterval int64
+    err := row.Scan(
+        &auction.ID,
+        &auction.LotID,
+        &auction.Type,
+        &auction.StartPrice,
+        &auction.MinStep,
+        &auction.CurrentPrice,
+        &auction.StartTime,
+        &auction.EndTime,
+        &auction.Status,
+        &auction.WinnerID,
+        &auction.WinnerBidID,
+        &extensionWindow,
+        &extension,
+        &maxExtension,
+        &extended,
+        &auction.ReservePrice,
+        &auction.BuyNowPrice,
+        &auction.BuyNowDisabled,
+        &auction.PriceDecrement,
+        &decrementInterval,
+        &auction.FloorPrice,
+        &auction.Quantity,
+        &auction.Pricing,
+        &auction.Currency,
+        &auction.CreatedAt,
+        &auction.UpdatedAt,
+    )
+    if err != nil {
+        return nil, err
+    }
+
+    auction.ExtensionWindow = time.Duration(extensionWindow) * time.Second
+    auction.ExtensionDuration = time.Duration(extension) * time.Second
+    auction.MaxExtension = time.Duration(maxExtension) * time.Second
+    auction.TotalExtension = time.Duration(extended) * time.Second
+    auction.DecrementInterval = time.Duration(decrementInterval) * time.Second
+    auction.SetCurrency(auction.Currency)
+    return auction, nil
+}
+
+// nullMoney writes NULL for optional prices that are not set.
+func nullMoney(m entity.Money, valid bool) interface{} {
+    if !valid {
+        return nil
+    }
+    return m
+}
+
 func (r *AuctionRepository) Create(ctx context.Context, auction *entity.Auction) error {
     query := `
         INSERT INTO auctions (
             lot_id, start_price, min_step, current_price,
-            start_time, end_time, status
-        ) VALUES ($1, $2, $3, $4, $5, $6, $7)
+            start_time, end_time, status, extension_window_seconds,
+            extension_seconds, max_extension_seconds, reserve_price,
+            buy_now_price, type, price_decrement, decrement_interval_seconds,
+            floor_price, quantity, pricing, currency
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
+            $17, $18, $19)
         RETURNING id, created_at, updated_at`
 
-    err := r.db.QueryRowContext(
+    err := conn(ctx, r.db).QueryRowContext(
         ctx,
         query,
         auction.LotID,
@@ -35,6 +104,18 @@ func (r *AuctionRepository) Create(ctx context.Context, auction *entity.Auction)
         auction.StartTime,
         auction.EndTime,
         auction.Status,
+        int64(auction.ExtensionWindow / time.Second),
+        int64(auction.ExtensionDuration / time.Second),
+        int64(auction.MaxExtension / time.Second),
+        nullMoney(auction.ReservePrice, auction.HasReserve()),
+        nullMoney(auction.BuyNowPrice, auction.BuyNowPrice.IsPositive()),
+        auction.Type,
+        nullMoney(auction.PriceDecrement, auction.IsDutch()),
+        int64(auction.DecrementInterval / time.Second),
+        nullMoney(auction.FloorPrice, auction.IsDutch()),
+        auction.Quantity,
+        auction.Pricing,
+        auction.Currency,
     ).Scan(&auction.ID, &auction.CreatedAt, &auction.UpdatedAt)
 
     if err != nil {
@@ -46,27 +127,11 @@ func (r *AuctionRepository) Create(ctx context.Context, auction *entity.Auction)
 
 func (r *AuctionRepository) GetByID(ctx context.Context, id int64) (*entity.Auction, error) {
     query := `
-        SELECT id, lot_id, start_price, min_step, current_price,
-               start_time, end_time, status, winner_id, winner_bid_id,
-               created_at, updated_at
+        SELECT ` + auctionColumns + `
         FROM auctions
         WHERE id = $1`
 
-    auction := &entity.Auction{}
-    err := r.db.QueryRowContext(ctx, query, id).Scan(
-        &auction.ID,
-        &auction.LotID,
-        &auction.StartPrice,
-        &auction.MinStep,
-        &auction.CurrentPrice,
-        &auction.StartTime,
-        &auction.EndTime,
-        &auction.Status,
-        &auction.WinnerID,
-        &auction.WinnerBidID,
-        &auction.CreatedAt,
-        &auction.UpdatedAt,
-    )
+    auction, err := scanAuction(conn(ctx, r.db).QueryRowContext(ctx, query, id))
 
     if err == sql.ErrNoRows {
         return nil, errors.New(errors.ErrorTypeNotFound, "auction not found", nil)
@@ -78,22 +143,43 @@ func (r *AuctionRepository) GetByID(ctx context.Context, id int64) (*entity.Auct
     return auction, nil
 }
 
+// GetByIDForUpdate locks the auction row until the surrounding transaction
+// finishes, so concurrent bids on the same auction are serialized.
+func (r *AuctionRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entity.Auction, error) {
+    query := `
+        SELECT ` + auctionColumns + `
+        FROM auctions
+        WHERE id = $1
+        FOR UPDATE`
+
+    auction, err := scanAuction(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+
+    if err == sql.ErrNoRows {
+        return nil, errors.New(errors.ErrorTypeNotFound, "auction not found", nil)
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to lock auction", err)
+    }
+
+    return auction, nil
+}
+
 func (r *AuctionRepository) Update(ctx context.Context, id int64, auction *entity.Auction) (*entity.Auction, error) {
     var queryParts []string
     var args []interface{}
     argPosition := 1
 
-    if auction.StartPrice > 0 {
+    if auction.StartPrice.IsPositive() {
         queryParts = append(queryParts, fmt.Sprintf("start_price = $%d", argPosition))
         args = append(args, auction.StartPrice)
         argPosition++
     }
-    if auction.MinStep > 0 {
+    if auction.MinStep.IsPositive() {
         queryParts = append(queryParts, fmt.Sprintf("min_step = $%d", argPosition))
         args = append(args, auction.MinStep)
         argPosition++
     }
-    if auction.CurrentPrice > 0 {
+    if auction.CurrentPrice.IsPositive() {
         queryParts = append(queryParts, fmt.Sprintf("current_price = $%d", argPosition))
         args = append(args, auction.CurrentPrice)
         argPosition++
@@ -123,6 +209,24 @@ func (r *AuctionRepository) Update(ctx context.Context, id int64, auction *entit
         args = append(args, auction.WinnerBidID)
         argPosition++
     }
+    if auction.ReservePrice.IsPositive() {
+        queryParts = append(queryParts, fmt.Sprintf("reserve_price = $%d", argPosition))
+        args = append(args, auction.ReservePrice)
+        argPosition++
+    }
+    if auction.BuyNowPrice.IsPositive() {
+        queryParts = append(queryParts, fmt.Sprintf("buy_now_price = $%d", argPosition))
+        args = append(args, auction.BuyNowPrice)
+        argPosition++
+    }
+    if auction.BuyNowDisabled {
+        queryParts = append(queryParts, "buy_now_disabled = TRUE")
+    }
+    if auction.TotalExtension > 0 {
+        queryParts = append(queryParts, fmt.Sprintf("extended_seconds = $%d", argPosition))
+        args = append(args, int64(auction.TotalExtension / time.Second))
+        argPosition++
+    }
 
     queryParts = append(queryParts, "updated_at = CURRENT_TIMESTAMP")
 
@@ -137,7 +241,7 @@ func (r *AuctionRepository) Update(ctx context.Context, id int64, auction *entit
         argPosition,
     )
 
-    err := r.db.QueryRowContext(
+    err := conn(ctx, r.db).QueryRowContext(
         ctx,
         query,
         args...,
@@ -165,15 +269,13 @@ func (r *AuctionRepository) List(ctx context.Context, offset, limit int, status
 
     countQuery := `SELECT COUNT(*) FROM auctions ` + whereClause
     var total int64
-    err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total)
+    err := conn(ctx, r.db).QueryRowContext(ctx, countQuery, args...).Scan(&total)
     if err != nil {
         return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count auctions", err)
     }
 
     query := `
-        SELECT id, lot_id, start_price, min_step, current_price,
-               start_time, end_time, status, winner_id, winner_bid_id,
-               created_at, updated_at
+        SELECT ` + auctionColumns + `
         FROM auctions
         ` + whereClause + `
         ORDER BY created_at DESC
@@ -181,7 +283,7 @@ func (r *AuctionRepository) List(ctx context.Context, offset, limit int, status
         OFFSET $` + fmt.Sprintf("%d", len(args)+2)
 
     args = append(args, limit, offset)
-    rows, err := r.db.QueryContext(ctx, query, args...)
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
     if err != nil {
         return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to list auctions", err)
     }
@@ -189,21 +291,7 @@ func (r *AuctionRepository) List(ctx context.Context, offset, limit int, status
 
     var auctions []*entity.Auction
     for rows.Next() {
-        auction := &entity.Auction{}
-        err := rows.Scan(
-            &auction.ID,
-            &auction.LotID,
-            &auction.StartPrice,
-            &auction.MinStep,
-            &auction.CurrentPrice,
-            &auction.StartTime,
-            &auction.EndTime,
-            &auction.Status,
-            &auction.WinnerID,
-            &auction.WinnerBidID,
-            &auction.CreatedAt,
-            &auction.UpdatedAt,
-        )
+        auction, err := scanAuction(rows)
         if err != nil {
             return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to scan auction", err)
         }
@@ -215,27 +303,11 @@ func (r *AuctionRepository) List(ctx context.Context, offset, limit int, status
 
 func (r *AuctionRepository) GetByLotID(ctx context.Context, lotID int64) (*entity.Auction, error) {
     query := `
-        SELECT id, lot_id, start_price, min_step, current_price,
-               start_time, end_time, status, winner_id, winner_bid_id,
-               created_at, updated_at
+        SELECT ` + auctionColumns + `
         FROM auctions
         WHERE lot_id = $1`
 
-    auction := &entity.Auction{}
-    err := r.db.QueryRowContext(ctx, query, lotID).Scan(
-        &auction.ID,
-        &auction.LotID,
-        &auction.StartPrice,
-        &auction.MinStep,
-        &auction.CurrentPrice,
-        &auction.StartTime,
-        &auction.EndTime,
-        &auction.Status,
-        &auction.WinnerID,
-        &auction.WinnerBidID,
-        &auction.CreatedAt,
-        &auction.UpdatedAt,
-    )
+    auction, err := scanAuction(conn(ctx, r.db).QueryRowContext(ctx, query, lotID))
 
     if err == sql.ErrNoRows {
         return nil, nil
@@ -254,7 +326,7 @@ func (r *AuctionRepository) UpdateStatus(ctx context.Context, id int64, status e
             updated_at = CURRENT_TIMESTAMP
         WHERE id = $2`
 
-    result, err := r.db.ExecContext(ctx, query, status, id)
+    result, err := conn(ctx, r.db).ExecContext(ctx, query, status, id)
     if err != nil {
         return errors.New(errors.ErrorTypeInternal, "failed to update auction status", err)
     }
@@ -273,9 +345,7 @@ func (r *AuctionRepository) UpdateStatus(ctx context.Context, id int64, status e
 
 func (r *AuctionRepository) GetActiveAuctions(ctx context.Context) ([]*entity.Auction, error) {
     query := `
-        SELECT id, lot_id, start_price, min_step, current_price,
-               start_time, end_time, status, winner_id, winner_bid_id,
-               created_at, updated_at
+        SELECT ` + auctionColumns + `
         FROM auctions
         WHERE status = $1`
 
@@ -284,20 +354,25 @@ func (r *AuctionRepository) GetActiveAuctions(ctx context.Context) ([]*entity.Au
 
 func (r *AuctionRepository) GetEndedAuctions(ctx context.Context) ([]*entity.Auction, error) {
     query := `
-        SELECT id, lot_id, start_price, min_step, current_price,
-               start_time, end_time, status, winner_id, winner_bid_id,
-               created_at, updated_at
+        SELECT ` + auctionColumns + `
         FROM auctions
         WHERE status = $1 AND end_time <= CURRENT_TIMESTAMP`
 
     return r.queryAuctions(ctx, query, entity.AuctionStatusActive)
 }
 
+func (r *AuctionRepository) GetActiveDutchAuctions(ctx context.Context) ([]*entity.Auction, error) {
+    query := `
+        SELECT ` + auctionColumns + `
+        FROM auctions
+        WHERE type = $1 AND status = $2`
+
+    return r.queryAuctions(ctx, query, entity.AuctionTypeDutch, entity.AuctionStatusActive)
+}
+
 func (r *AuctionRepository) GetPendingAuctionsToStart(ctx context.Context) ([]*entity.Auction, error) {
     query := `
-        SELECT id, lot_id, start_price, min_step, current_price,
-               start_time, end_time, status, winner_id, winner_bid_id,
-               created_at, updated_at
+        SELECT ` + auctionColumns + `
         FROM auctions
         WHERE status = $1 
         AND start_time <= CURRENT_TIMESTAMP`
@@ -306,7 +381,7 @@ func (r *AuctionRepository) GetPendingAuctionsToStart(ctx context.Context) ([]*e
 }
 
 func (r *AuctionRepository) queryAuctions(ctx context.Context, query string, args ...interface{}) ([]*entity.Auction, error) {
-    rows, err := r.db.QueryContext(ctx, query, args...)
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
     if err != nil {
         return nil, errors.New(errors.ErrorTypeInternal, "failed to query auctions", err)
     }
@@ -314,21 +389,7 @@ func (r *AuctionRepository) queryAuctions(ctx context.Context, query string, arg
 
     var auctions []*entity.Auction
     for rows.Next() {
-        auction := &entity.Auction{}
-        err := rows.Scan(
-            &auction.ID,
-            &auction.LotID,
-            &auction.StartPrice,
-            &auction.MinStep,
-            &auction.CurrentPrice,
-            &auction.StartTime,
-            &auction.EndTime,
-            &auction.Status,
-            &auction.WinnerID,
-            &auction.WinnerBidID,
-            &auction.CreatedAt,
-            &auction.UpdatedAt,
-        )
+        auction, err := scanAuction(rows)
         if err != nil {
             return nil, errors.New(errors.ErrorTypeInternal, "failed to scan auction", err)
         }
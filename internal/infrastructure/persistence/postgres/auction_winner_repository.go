@@ -0,0 +1,75 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type AuctionWinnerRepository struct {
+    db *sql.DB
+}
+
+func NewAuctionWinnerRepository(db *sql.DB) *AuctionWinnerRepository {
+    return &AuctionWinnerRepository{db: db}
+}
+
+func (r *AuctionWinnerRepository) Create(ctx context.Context, winner *entity.AuctionWinner) error {
+    query := `
+        INSERT INTO auction_winners (auction_id, user_id, bid_id, quantity, unit_price, currency)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING id, created_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        winner.AuctionID,
+        winner.UserID,
+        winner.BidID,
+        winner.Quantity,
+        winner.UnitPrice,
+        winner.UnitPrice.Currency,
+    ).Scan(&winner.ID, &winner.CreatedAt)
+
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to create auction winner", err)
+    }
+
+    return nil
+}
+
+func (r *AuctionWinnerRepository) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.AuctionWinner, error) {
+    query := `
+        SELECT id, auction_id, user_id, bid_id, quantity, unit_price, currency, created_at
+        FROM auction_winners
+        WHERE auction_id = $1
+        ORDER BY unit_price DESC, id ASC`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, auctionID)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get auction winners", err)
+    }
+    defer rows.Close()
+
+    var winners []*entity.AuctionWinner
+    for rows.Next() {
+        winner := &entity.AuctionWinner{}
+        err := rows.Scan(
+            &winner.ID,
+            &winner.AuctionID,
+            &winner.UserID,
+            &winner.BidID,
+            &winner.Quantity,
+            &winner.UnitPrice,
+            &winner.UnitPrice.Currency,
+            &winner.CreatedAt,
+        )
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan auction winner", err)
+        }
+        winners = append(winners, winner)
+    }
+
+    return winners, nil
+}
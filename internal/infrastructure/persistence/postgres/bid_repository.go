@@ -17,16 +17,19 @@ func NewBidRepository(db *sql.DB) *BidRepository {
 
 func (r *BidRepository) Create(ctx context.Context, bid *entity.Bid) error {
     query := `
-        INSERT INTO bids (auction_id, user_id, amount)
-        VALUES ($1, $2, $3)
+        INSERT INTO bids (auction_id, user_id, amount, currency, quantity, is_auto)
+        VALUES ($1, $2, $3, $4, $5, $6)
         RETURNING id, created_at, updated_at`
 
-    err := r.db.QueryRowContext(
+    err := conn(ctx, r.db).QueryRowContext(
         ctx,
         query,
         bid.AuctionID,
         bid.UserID,
         bid.Amount,
+        bid.Amount.Currency,
+        bid.Quantity,
+        bid.IsAuto,
     ).Scan(&bid.ID, &bid.CreatedAt, &bid.UpdatedAt)
 
     if err != nil {
@@ -38,16 +41,19 @@ func (r *BidRepository) Create(ctx context.Context, bid *entity.Bid) error {
 
 func (r *BidRepository) GetByID(ctx context.Context, id int64) (*entity.Bid, error) {
     query := `
-        SELECT id, auction_id, user_id, amount, created_at, updated_at
+        SELECT id, auction_id, user_id, amount, currency, quantity, is_auto, created_at, updated_at
         FROM bids
         WHERE id = $1`
 
     bid := &entity.Bid{}
-    err := r.db.QueryRowContext(ctx, query, id).Scan(
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
         &bid.ID,
         &bid.AuctionID,
         &bid.UserID,
         &bid.Amount,
+        &bid.Amount.Currency,
+        &bid.Quantity,
+        &bid.IsAuto,
         &bid.CreatedAt,
         &bid.UpdatedAt,
     )
@@ -62,14 +68,71 @@ func (r *BidRepository) GetByID(ctx context.Context, id int64) (*entity.Bid, err
     return bid, nil
 }
 
+// GetByAuctionAndUser returns nil without an error when the user has not
+// bid on the auction.
+func (r *BidRepository) GetByAuctionAndUser(ctx context.Context, auctionID, userID int64) (*entity.Bid, error) {
+    query := `
+        SELECT id, auction_id, user_id, amount, currency, quantity, is_auto, created_at, updated_at
+        FROM bids
+        WHERE auction_id = $1 AND user_id = $2
+        ORDER BY amount DESC
+        LIMIT 1`
+
+    bid := &entity.Bid{}
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, auctionID, userID).Scan(
+        &bid.ID,
+        &bid.AuctionID,
+        &bid.UserID,
+        &bid.Amount,
+        &bid.Amount.Currency,
+        &bid.Quantity,
+        &bid.IsAuto,
+        &bid.CreatedAt,
+        &bid.UpdatedAt,
+    )
+
+    if err == sql.ErrNoRows {
+        return nil, nil
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get bid", err)
+    }
+
+    return bid, nil
+}
+
+func (r *BidRepository) UpdateAmount(ctx context.Context, id int64, amount entity.Money) error {
+    query := `
+        UPDATE bids
+        SET amount = $1,
+            updated_at = CURRENT_TIMESTAMP
+        WHERE id = $2`
+
+    result, err := conn(ctx, r.db).ExecContext(ctx, query, amount, id)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to update bid", err)
+    }
+
+    rows, err := result.RowsAffected()
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to get rows affected", err)
+    }
+
+    if rows == 0 {
+        return errors.New(errors.ErrorTypeNotFound, "bid not found", nil)
+    }
+
+    return nil
+}
+
 func (r *BidRepository) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.Bid, error) {
     query := `
-        SELECT id, auction_id, user_id, amount, created_at, updated_at
+        SELECT id, auction_id, user_id, amount, currency, quantity, is_auto, created_at, updated_at
         FROM bids
         WHERE auction_id = $1
-        ORDER BY amount DESC`
+        ORDER BY amount DESC, updated_at ASC, id ASC`
 
-    rows, err := r.db.QueryContext(ctx, query, auctionID)
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, auctionID)
     if err != nil {
         return nil, errors.New(errors.ErrorTypeInternal, "failed to get bids", err)
     }
@@ -83,6 +146,9 @@ func (r *BidRepository) GetByAuctionID(ctx context.Context, auctionID int64) ([]
             &bid.AuctionID,
             &bid.UserID,
             &bid.Amount,
+            &bid.Amount.Currency,
+            &bid.Quantity,
+            &bid.IsAuto,
             &bid.CreatedAt,
             &bid.UpdatedAt,
         )
@@ -95,15 +161,46 @@ func (r *BidRepository) GetByAuctionID(ctx context.Context, auctionID int64) ([]
     return bids, nil
 }
 
+func (r *BidRepository) GetHighestByAuctionID(ctx context.Context, auctionID int64) (*entity.Bid, error) {
+    query := `
+        SELECT id, auction_id, user_id, amount, currency, quantity, is_auto, created_at, updated_at
+        FROM bids
+        WHERE auction_id = $1
+        ORDER BY amount DESC, updated_at ASC, id ASC
+        LIMIT 1`
+
+    bid := &entity.Bid{}
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, auctionID).Scan(
+        &bid.ID,
+        &bid.AuctionID,
+        &bid.UserID,
+        &bid.Amount,
+        &bid.Amount.Currency,
+        &bid.Quantity,
+        &bid.IsAuto,
+        &bid.CreatedAt,
+        &bid.UpdatedAt,
+    )
+
+    if err == sql.ErrNoRows {
+        return nil, nil
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get highest bid", err)
+    }
+
+    return bid, nil
+}
+
 func (r *BidRepository) List(ctx context.Context, auctionID int64, offset, limit int) ([]*entity.Bid, int64, error) {
     query := `
-        SELECT id, auction_id, user_id, amount, created_at, updated_at
+        SELECT id, auction_id, user_id, amount, currency, quantity, is_auto, created_at, updated_at
         FROM bids
         WHERE auction_id = $1
-        ORDER BY amount DESC
+        ORDER BY amount DESC, updated_at ASC, id ASC
         LIMIT $2 OFFSET $3`
 
-    rows, err := r.db.QueryContext(ctx, query, auctionID, limit, offset)
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, auctionID, limit, offset)
     if err != nil {
         return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to list bids", err)
     }
@@ -117,6 +214,9 @@ func (r *BidRepository) List(ctx context.Context, auctionID int64, offset, limit
             &bid.AuctionID,
             &bid.UserID,
             &bid.Amount,
+            &bid.Amount.Currency,
+            &bid.Quantity,
+            &bid.IsAuto,
             &bid.CreatedAt,
             &bid.UpdatedAt,
         )
@@ -127,7 +227,7 @@ func (r *BidRepository) List(ctx context.Context, auctionID int64, offset, limit
     }
 
     var total int64
-    err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM bids WHERE auction_id = $1", auctionID).Scan(&total)
+    err = conn(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM bids WHERE auction_id = $1", auctionID).Scan(&total)
     if err != nil {
         return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count bids", err)
     }
@@ -141,7 +241,7 @@ func (r *BidRepository) GetUniqueParticipantsByAuctionID(ctx context.Context, au
         FROM bids 
         WHERE auction_id = $1`
 
-    rows, err := r.db.QueryContext(ctx, query, auctionID)
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, auctionID)
     if err != nil {
         return nil, errors.New(errors.ErrorTypeInternal, "failed to get auction participants", err)
     }
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "time"
+    "github.com/lib/pq"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/notification"
+)
+
+type DigestRepository struct {
+    db *sql.DB
+}
+
+func NewDigestRepository(db *sql.DB) *DigestRepository {
+    return &DigestRepository{db: db}
+}
+
+func (r *DigestRepository) Claim(ctx context.Context, userID int64, frequency notification.DigestFrequency, periodStart time.Time) (bool, error) {
+    query := `
+        INSERT INTO digests (user_id, frequency, period_start)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (user_id, frequency, period_start) DO NOTHING`
+
+    result, err := conn(ctx, r.db).ExecContext(ctx, query, userID, frequency, periodStart.Format("2006-01-02"))
+    if err != nil {
+        return false, errors.New(errors.ErrorTypeInternal, "failed to record digest", err)
+    }
+
+    rows, err := result.RowsAffected()
+    if err != nil {
+        return false, errors.New(errors.ErrorTypeInternal, "failed to get affected rows", err)
+    }
+    return rows > 0, nil
+}
+
+func (r *DigestRepository) GetBidAuctions(ctx context.Context, userID int64) ([]*entity.Auction, error) {
+    query := `
+        SELECT ` + auctionColumns + `
+        FROM auctions
+        WHERE status = $1
+        AND type NOT IN ($2, $3)
+        AND quantity = 1
+        AND id IN (SELECT auction_id FROM bids WHERE user_id = $4)
+        ORDER BY end_time, id`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query,
+        entity.AuctionStatusActive,
+        entity.AuctionTypeSealedFirstPrice,
+        entity.AuctionTypeSealedSecondPrice,
+        userID,
+    )
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to query auctions", err)
+    }
+    defer rows.Close()
+
+    var auctions []*entity.Auction
+    for rows.Next() {
+        auction, err := scanAuction(rows)
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan auction", err)
+        }
+        auctions = append(auctions, auction)
+    }
+    return auctions, nil
+}
+
+func (r *DigestRepository) GetNewLots(ctx context.Context, categories []string, since time.Time) ([]*entity.Lot, error) {
+    query := `
+        SELECT id, title, description, start_price, currency, quantity, category, creator_id, created_at, updated_at
+        FROM lots
+        WHERE category = ANY($1) AND created_at > $2
+        ORDER BY created_at DESC, id DESC`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, pq.Array(categories), since)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to query lots", err)
+    }
+    defer rows.Close()
+
+    var lots []*entity.Lot
+    for rows.Next() {
+        lot := &entity.Lot{}
+        err := rows.Scan(
+            &lot.ID,
+            &lot.Title,
+            &lot.Description,
+            &lot.StartPrice,
+            &lot.Currency,
+            &lot.Quantity,
+            &lot.Category,
+            &lot.CreatorID,
+            &lot.CreatedAt,
+            &lot.UpdatedAt,
+        )
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan lot", err)
+        }
+        lot.StartPrice.Currency = lot.Currency
+        lots = append(lots, lot)
+    }
+    return lots, nil
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "encoding/json"
+    "log"
+    "time"
+    "github.com/lib/pq"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/event"
+    "auction-system/internal/domain/notification"
+)
+
+// localPublisher is where a bridge delivers the events it hears about.
+type localPublisher[E event.Event] interface {
+    Publish(ctx context.Context, e E) error
+}
+
+// EventBridge carries events between app instances through Postgres
+// LISTEN/NOTIFY. Publish numbers an event from a sequence and sends it with
+// pg_notify on the caller's transaction, so it goes out only if the change
+// it describes commits. Listen hands every event sent by any instance,
+// including this one, to the local publisher.
+type EventBridge[E event.Event] struct {
+    db       *sql.DB
+    dsn      string
+    channel  string
+    sequence string
+    newEvent func() E
+    local    localPublisher[E]
+}
+
+// NewAuctionEventBridge carries auction events on the auction_events
+// channel.
+func NewAuctionEventBridge(db *sql.DB, dsn string, local localPublisher[*entity.AuctionEvent]) *EventBridge[*entity.AuctionEvent] {
+    return &EventBridge[*entity.AuctionEvent]{
+        db:       db,
+        dsn:      dsn,
+        channel:  "auction_events",
+        sequence: "auction_event_id_seq",
+        newEvent: func() *entity.AuctionEvent { return &entity.AuctionEvent{} },
+        local:    local,
+    }
+}
+
+// NewNotificationBridge carries notifications on the user_notifications
+// channel.
+func NewNotificationBridge(db *sql.DB, dsn string, local localPublisher[*notification.Notification]) *EventBridge[*notification.Notification] {
+    return &EventBridge[*notification.Notification]{
+        db:       db,
+        dsn:      dsn,
+        channel:  "user_notifications",
+        sequence: "user_notification_id_seq",
+        newEvent: func() *notification.Notification { return &notification.Notification{} },
+        local:    local,
+    }
+}
+
+func (b *EventBridge[E]) Publish(ctx context.Context, e E) error {
+    if e.EventID() == 0 {
+        var id int64
+        err := conn(ctx, b.db).QueryRowContext(ctx, "SELECT nextval($1::regclass)", b.sequence).Scan(&id)
+        if err != nil {
+            return errors.New(errors.ErrorTypeInternal, "failed to number event", err)
+        }
+        e.SetEventID(id)
+    }
+
+    payload, err := json.Marshal(e)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to encode event", err)
+    }
+
+    if _, err := conn(ctx, b.db).ExecContext(ctx, "SELECT pg_notify($1, $2)", b.channel, string(payload)); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to publish event", err)
+    }
+    return nil
+}
+
+// Listen relays notifications to the local publisher until ctx is done. The
+// listener reconnects by itself; events sent while it is disconnected are
+// lost.
+func (b *EventBridge[E]) Listen(ctx context.Context) error {
+    listener := pq.NewListener(b.dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+        if err != nil {
+            log.Printf("Listener on %s: %v", b.channel, err)
+        }
+    })
+    defer listener.Close()
+
+    if err := listener.Listen(b.channel); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to listen on "+b.channel, err)
+    }
+
+    ping := time.NewTicker(90 * time.Second)
+    defer ping.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return nil
+        case <-ping.C:
+            go listener.Ping()
+        case n := <-listener.Notify:
+            // A nil notification means the connection was re-established.
+            if n == nil {
+                log.Printf("Listener on %s reconnected, events may have been missed", b.channel)
+                continue
+            }
+
+            e := b.newEvent()
+            if err := json.Unmarshal([]byte(n.Extra), e); err != nil {
+                log.Printf("Error decoding event from %s: %v", b.channel, err)
+                continue
+            }
+            if err := b.local.Publish(ctx, e); err != nil {
+                log.Printf("Error delivering event %d from %s: %v", e.EventID(), b.channel, err)
+            }
+        }
+    }
+}
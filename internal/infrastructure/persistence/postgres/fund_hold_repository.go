@@ -0,0 +1,107 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type FundHoldRepository struct {
+    db *sql.DB
+}
+
+func NewFundHoldRepository(db *sql.DB) *FundHoldRepository {
+    return &FundHoldRepository{db: db}
+}
+
+func (r *FundHoldRepository) Upsert(ctx context.Context, hold *entity.FundHold) error {
+    query := `
+        INSERT INTO fund_holds (user_id, auction_id, amount, currency)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (auction_id, user_id) DO UPDATE
+        SET amount = EXCLUDED.amount,
+            currency = EXCLUDED.currency,
+            updated_at = CURRENT_TIMESTAMP
+        RETURNING id, created_at, updated_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        hold.UserID,
+        hold.AuctionID,
+        hold.Amount,
+        hold.Amount.Currency,
+    ).Scan(&hold.ID, &hold.CreatedAt, &hold.UpdatedAt)
+
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to save fund hold", err)
+    }
+
+    return nil
+}
+
+func (r *FundHoldRepository) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.FundHold, error) {
+    query := `
+        SELECT id, user_id, auction_id, amount, currency, created_at, updated_at
+        FROM fund_holds
+        WHERE auction_id = $1`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, auctionID)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get fund holds", err)
+    }
+    defer rows.Close()
+
+    var holds []*entity.FundHold
+    for rows.Next() {
+        hold := &entity.FundHold{}
+        err := rows.Scan(
+            &hold.ID,
+            &hold.UserID,
+            &hold.AuctionID,
+            &hold.Amount,
+            &hold.Amount.Currency,
+            &hold.CreatedAt,
+            &hold.UpdatedAt,
+        )
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan fund hold", err)
+        }
+        holds = append(holds, hold)
+    }
+
+    return holds, nil
+}
+
+func (r *FundHoldRepository) GetTotalByUserID(ctx context.Context, userID int64, currency string) (entity.Money, error) {
+    query := `SELECT COALESCE(SUM(amount), 0) FROM fund_holds WHERE user_id = $1 AND currency = $2`
+
+    var total entity.Money
+    if err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, currency).Scan(&total); err != nil {
+        return entity.Money{}, errors.New(errors.ErrorTypeInternal, "failed to sum fund holds", err)
+    }
+    total.Currency = currency
+
+    return total, nil
+}
+
+func (r *FundHoldRepository) Delete(ctx context.Context, auctionID, userID int64) error {
+    query := `DELETE FROM fund_holds WHERE auction_id = $1 AND user_id = $2`
+
+    if _, err := conn(ctx, r.db).ExecContext(ctx, query, auctionID, userID); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to release fund hold", err)
+    }
+
+    return nil
+}
+
+func (r *FundHoldRepository) DeleteByAuctionID(ctx context.Context, auctionID int64) error {
+    query := `DELETE FROM fund_holds WHERE auction_id = $1`
+
+    if _, err := conn(ctx, r.db).ExecContext(ctx, query, auctionID); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to release fund holds", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "time"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type IdempotencyRepository struct {
+    db *sql.DB
+}
+
+func NewIdempotencyRepository(db *sql.DB) *IdempotencyRepository {
+    return &IdempotencyRepository{db: db}
+}
+
+func (r *IdempotencyRepository) Claim(ctx context.Context, record *entity.IdempotencyRecord, staleBefore time.Time) (*entity.IdempotencyRecord, error) {
+    // The conditional update takes over a stale record; a live one makes
+    // the statement return no row.
+    query := `
+        INSERT INTO idempotency_keys (user_id, key, method, request_hash)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (user_id, key, method) DO UPDATE
+        SET request_hash = EXCLUDED.request_hash, response = NULL, created_at = CURRENT_TIMESTAMP
+        WHERE idempotency_keys.created_at < $5
+        RETURNING created_at`
+
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, record.UserID, record.Key, record.Method, record.RequestHash, staleBefore).Scan(&record.CreatedAt)
+    if err == nil {
+        return nil, nil
+    }
+    if err != sql.ErrNoRows {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to claim idempotency key", err)
+    }
+
+    existing := &entity.IdempotencyRecord{}
+    err = conn(ctx, r.db).QueryRowContext(ctx, `
+        SELECT user_id, key, method, request_hash, response, created_at
+        FROM idempotency_keys
+        WHERE user_id = $1 AND key = $2 AND method = $3`,
+        record.UserID,
+        record.Key,
+        record.Method,
+    ).Scan(
+        &existing.UserID,
+        &existing.Key,
+        &existing.Method,
+        &existing.RequestHash,
+        &existing.Response,
+        &existing.CreatedAt,
+    )
+    if err == sql.ErrNoRows {
+        // The holder released the key in between; report it as busy
+        // rather than claiming it on a second round trip.
+        existing = &entity.IdempotencyRecord{UserID: record.UserID, Key: record.Key, Method: record.Method, RequestHash: record.RequestHash}
+        return existing, nil
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get idempotency key", err)
+    }
+
+    return existing, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, userID int64, key, method string, response []byte) error {
+    query := `UPDATE idempotency_keys SET response = $1 WHERE user_id = $2 AND key = $3 AND method = $4`
+    if _, err := conn(ctx, r.db).ExecContext(ctx, query, response, userID, key, method); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to save idempotent response", err)
+    }
+    return nil
+}
+
+func (r *IdempotencyRepository) Release(ctx context.Context, userID int64, key, method string) error {
+    query := `DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2 AND method = $3 AND response IS NULL`
+    if _, err := conn(ctx, r.db).ExecContext(ctx, query, userID, key, method); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to release idempotency key", err)
+    }
+    return nil
+}
+
+func (r *IdempotencyRepository) DeleteCreatedBefore(ctx context.Context, t time.Time) (int64, error) {
+    result, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM idempotency_keys WHERE created_at < $1`, t)
+    if err != nil {
+        return 0, errors.New(errors.ErrorTypeInternal, "failed to delete expired idempotency keys", err)
+    }
+    return result.RowsAffected()
+}
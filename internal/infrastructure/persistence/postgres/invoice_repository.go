@@ -0,0 +1,147 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type InvoiceRepository struct {
+    db *sql.DB
+}
+
+func NewInvoiceRepository(db *sql.DB) *InvoiceRepository {
+    return &InvoiceRepository{db: db}
+}
+
+const invoiceColumns = `id, number, auction_id, payment_id, lot_id, lot_title, buyer_id, buyer_name, buyer_email,
+        seller_id, seller_name, seller_email, total, currency, hammer, commission, buyer_premium, tax_rate, tax, issued_at`
+
+func scanInvoice(row rowScanner) (*entity.Invoice, error) {
+    invoice := &entity.Invoice{}
+    err := row.Scan(
+        &invoice.ID,
+        &invoice.Number,
+        &invoice.AuctionID,
+        &invoice.PaymentID,
+        &invoice.LotID,
+        &invoice.LotTitle,
+        &invoice.BuyerID,
+        &invoice.BuyerName,
+        &invoice.BuyerEmail,
+        &invoice.SellerID,
+        &invoice.SellerName,
+        &invoice.SellerEmail,
+        &invoice.Total,
+        &invoice.Total.Currency,
+        &invoice.Hammer,
+        &invoice.Commission,
+        &invoice.BuyerPremium,
+        &invoice.TaxRate,
+        &invoice.Tax,
+        &invoice.IssuedAt,
+    )
+    invoice.Hammer.Currency = invoice.Total.Currency
+    invoice.Commission.Currency = invoice.Total.Currency
+    invoice.BuyerPremium.Currency = invoice.Total.Currency
+    invoice.Tax.Currency = invoice.Total.Currency
+    return invoice, err
+}
+
+func (r *InvoiceRepository) Create(ctx context.Context, invoice *entity.Invoice) error {
+    var number int64
+    err := conn(ctx, r.db).QueryRowContext(ctx, `
+        UPDATE invoice_sequence
+        SET last_number = last_number + 1
+        RETURNING last_number`,
+    ).Scan(&number)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to assign invoice number", err)
+    }
+    invoice.Number = entity.InvoiceNumber(number)
+
+    query := `
+        INSERT INTO invoices (number, auction_id, payment_id, lot_id, lot_title, buyer_id, buyer_name, buyer_email,
+            seller_id, seller_name, seller_email, total, currency, hammer, commission, buyer_premium, tax_rate, tax)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+        RETURNING id, issued_at`
+
+    err = conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        invoice.Number,
+        invoice.AuctionID,
+        invoice.PaymentID,
+        invoice.LotID,
+        invoice.LotTitle,
+        invoice.BuyerID,
+        invoice.BuyerName,
+        invoice.BuyerEmail,
+        invoice.SellerID,
+        invoice.SellerName,
+        invoice.SellerEmail,
+        invoice.Total,
+        invoice.Total.Currency,
+        invoice.Hammer,
+        invoice.Commission,
+        invoice.BuyerPremium,
+        invoice.TaxRate,
+        invoice.Tax,
+    ).Scan(&invoice.ID, &invoice.IssuedAt)
+
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to create invoice", err)
+    }
+
+    return nil
+}
+
+func (r *InvoiceRepository) GetByID(ctx context.Context, id int64) (*entity.Invoice, error) {
+    query := `
+        SELECT ` + invoiceColumns + `
+        FROM invoices
+        WHERE id = $1`
+
+    invoice, err := scanInvoice(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+    if err == sql.ErrNoRows {
+        return nil, errors.New(errors.ErrorTypeNotFound, "invoice not found", nil)
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get invoice", err)
+    }
+
+    return invoice, nil
+}
+
+func (r *InvoiceRepository) GetByUserID(ctx context.Context, userID int64, offset, limit int) ([]*entity.Invoice, int64, error) {
+    query := `
+        SELECT ` + invoiceColumns + `
+        FROM invoices
+        WHERE buyer_id = $1 OR seller_id = $1
+        ORDER BY id DESC
+        LIMIT $2 OFFSET $3`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, limit, offset)
+    if err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to get invoices", err)
+    }
+    defer rows.Close()
+
+    var invoices []*entity.Invoice
+    for rows.Next() {
+        invoice, err := scanInvoice(rows)
+        if err != nil {
+            return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to scan invoice", err)
+        }
+        invoices = append(invoices, invoice)
+    }
+
+    var total int64
+    countQuery := `SELECT COUNT(*) FROM invoices WHERE buyer_id = $1 OR seller_id = $1`
+    if err := conn(ctx, r.db).QueryRowContext(ctx, countQuery, userID).Scan(&total); err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count invoices", err)
+    }
+
+    return invoices, total, nil
+}
@@ -0,0 +1,220 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type LedgerRepository struct {
+    db *sql.DB
+}
+
+func NewLedgerRepository(db *sql.DB) *LedgerRepository {
+    return &LedgerRepository{db: db}
+}
+
+func (r *LedgerRepository) GetUserAccount(ctx context.Context, userID int64, currency string) (*entity.Account, error) {
+    // The no-op update makes RETURNING yield the existing row on conflict.
+    query := `
+        INSERT INTO accounts (type, user_id, currency)
+        VALUES ('USER', $1, $2)
+        ON CONFLICT (user_id, currency) DO UPDATE SET user_id = EXCLUDED.user_id
+        RETURNING id, type, user_id, currency, created_at`
+
+    account := &entity.Account{}
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, currency).Scan(
+        &account.ID,
+        &account.Type,
+        &account.UserID,
+        &account.Currency,
+        &account.CreatedAt,
+    )
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get user account", err)
+    }
+
+    return account, nil
+}
+
+func (r *LedgerRepository) GetExternalAccount(ctx context.Context, currency string) (*entity.Account, error) {
+    query := `
+        INSERT INTO accounts (type, currency)
+        VALUES ('EXTERNAL', $1)
+        ON CONFLICT (currency) WHERE type = 'EXTERNAL' DO UPDATE SET currency = EXCLUDED.currency
+        RETURNING id, type, user_id, currency, created_at`
+
+    account := &entity.Account{}
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, currency).Scan(
+        &account.ID,
+        &account.Type,
+        &account.UserID,
+        &account.Currency,
+        &account.CreatedAt,
+    )
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get external account", err)
+    }
+
+    return account, nil
+}
+
+func (r *LedgerRepository) GetPlatformAccount(ctx context.Context, currency string) (*entity.Account, error) {
+    query := `
+        INSERT INTO accounts (type, currency)
+        VALUES ('PLATFORM', $1)
+        ON CONFLICT (currency) WHERE type = 'PLATFORM' DO UPDATE SET currency = EXCLUDED.currency
+        RETURNING id, type, user_id, currency, created_at`
+
+    account := &entity.Account{}
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, currency).Scan(
+        &account.ID,
+        &account.Type,
+        &account.UserID,
+        &account.Currency,
+        &account.CreatedAt,
+    )
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get platform account", err)
+    }
+
+    return account, nil
+}
+
+func (r *LedgerRepository) GetPayoutAccount(ctx context.Context, currency string) (*entity.Account, error) {
+    query := `
+        INSERT INTO accounts (type, currency)
+        VALUES ('PAYOUT', $1)
+        ON CONFLICT (currency) WHERE type = 'PAYOUT' DO UPDATE SET currency = EXCLUDED.currency
+        RETURNING id, type, user_id, currency, created_at`
+
+    account := &entity.Account{}
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, currency).Scan(
+        &account.ID,
+        &account.Type,
+        &account.UserID,
+        &account.Currency,
+        &account.CreatedAt,
+    )
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get payout account", err)
+    }
+
+    return account, nil
+}
+
+func (r *LedgerRepository) CreateTransaction(ctx context.Context, tx *entity.LedgerTransaction) error {
+    query := `
+        INSERT INTO ledger_transactions (kind, auction_id, description)
+        VALUES ($1, $2, $3)
+        RETURNING id, created_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        tx.Kind,
+        tx.AuctionID,
+        tx.Description,
+    ).Scan(&tx.ID, &tx.CreatedAt)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to create ledger transaction", err)
+    }
+
+    entryQuery := `
+        INSERT INTO ledger_entries (transaction_id, account_id, direction, amount)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, created_at`
+
+    for _, entry := range tx.Entries {
+        entry.TransactionID = tx.ID
+        err := conn(ctx, r.db).QueryRowContext(
+            ctx,
+            entryQuery,
+            entry.TransactionID,
+            entry.AccountID,
+            entry.Direction,
+            entry.Amount,
+        ).Scan(&entry.ID, &entry.CreatedAt)
+        if err != nil {
+            return errors.New(errors.ErrorTypeInternal, "failed to create ledger entry", err)
+        }
+    }
+
+    return nil
+}
+
+func (r *LedgerRepository) GetBalance(ctx context.Context, accountID int64) (entity.Money, error) {
+    query := `
+        SELECT COALESCE(SUM(CASE WHEN e.direction = 'CREDIT' THEN e.amount ELSE -e.amount END), 0), a.currency
+        FROM accounts a
+        LEFT JOIN ledger_entries e ON e.account_id = a.id
+        WHERE a.id = $1
+        GROUP BY a.currency`
+
+    var balance entity.Money
+    if err := conn(ctx, r.db).QueryRowContext(ctx, query, accountID).Scan(&balance, &balance.Currency); err != nil {
+        return entity.Money{}, errors.New(errors.ErrorTypeInternal, "failed to get account balance", err)
+    }
+
+    return balance, nil
+}
+
+func (r *LedgerRepository) ListStatement(ctx context.Context, accountID int64, offset, limit int) ([]*entity.StatementLine, int64, error) {
+    // The running balance is computed over the whole history before the
+    // page is cut, newest first.
+    query := `
+        SELECT transaction_id, kind, auction_id, description, amount, balance_after, currency, created_at
+        FROM (
+            SELECT t.id AS transaction_id,
+                   t.kind,
+                   t.auction_id,
+                   t.description,
+                   SUM(CASE WHEN e.direction = 'CREDIT' THEN e.amount ELSE -e.amount END) AS amount,
+                   SUM(SUM(CASE WHEN e.direction = 'CREDIT' THEN e.amount ELSE -e.amount END))
+                       OVER (ORDER BY t.id) AS balance_after,
+                   a.currency,
+                   t.created_at
+            FROM ledger_entries e
+            JOIN ledger_transactions t ON t.id = e.transaction_id
+            JOIN accounts a ON a.id = e.account_id
+            WHERE e.account_id = $1
+            GROUP BY t.id, a.currency
+        ) statement
+        ORDER BY transaction_id DESC
+        LIMIT $2 OFFSET $3`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, accountID, limit, offset)
+    if err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to list transactions", err)
+    }
+    defer rows.Close()
+
+    var lines []*entity.StatementLine
+    for rows.Next() {
+        line := &entity.StatementLine{}
+        err := rows.Scan(
+            &line.TransactionID,
+            &line.Kind,
+            &line.AuctionID,
+            &line.Description,
+            &line.Amount,
+            &line.BalanceAfter,
+            &line.BalanceAfter.Currency,
+            &line.CreatedAt,
+        )
+        if err != nil {
+            return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to scan transaction", err)
+        }
+        line.Amount.Currency = line.BalanceAfter.Currency
+        lines = append(lines, line)
+    }
+
+    var total int64
+    countQuery := `SELECT COUNT(DISTINCT transaction_id) FROM ledger_entries WHERE account_id = $1`
+    if err := conn(ctx, r.db).QueryRowContext(ctx, countQuery, accountID).Scan(&total); err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count transactions", err)
+    }
+
+    return lines, total, nil
+}
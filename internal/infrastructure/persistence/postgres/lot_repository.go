@@ -21,20 +21,23 @@ func NewLotRepository(db *sql.DB) *LotRepository {
 
 func (r *LotRepository) Create(ctx context.Context, lot *entity.Lot) error {
     query := `
-        INSERT INTO lots (title, description, start_price, creator_id, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6)
+        INSERT INTO lots (title, description, start_price, currency, quantity, category, creator_id, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
         RETURNING id, created_at, updated_at`
 
     now := time.Now()
     lot.CreatedAt = now
     lot.UpdatedAt = now
 
-    err := r.db.QueryRowContext(
+    err := conn(ctx, r.db).QueryRowContext(
         ctx,
         query,
         lot.Title,
         lot.Description,
         lot.StartPrice,
+        lot.Currency,
+        lot.Quantity,
+        lot.Category,
         lot.CreatorID,
         lot.CreatedAt,
         lot.UpdatedAt,
@@ -49,16 +52,19 @@ func (r *LotRepository) Create(ctx context.Context, lot *entity.Lot) error {
 
 func (r *LotRepository) GetByID(ctx context.Context, id int64) (*entity.Lot, error) {
     query := `
-        SELECT id, title, description, start_price, creator_id, created_at, updated_at
+        SELECT id, title, description, start_price, currency, quantity, category, creator_id, created_at, updated_at
         FROM lots
         WHERE id = $1`
 
     lot := &entity.Lot{}
-    err := r.db.QueryRowContext(ctx, query, id).Scan(
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
         &lot.ID,
         &lot.Title,
         &lot.Description,
         &lot.StartPrice,
+        &lot.Currency,
+        &lot.Quantity,
+        &lot.Category,
         &lot.CreatorID,
         &lot.CreatedAt,
         &lot.UpdatedAt,
@@ -71,24 +77,26 @@ func (r *LotRepository) GetByID(ctx context.Context, id int64) (*entity.Lot, err
         return nil, errors.New(errors.ErrorTypeInternal, "failed to get lot", err)
     }
 
+    lot.StartPrice.Currency = lot.Currency
     return lot, nil
 }
 
 func (r *LotRepository) Update(ctx context.Context, id int64, lot *entity.Lot) (*entity.Lot, error) {
     query := `
         UPDATE lots 
-        SET title = $1, description = $2, start_price = $3, updated_at = $4
-        WHERE id = $5
-        RETURNING id, title, description, start_price, creator_id, created_at, updated_at`
+        SET title = $1, description = $2, start_price = $3, category = $4, updated_at = $5
+        WHERE id = $6
+        RETURNING id, title, description, start_price, currency, quantity, category, creator_id, created_at, updated_at`
 
     now := time.Now()
     updatedLot := &entity.Lot{}
-    err := r.db.QueryRowContext(
+    err := conn(ctx, r.db).QueryRowContext(
         ctx,
         query,
         lot.Title,
         lot.Description,
         lot.StartPrice,
+        lot.Category,
         now,
         id,
     ).Scan(
@@ -96,6 +104,9 @@ func (r *LotRepository) Update(ctx context.Context, id int64, lot *entity.Lot) (
         &updatedLot.Title,
         &updatedLot.Description,
         &updatedLot.StartPrice,
+        &updatedLot.Currency,
+        &updatedLot.Quantity,
+        &updatedLot.Category,
         &updatedLot.CreatorID,
         &updatedLot.CreatedAt,
         &updatedLot.UpdatedAt,
@@ -108,12 +119,13 @@ func (r *LotRepository) Update(ctx context.Context, id int64, lot *entity.Lot) (
         return nil, errors.New(errors.ErrorTypeInternal, "failed to update lot", err)
     }
 
+    updatedLot.StartPrice.Currency = updatedLot.Currency
     return updatedLot, nil
 }
 
 func (r *LotRepository) Delete(ctx context.Context, id int64) error {
     query := `DELETE FROM lots WHERE id = $1`
-    result, err := r.db.ExecContext(ctx, query, id)
+    result, err := conn(ctx, r.db).ExecContext(ctx, query, id)
     if err != nil {
         return errors.New(errors.ErrorTypeInternal, "failed to delete lot", err)
     }
@@ -132,12 +144,12 @@ func (r *LotRepository) Delete(ctx context.Context, id int64) error {
 
 func (r *LotRepository) List(ctx context.Context, offset, limit int) ([]*entity.Lot, int64, error) {
     query := `
-        SELECT id, title, description, start_price, creator_id, created_at, updated_at
+        SELECT id, title, description, start_price, currency, quantity, category, creator_id, created_at, updated_at
         FROM lots
         ORDER BY created_at DESC
         LIMIT $1 OFFSET $2`
 
-    rows, err := r.db.QueryContext(ctx, query, limit, offset)
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, limit, offset)
     if err != nil {
         return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to list lots", err)
     }
@@ -151,6 +163,9 @@ func (r *LotRepository) List(ctx context.Context, offset, limit int) ([]*entity.
             &lot.Title,
             &lot.Description,
             &lot.StartPrice,
+            &lot.Currency,
+            &lot.Quantity,
+            &lot.Category,
             &lot.CreatorID,
             &lot.CreatedAt,
             &lot.UpdatedAt,
@@ -158,11 +173,12 @@ func (r *LotRepository) List(ctx context.Context, offset, limit int) ([]*entity.
         if err != nil {
             return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to scan lot", err)
         }
+        lot.StartPrice.Currency = lot.Currency
         lots = append(lots, lot)
     }
 
     var total int64
-    err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM lots").Scan(&total)
+    err = conn(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM lots").Scan(&total)
     if err != nil {
         return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count lots", err)
     }
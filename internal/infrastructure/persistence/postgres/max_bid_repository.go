@@ -0,0 +1,105 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type MaxBidRepository struct {
+    db *sql.DB
+}
+
+func NewMaxBidRepository(db *sql.DB) *MaxBidRepository {
+    return &MaxBidRepository{db: db}
+}
+
+func (r *MaxBidRepository) Upsert(ctx context.Context, maxBid *entity.MaxBid) error {
+    query := `
+        INSERT INTO max_bids (auction_id, user_id, max_amount, currency)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (auction_id, user_id) DO UPDATE
+        SET max_amount = EXCLUDED.max_amount,
+            currency = EXCLUDED.currency,
+            updated_at = CURRENT_TIMESTAMP
+        RETURNING id, created_at, updated_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        maxBid.AuctionID,
+        maxBid.UserID,
+        maxBid.MaxAmount,
+        maxBid.MaxAmount.Currency,
+    ).Scan(&maxBid.ID, &maxBid.CreatedAt, &maxBid.UpdatedAt)
+
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to save max bid", err)
+    }
+
+    return nil
+}
+
+func (r *MaxBidRepository) GetByAuctionAndUser(ctx context.Context, auctionID, userID int64) (*entity.MaxBid, error) {
+    query := `
+        SELECT id, auction_id, user_id, max_amount, currency, created_at, updated_at
+        FROM max_bids
+        WHERE auction_id = $1 AND user_id = $2`
+
+    maxBid := &entity.MaxBid{}
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, auctionID, userID).Scan(
+        &maxBid.ID,
+        &maxBid.AuctionID,
+        &maxBid.UserID,
+        &maxBid.MaxAmount,
+        &maxBid.MaxAmount.Currency,
+        &maxBid.CreatedAt,
+        &maxBid.UpdatedAt,
+    )
+
+    if err == sql.ErrNoRows {
+        return nil, nil
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get max bid", err)
+    }
+
+    return maxBid, nil
+}
+
+// GetByAuctionID returns max bids ordered by priority: highest ceiling first,
+// earliest ceiling first among equals.
+func (r *MaxBidRepository) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.MaxBid, error) {
+    query := `
+        SELECT id, auction_id, user_id, max_amount, currency, created_at, updated_at
+        FROM max_bids
+        WHERE auction_id = $1
+        ORDER BY max_amount DESC, updated_at ASC, id ASC`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, auctionID)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get max bids", err)
+    }
+    defer rows.Close()
+
+    var maxBids []*entity.MaxBid
+    for rows.Next() {
+        maxBid := &entity.MaxBid{}
+        err := rows.Scan(
+            &maxBid.ID,
+            &maxBid.AuctionID,
+            &maxBid.UserID,
+            &maxBid.MaxAmount,
+            &maxBid.MaxAmount.Currency,
+            &maxBid.CreatedAt,
+            &maxBid.UpdatedAt,
+        )
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan max bid", err)
+        }
+        maxBids = append(maxBids, maxBid)
+    }
+
+    return maxBids, nil
+}
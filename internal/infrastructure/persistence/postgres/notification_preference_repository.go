@@ -0,0 +1,134 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "encoding/json"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/notification"
+    "github.com/lib/pq"
+)
+
+type NotificationPreferenceRepository struct {
+    db *sql.DB
+}
+
+func NewNotificationPreferenceRepository(db *sql.DB) *NotificationPreferenceRepository {
+    return &NotificationPreferenceRepository{db: db}
+}
+
+const preferenceColumns = `user_id, channels, quiet_hours_start, quiet_hours_end, time_zone, digest,
+               followed_categories, updated_at`
+
+// scanPreferences reads a row selected with preferenceColumns.
+func scanPreferences(row rowScanner) (*notification.Preferences, error) {
+    preferences := &notification.Preferences{}
+    var channels []byte
+    var quietStart, quietEnd sql.NullInt32
+    err := row.Scan(
+        &preferences.UserID,
+        &channels,
+        &quietStart,
+        &quietEnd,
+        &preferences.TimeZone,
+        &preferences.Digest,
+        pq.Array(&preferences.FollowedCategories),
+        &preferences.UpdatedAt,
+    )
+    if err != nil {
+        return nil, err
+    }
+
+    if err := json.Unmarshal(channels, &preferences.Channels); err != nil {
+        return nil, err
+    }
+    if quietStart.Valid && quietEnd.Valid {
+        preferences.QuietHours = &notification.QuietHours{Start: int(quietStart.Int32), End: int(quietEnd.Int32)}
+    }
+    return preferences, nil
+}
+
+func (r *NotificationPreferenceRepository) GetByUserID(ctx context.Context, userID int64) (*notification.Preferences, error) {
+    query := `
+        SELECT ` + preferenceColumns + `
+        FROM notification_preferences
+        WHERE user_id = $1`
+
+    preferences, err := scanPreferences(conn(ctx, r.db).QueryRowContext(ctx, query, userID))
+    if err == sql.ErrNoRows {
+        return notification.DefaultPreferences(userID), nil
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get notification preferences", err)
+    }
+    return preferences, nil
+}
+
+func (r *NotificationPreferenceRepository) GetWithDigest(ctx context.Context) ([]*notification.Preferences, error) {
+    query := `
+        SELECT ` + preferenceColumns + `
+        FROM notification_preferences
+        WHERE digest <> $1
+        ORDER BY user_id`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, notification.DigestNone)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to query notification preferences", err)
+    }
+    defer rows.Close()
+
+    var result []*notification.Preferences
+    for rows.Next() {
+        preferences, err := scanPreferences(rows)
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan notification preferences", err)
+        }
+        result = append(result, preferences)
+    }
+    return result, nil
+}
+
+func (r *NotificationPreferenceRepository) Save(ctx context.Context, preferences *notification.Preferences) error {
+    query := `
+        INSERT INTO notification_preferences (user_id, channels, quiet_hours_start, quiet_hours_end, time_zone, digest, followed_categories)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        ON CONFLICT (user_id) DO UPDATE
+        SET channels = EXCLUDED.channels,
+            quiet_hours_start = EXCLUDED.quiet_hours_start,
+            quiet_hours_end = EXCLUDED.quiet_hours_end,
+            time_zone = EXCLUDED.time_zone,
+            digest = EXCLUDED.digest,
+            followed_categories = EXCLUDED.followed_categories,
+            updated_at = CURRENT_TIMESTAMP
+        RETURNING updated_at`
+
+    channels, err := json.Marshal(preferences.Channels)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to encode notification channels", err)
+    }
+    followedCategories := preferences.FollowedCategories
+    if followedCategories == nil {
+        followedCategories = []string{}
+    }
+    var quietStart, quietEnd sql.NullInt32
+    if preferences.QuietHours != nil {
+        quietStart = sql.NullInt32{Int32: int32(preferences.QuietHours.Start), Valid: true}
+        quietEnd = sql.NullInt32{Int32: int32(preferences.QuietHours.End), Valid: true}
+    }
+
+    err = conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        preferences.UserID,
+        string(channels),
+        quietStart,
+        quietEnd,
+        preferences.TimeZone,
+        preferences.Digest,
+        pq.Array(followedCategories),
+    ).Scan(&preferences.UpdatedAt)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to save notification preferences", err)
+    }
+    return nil
+}
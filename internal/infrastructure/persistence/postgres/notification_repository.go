@@ -0,0 +1,111 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "github.com/lib/pq"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/notification"
+)
+
+type NotificationRepository struct {
+    db *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+    return &NotificationRepository{db: db}
+}
+
+const notificationColumns = `id, user_id, type, message, read_at, created_at`
+
+func (r *NotificationRepository) Create(ctx context.Context, n *notification.Notification) error {
+    query := `
+        INSERT INTO notifications (user_id, type, message)
+        VALUES ($1, $2, $3)
+        RETURNING id, created_at`
+
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, n.UserID, n.Type, n.Message).Scan(&n.ID, &n.CreatedAt)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to create notification", err)
+    }
+    return nil
+}
+
+func (r *NotificationRepository) GetByUserID(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]*notification.Notification, int64, error) {
+    filter := `user_id = $1`
+    if unreadOnly {
+        filter += ` AND read_at IS NULL`
+    }
+
+    query := `
+        SELECT ` + notificationColumns + `
+        FROM notifications
+        WHERE ` + filter + `
+        ORDER BY id DESC
+        LIMIT $2 OFFSET $3`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID, limit, offset)
+    if err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to get notifications", err)
+    }
+    defer rows.Close()
+
+    var notifications []*notification.Notification
+    for rows.Next() {
+        n := &notification.Notification{}
+        if err := rows.Scan(&n.ID, &n.UserID, &n.Type, &n.Message, &n.ReadAt, &n.CreatedAt); err != nil {
+            return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to scan notification", err)
+        }
+        notifications = append(notifications, n)
+    }
+    if err := rows.Err(); err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to get notifications", err)
+    }
+
+    var total int64
+    countQuery := `SELECT COUNT(*) FROM notifications WHERE ` + filter
+    if err := conn(ctx, r.db).QueryRowContext(ctx, countQuery, userID).Scan(&total); err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count notifications", err)
+    }
+
+    return notifications, total, nil
+}
+
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID int64) (int64, error) {
+    var count int64
+    query := `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`
+    if err := conn(ctx, r.db).QueryRowContext(ctx, query, userID).Scan(&count); err != nil {
+        return 0, errors.New(errors.ErrorTypeInternal, "failed to count unread notifications", err)
+    }
+    return count, nil
+}
+
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+    query := `
+        UPDATE notifications
+        SET read_at = CURRENT_TIMESTAMP
+        WHERE user_id = $1 AND id = ANY($2) AND read_at IS NULL`
+
+    return r.markRead(ctx, query, userID, pq.Array(ids))
+}
+
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+    query := `
+        UPDATE notifications
+        SET read_at = CURRENT_TIMESTAMP
+        WHERE user_id = $1 AND read_at IS NULL`
+
+    return r.markRead(ctx, query, userID)
+}
+
+func (r *NotificationRepository) markRead(ctx context.Context, query string, args ...any) (int64, error) {
+    result, err := conn(ctx, r.db).ExecContext(ctx, query, args...)
+    if err != nil {
+        return 0, errors.New(errors.ErrorTypeInternal, "failed to mark notifications as read", err)
+    }
+    marked, err := result.RowsAffected()
+    if err != nil {
+        return 0, errors.New(errors.ErrorTypeInternal, "failed to mark notifications as read", err)
+    }
+    return marked, nil
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "time"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type PaymentRepository struct {
+    db *sql.DB
+}
+
+func NewPaymentRepository(db *sql.DB) *PaymentRepository {
+    return &PaymentRepository{db: db}
+}
+
+const paymentColumns = `id, auction_id, user_id, seller_id, amount, currency, hammer, commission,
+        buyer_premium, status, attempts, next_attempt_at, last_error, created_at, updated_at`
+
+func scanPayment(row rowScanner) (*entity.Payment, error) {
+    payment := &entity.Payment{}
+    err := row.Scan(
+        &payment.ID,
+        &payment.AuctionID,
+        &payment.UserID,
+        &payment.SellerID,
+        &payment.Amount,
+        &payment.Amount.Currency,
+        &payment.Hammer,
+        &payment.Commission,
+        &payment.BuyerPremium,
+        &payment.Status,
+        &payment.Attempts,
+        &payment.NextAttemptAt,
+        &payment.LastError,
+        &payment.CreatedAt,
+        &payment.UpdatedAt,
+    )
+    payment.Hammer.Currency = payment.Amount.Currency
+    payment.Commission.Currency = payment.Amount.Currency
+    payment.BuyerPremium.Currency = payment.Amount.Currency
+    return payment, err
+}
+
+func (r *PaymentRepository) Create(ctx context.Context, payment *entity.Payment) error {
+    query := `
+        INSERT INTO payments (auction_id, user_id, seller_id, amount, currency, hammer, commission, buyer_premium, status, next_attempt_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+        RETURNING id, created_at, updated_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        payment.AuctionID,
+        payment.UserID,
+        payment.SellerID,
+        payment.Amount,
+        payment.Amount.Currency,
+        payment.Hammer,
+        payment.Commission,
+        payment.BuyerPremium,
+        payment.Status,
+        payment.NextAttemptAt,
+    ).Scan(&payment.ID, &payment.CreatedAt, &payment.UpdatedAt)
+
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to create payment", err)
+    }
+
+    return nil
+}
+
+func (r *PaymentRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entity.Payment, error) {
+    query := `
+        SELECT ` + paymentColumns + `
+        FROM payments
+        WHERE id = $1
+        FOR UPDATE`
+
+    payment, err := scanPayment(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+    if err == sql.ErrNoRows {
+        return nil, errors.New(errors.ErrorTypeNotFound, "payment not found", nil)
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to lock payment", err)
+    }
+
+    return payment, nil
+}
+
+func (r *PaymentRepository) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.Payment, error) {
+    query := `
+        SELECT ` + paymentColumns + `
+        FROM payments
+        WHERE auction_id = $1
+        ORDER BY id`
+
+    return r.list(ctx, query, auctionID)
+}
+
+func (r *PaymentRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.Payment, error) {
+    query := `
+        SELECT ` + paymentColumns + `
+        FROM payments
+        WHERE status IN ('PENDING', 'CHARGING', 'REFUNDING') AND next_attempt_at <= $1
+        ORDER BY next_attempt_at, id
+        LIMIT $2`
+
+    return r.list(ctx, query, now, limit)
+}
+
+func (r *PaymentRepository) list(ctx context.Context, query string, args ...any) ([]*entity.Payment, error) {
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get payments", err)
+    }
+    defer rows.Close()
+
+    var payments []*entity.Payment
+    for rows.Next() {
+        payment, err := scanPayment(rows)
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan payment", err)
+        }
+        payments = append(payments, payment)
+    }
+
+    return payments, nil
+}
+
+func (r *PaymentRepository) Update(ctx context.Context, payment *entity.Payment) error {
+    query := `
+        UPDATE payments
+        SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $5
+        RETURNING updated_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        payment.Status,
+        payment.Attempts,
+        payment.NextAttemptAt,
+        payment.LastError,
+        payment.ID,
+    ).Scan(&payment.UpdatedAt)
+
+    if err == sql.ErrNoRows {
+        return errors.New(errors.ErrorTypeNotFound, "payment not found", nil)
+    }
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to update payment", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/errors"
+)
+
+type txKey struct{}
+
+// executor is the subset of *sql.DB and *sql.Tx used by the repositories.
+type executor interface {
+    ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+    QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+    QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// conn returns the transaction bound to ctx, or db when there is none.
+func conn(ctx context.Context, db *sql.DB) executor {
+    if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+        return tx
+    }
+    return db
+}
+
+type TxManager struct {
+    db *sql.DB
+}
+
+func NewTxManager(db *sql.DB) *TxManager {
+    return &TxManager{db: db}
+}
+
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+    if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+        return fn(ctx)
+    }
+
+    tx, err := m.db.BeginTx(ctx, nil)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to begin transaction", err)
+    }
+
+    if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+        _ = tx.Rollback()
+        return err
+    }
+
+    if err := tx.Commit(); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to commit transaction", err)
+    }
+
+    return nil
+}
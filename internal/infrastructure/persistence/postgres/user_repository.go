@@ -24,18 +24,17 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
     }
 
 	query := `
-        INSERT INTO users (username, email, balance, created_at)
-        VALUES ($1, $2, $3, $4)
+        INSERT INTO users (username, email, created_at)
+        VALUES ($1, $2, $3)
         RETURNING id`
 
     user.CreatedAt = time.Now()
     
-    err := r.db.QueryRowContext(
+    err := conn(ctx, r.db).QueryRowContext(
         ctx,
         query,
         user.Username,
         user.Email,
-        user.Balance,
         user.CreatedAt,
     ).Scan(&user.ID)
 
@@ -51,8 +50,8 @@ func (r *UserRepository) GetAll(ctx context.Context) ([]*entity.User, error) {
         return nil, err
     }
 
-	query := `SELECT id, username, email, balance, created_at FROM users`
-    rows, err := r.db.QueryContext(ctx, query)
+	query := `SELECT id, username, email, is_admin, created_at FROM users`
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query)
     if err != nil {
         return nil, errors.New(errors.ErrorTypeInternal, "failed to get all users", err)
     }
@@ -61,7 +60,7 @@ func (r *UserRepository) GetAll(ctx context.Context) ([]*entity.User, error) {
     var users []*entity.User
     for rows.Next() {
         user := &entity.User{}
-        err := rows.Scan(&user.ID, &user.Username, &user.Email, &user.Balance, &user.CreatedAt)
+        err := rows.Scan(&user.ID, &user.Username, &user.Email, &user.IsAdmin, &user.CreatedAt)
         if err != nil {
             return nil, errors.New(errors.ErrorTypeInternal, "failed to scan user", err)
         }
@@ -77,7 +76,7 @@ func (r *UserRepository) Delete(ctx context.Context, id int64) error {
     }
 
 	query := `DELETE FROM users WHERE id = $1`
-    _, err := r.db.ExecContext(ctx, query, id)
+    _, err := conn(ctx, r.db).ExecContext(ctx, query, id)
     return err
 }
 
@@ -88,23 +87,22 @@ func (r *UserRepository) Update(ctx context.Context, id int64, user *entity.User
 
 	query := `
         UPDATE users 
-        SET username = $1, email = $2, balance = $3 
-        WHERE id = $4 
-        RETURNING id, username, email, balance, created_at`
+        SET username = $1, email = $2 
+        WHERE id = $3 
+        RETURNING id, username, email, is_admin, created_at`
         
     updatedUser := &entity.User{}
-    err := r.db.QueryRowContext(
+    err := conn(ctx, r.db).QueryRowContext(
         ctx,
         query,
         user.Username,
         user.Email,
-        user.Balance,
         id,
     ).Scan(
         &updatedUser.ID,
         &updatedUser.Username,
         &updatedUser.Email,
-        &updatedUser.Balance,
+        &updatedUser.IsAdmin,
         &updatedUser.CreatedAt,
     )
 
@@ -125,16 +123,16 @@ func (r *UserRepository) GetByID(ctx context.Context, id int64) (*entity.User, e
     }
 
 	query := `
-        SELECT id, username, email, balance, created_at
+        SELECT id, username, email, is_admin, created_at
         FROM users
         WHERE id = $1`
 
     user := &entity.User{}
-    err := r.db.QueryRowContext(ctx, query, id).Scan(
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
         &user.ID,
         &user.Username,
         &user.Email,
-        &user.Balance,
+        &user.IsAdmin,
         &user.CreatedAt,
     )
 
@@ -148,60 +146,61 @@ func (r *UserRepository) GetByID(ctx context.Context, id int64) (*entity.User, e
     return user, nil
 }
 
-func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
-	if err := ctx.Err(); err != nil {
-        return nil, err
-    }
-	
-	query := `
-        SELECT id, username, email, balance, created_at
+// GetByIDForUpdate locks the user row until the surrounding transaction
+// finishes, so concurrent bids by the same user cannot over-commit funds.
+func (r *UserRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entity.User, error) {
+    query := `
+        SELECT id, username, email, is_admin, created_at
         FROM users
-        WHERE email = $1`
+        WHERE id = $1
+        FOR UPDATE`
 
     user := &entity.User{}
-    err := r.db.QueryRowContext(ctx, query, email).Scan(
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
         &user.ID,
         &user.Username,
         &user.Email,
-        &user.Balance,
+        &user.IsAdmin,
         &user.CreatedAt,
     )
 
     if err == sql.ErrNoRows {
-        return nil, nil
+        return nil, errors.NewNotFoundError("user not found")
     }
     if err != nil {
-        return nil, errors.New(errors.ErrorTypeInternal, "failed to get user by email", err)
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to lock user", err)
     }
 
     return user, nil
 }
 
-func (r *UserRepository) UpdateBalance(ctx context.Context, id int64, balance float64) error {
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
 	if err := ctx.Err(); err != nil {
-        return err
+        return nil, err
     }
-
+	
 	query := `
-        UPDATE users
-        SET balance = $1
-        WHERE id = $2`
+        SELECT id, username, email, is_admin, created_at
+        FROM users
+        WHERE email = $1`
 
-    result, err := r.db.ExecContext(ctx, query, balance, id)
-    if err != nil {
-        return errors.New(errors.ErrorTypeInternal, "failed to update balance", err)
-    }
+    user := &entity.User{}
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, email).Scan(
+        &user.ID,
+        &user.Username,
+        &user.Email,
+        &user.IsAdmin,
+        &user.CreatedAt,
+    )
 
-    rowsAffected, err := result.RowsAffected()
-    if err != nil {
-        return errors.New(errors.ErrorTypeInternal, "failed to get rows affected", err)
+    if err == sql.ErrNoRows {
+        return nil, nil
     }
-
-    if rowsAffected == 0 {
-        return errors.NewNotFoundError("user not found")
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get user by email", err)
     }
 
-    return nil
+    return user, nil
 }
 
 func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity.User, int64, error) {
@@ -210,12 +209,12 @@ func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity
     }
 
 	query := `
-        SELECT id, username, email, balance, created_at
+        SELECT id, username, email, is_admin, created_at
         FROM users
         ORDER BY id
         LIMIT $1 OFFSET $2`
 
-    rows, err := r.db.QueryContext(ctx, query, limit, offset)
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, limit, offset)
     if err != nil {
         return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to list users", err)
     }
@@ -228,7 +227,7 @@ func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity
             &user.ID,
             &user.Username,
             &user.Email,
-            &user.Balance,
+            &user.IsAdmin,
             &user.CreatedAt,
         )
         if err != nil {
@@ -238,7 +237,7 @@ func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity
     }
 
     var total int64
-    err = r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users").Scan(&total)
+    err = conn(ctx, r.db).QueryRowContext(ctx, "SELECT COUNT(*) FROM users").Scan(&total)
     if err != nil {
         return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count users", err)
     }
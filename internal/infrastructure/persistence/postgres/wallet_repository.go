@@ -0,0 +1,87 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type WalletRepository struct {
+    db *sql.DB
+}
+
+func NewWalletRepository(db *sql.DB) *WalletRepository {
+    return &WalletRepository{db: db}
+}
+
+func (r *WalletRepository) GetByUserID(ctx context.Context, userID int64) ([]*entity.Wallet, error) {
+    query := `
+        SELECT user_id, currency, balance, updated_at
+        FROM wallets
+        WHERE user_id = $1
+        ORDER BY currency`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get wallets", err)
+    }
+    defer rows.Close()
+
+    var wallets []*entity.Wallet
+    for rows.Next() {
+        wallet := &entity.Wallet{}
+        err := rows.Scan(
+            &wallet.UserID,
+            &wallet.Currency,
+            &wallet.Balance,
+            &wallet.UpdatedAt,
+        )
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan wallet", err)
+        }
+        wallet.Balance.Currency = wallet.Currency
+        wallet.HeldBalance = entity.NewMoney(0, wallet.Currency)
+        wallets = append(wallets, wallet)
+    }
+
+    return wallets, nil
+}
+
+func (r *WalletRepository) Get(ctx context.Context, userID int64, currency string) (*entity.Wallet, error) {
+    query := `
+        SELECT user_id, currency, balance, updated_at
+        FROM wallets
+        WHERE user_id = $1 AND currency = $2`
+
+    wallet := entity.NewWallet(userID, currency)
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, userID, currency).Scan(
+        &wallet.UserID,
+        &wallet.Currency,
+        &wallet.Balance,
+        &wallet.UpdatedAt,
+    )
+    if err == sql.ErrNoRows {
+        return entity.NewWallet(userID, currency), nil
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get wallet", err)
+    }
+
+    wallet.Balance.Currency = currency
+    return wallet, nil
+}
+
+func (r *WalletRepository) Adjust(ctx context.Context, userID int64, delta entity.Money) error {
+    query := `
+        INSERT INTO wallets (user_id, currency, balance)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (user_id, currency) DO UPDATE
+        SET balance = wallets.balance + EXCLUDED.balance,
+            updated_at = CURRENT_TIMESTAMP`
+
+    if _, err := conn(ctx, r.db).ExecContext(ctx, query, userID, delta.Currency, delta); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to update balance", err)
+    }
+    return nil
+}
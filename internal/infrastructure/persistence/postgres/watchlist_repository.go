@@ -0,0 +1,70 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type WatchlistRepository struct {
+    db *sql.DB
+}
+
+func NewWatchlistRepository(db *sql.DB) *WatchlistRepository {
+    return &WatchlistRepository{db: db}
+}
+
+func (r *WatchlistRepository) Add(ctx context.Context, userID, auctionID int64) error {
+    query := `
+        INSERT INTO watchlist (user_id, auction_id)
+        VALUES ($1, $2)
+        ON CONFLICT (user_id, auction_id) DO NOTHING`
+
+    if _, err := conn(ctx, r.db).ExecContext(ctx, query, userID, auctionID); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to add auction to watchlist", err)
+    }
+    return nil
+}
+
+func (r *WatchlistRepository) Remove(ctx context.Context, userID, auctionID int64) error {
+    query := `DELETE FROM watchlist WHERE user_id = $1 AND auction_id = $2`
+
+    result, err := conn(ctx, r.db).ExecContext(ctx, query, userID, auctionID)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to remove auction from watchlist", err)
+    }
+
+    rows, err := result.RowsAffected()
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to get affected rows", err)
+    }
+    if rows == 0 {
+        return errors.New(errors.ErrorTypeNotFound, "auction is not on the watchlist", nil)
+    }
+    return nil
+}
+
+func (r *WatchlistRepository) GetByUserID(ctx context.Context, userID int64) ([]*entity.Auction, error) {
+    query := `
+        SELECT ` + auctionColumns + `
+        FROM auctions
+        WHERE id IN (SELECT auction_id FROM watchlist WHERE user_id = $1)
+        ORDER BY end_time, id`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to query watchlist", err)
+    }
+    defer rows.Close()
+
+    var auctions []*entity.Auction
+    for rows.Next() {
+        auction, err := scanAuction(rows)
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan auction", err)
+        }
+        auctions = append(auctions, auction)
+    }
+    return auctions, nil
+}
@@ -0,0 +1,268 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "time"
+    "github.com/lib/pq"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type WebhookRepository struct {
+    db *sql.DB
+}
+
+func NewWebhookRepository(db *sql.DB) *WebhookRepository {
+    return &WebhookRepository{db: db}
+}
+
+const webhookColumns = `id, user_id, url, event_types, secret, created_at`
+
+func scanWebhook(row rowScanner) (*entity.Webhook, error) {
+    webhook := &entity.Webhook{}
+    var eventTypes []string
+    err := row.Scan(
+        &webhook.ID,
+        &webhook.UserID,
+        &webhook.URL,
+        pq.Array(&eventTypes),
+        &webhook.Secret,
+        &webhook.CreatedAt,
+    )
+    for _, t := range eventTypes {
+        webhook.EventTypes = append(webhook.EventTypes, entity.AuctionEventType(t))
+    }
+    return webhook, err
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, webhook *entity.Webhook) error {
+    query := `
+        INSERT INTO webhooks (user_id, url, event_types, secret)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, created_at`
+
+    eventTypes := make([]string, len(webhook.EventTypes))
+    for i, t := range webhook.EventTypes {
+        eventTypes[i] = string(t)
+    }
+
+    err := conn(ctx, r.db).QueryRowContext(ctx, query, webhook.UserID, webhook.URL, pq.Array(eventTypes), webhook.Secret).
+        Scan(&webhook.ID, &webhook.CreatedAt)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to create webhook", err)
+    }
+    return nil
+}
+
+func (r *WebhookRepository) GetByID(ctx context.Context, id int64) (*entity.Webhook, error) {
+    query := `SELECT ` + webhookColumns + ` FROM webhooks WHERE id = $1`
+
+    webhook, err := scanWebhook(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+    if err == sql.ErrNoRows {
+        return nil, errors.New(errors.ErrorTypeNotFound, "webhook not found", nil)
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get webhook", err)
+    }
+    return webhook, nil
+}
+
+func (r *WebhookRepository) GetByUserID(ctx context.Context, userID int64) ([]*entity.Webhook, error) {
+    query := `
+        SELECT ` + webhookColumns + `
+        FROM webhooks
+        WHERE user_id = $1
+        ORDER BY id`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, userID)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get webhooks", err)
+    }
+    defer rows.Close()
+
+    var webhooks []*entity.Webhook
+    for rows.Next() {
+        webhook, err := scanWebhook(rows)
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan webhook", err)
+        }
+        webhooks = append(webhooks, webhook)
+    }
+
+    return webhooks, nil
+}
+
+func (r *WebhookRepository) Delete(ctx context.Context, id int64) error {
+    result, err := conn(ctx, r.db).ExecContext(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to delete webhook", err)
+    }
+    deleted, err := result.RowsAffected()
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to delete webhook", err)
+    }
+    if deleted == 0 {
+        return errors.New(errors.ErrorTypeNotFound, "webhook not found", nil)
+    }
+    return nil
+}
+
+type WebhookDeliveryRepository struct {
+    db *sql.DB
+}
+
+func NewWebhookDeliveryRepository(db *sql.DB) *WebhookDeliveryRepository {
+    return &WebhookDeliveryRepository{db: db}
+}
+
+const webhookDeliveryColumns = `id, webhook_id, event_id, event_type, payload, status, attempts,
+        next_attempt_at, last_status_code, last_error, delivered_at, created_at, updated_at`
+
+func scanWebhookDelivery(row rowScanner) (*entity.WebhookDelivery, error) {
+    delivery := &entity.WebhookDelivery{}
+    err := row.Scan(
+        &delivery.ID,
+        &delivery.WebhookID,
+        &delivery.EventID,
+        &delivery.EventType,
+        &delivery.Payload,
+        &delivery.Status,
+        &delivery.Attempts,
+        &delivery.NextAttemptAt,
+        &delivery.LastStatusCode,
+        &delivery.LastError,
+        &delivery.DeliveredAt,
+        &delivery.CreatedAt,
+        &delivery.UpdatedAt,
+    )
+    return delivery, err
+}
+
+func (r *WebhookDeliveryRepository) CreateForEvent(ctx context.Context, event *entity.AuctionEvent, payload []byte) error {
+    query := `
+        INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+        SELECT id, $1::bigint, $2::text, $3::jsonb
+        FROM webhooks
+        WHERE event_types = '{}' OR $2::text = ANY(event_types)`
+
+    if _, err := conn(ctx, r.db).ExecContext(ctx, query, event.ID, string(event.Type), string(payload)); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to queue webhook deliveries", err)
+    }
+    return nil
+}
+
+func (r *WebhookDeliveryRepository) CreateForUser(ctx context.Context, userID int64, eventType string, payload []byte) error {
+    query := `
+        INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+        SELECT id, 0, $2::text, $3::jsonb
+        FROM webhooks
+        WHERE user_id = $1`
+
+    if _, err := conn(ctx, r.db).ExecContext(ctx, query, userID, eventType, string(payload)); err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to queue webhook deliveries", err)
+    }
+    return nil
+}
+
+func (r *WebhookDeliveryRepository) GetByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+    return r.get(ctx, `SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries WHERE id = $1`, id)
+}
+
+func (r *WebhookDeliveryRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+    return r.get(ctx, `SELECT `+webhookDeliveryColumns+` FROM webhook_deliveries WHERE id = $1 FOR UPDATE`, id)
+}
+
+func (r *WebhookDeliveryRepository) get(ctx context.Context, query string, id int64) (*entity.WebhookDelivery, error) {
+    delivery, err := scanWebhookDelivery(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+    if err == sql.ErrNoRows {
+        return nil, errors.New(errors.ErrorTypeNotFound, "webhook delivery not found", nil)
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get webhook delivery", err)
+    }
+    return delivery, nil
+}
+
+func (r *WebhookDeliveryRepository) GetByWebhookID(ctx context.Context, webhookID int64, status entity.WebhookDeliveryStatus, offset, limit int) ([]*entity.WebhookDelivery, int64, error) {
+    filter := `webhook_id = $1 AND ($2::text = '' OR status = $2::text)`
+    query := `
+        SELECT ` + webhookDeliveryColumns + `
+        FROM webhook_deliveries
+        WHERE ` + filter + `
+        ORDER BY id DESC
+        LIMIT $3 OFFSET $4`
+
+    deliveries, err := r.list(ctx, query, webhookID, string(status), limit, offset)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    var total int64
+    countQuery := `SELECT COUNT(*) FROM webhook_deliveries WHERE ` + filter
+    if err := conn(ctx, r.db).QueryRowContext(ctx, countQuery, webhookID, string(status)).Scan(&total); err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count webhook deliveries", err)
+    }
+
+    return deliveries, total, nil
+}
+
+func (r *WebhookDeliveryRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+    query := `
+        SELECT ` + webhookDeliveryColumns + `
+        FROM webhook_deliveries
+        WHERE status = 'PENDING' AND next_attempt_at <= $1
+        ORDER BY next_attempt_at, id
+        LIMIT $2`
+
+    return r.list(ctx, query, now, limit)
+}
+
+func (r *WebhookDeliveryRepository) list(ctx context.Context, query string, args ...any) ([]*entity.WebhookDelivery, error) {
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get webhook deliveries", err)
+    }
+    defer rows.Close()
+
+    var deliveries []*entity.WebhookDelivery
+    for rows.Next() {
+        delivery, err := scanWebhookDelivery(rows)
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan webhook delivery", err)
+        }
+        deliveries = append(deliveries, delivery)
+    }
+
+    return deliveries, nil
+}
+
+func (r *WebhookDeliveryRepository) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+    query := `
+        UPDATE webhook_deliveries
+        SET status = $1, attempts = $2, next_attempt_at = $3, last_status_code = $4, last_error = $5,
+            delivered_at = $6, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $7
+        RETURNING updated_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        delivery.Status,
+        delivery.Attempts,
+        delivery.NextAttemptAt,
+        delivery.LastStatusCode,
+        delivery.LastError,
+        delivery.DeliveredAt,
+        delivery.ID,
+    ).Scan(&delivery.UpdatedAt)
+
+    if err == sql.ErrNoRows {
+        return errors.New(errors.ErrorTypeNotFound, "webhook delivery not found", nil)
+    }
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to update webhook delivery", err)
+    }
+
+    return nil
+}
@@ -0,0 +1,216 @@
+package postgres
+
+import (
+    "context"
+    "database/sql"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+type WithdrawalRepository struct {
+    db *sql.DB
+}
+
+func NewWithdrawalRepository(db *sql.DB) *WithdrawalRepository {
+    return &WithdrawalRepository{db: db}
+}
+
+const withdrawalColumns = `id, user_id, amount, currency, destination, status, reviewer_id, reference, note, created_at, updated_at`
+
+func scanWithdrawal(row rowScanner) (*entity.Withdrawal, error) {
+    withdrawal := &entity.Withdrawal{}
+    err := row.Scan(
+        &withdrawal.ID,
+        &withdrawal.UserID,
+        &withdrawal.Amount,
+        &withdrawal.Amount.Currency,
+        &withdrawal.Destination,
+        &withdrawal.Status,
+        &withdrawal.ReviewerID,
+        &withdrawal.Reference,
+        &withdrawal.Note,
+        &withdrawal.CreatedAt,
+        &withdrawal.UpdatedAt,
+    )
+    return withdrawal, err
+}
+
+func (r *WithdrawalRepository) Create(ctx context.Context, withdrawal *entity.Withdrawal) error {
+    query := `
+        INSERT INTO withdrawals (user_id, amount, currency, destination, status)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id, created_at, updated_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        withdrawal.UserID,
+        withdrawal.Amount,
+        withdrawal.Amount.Currency,
+        withdrawal.Destination,
+        withdrawal.Status,
+    ).Scan(&withdrawal.ID, &withdrawal.CreatedAt, &withdrawal.UpdatedAt)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to create withdrawal", err)
+    }
+
+    return r.addEvent(ctx, withdrawal, &withdrawal.UserID)
+}
+
+func (r *WithdrawalRepository) GetByID(ctx context.Context, id int64) (*entity.Withdrawal, error) {
+    withdrawal, err := r.get(ctx, `SELECT `+withdrawalColumns+` FROM withdrawals WHERE id = $1`, id)
+    if err != nil {
+        return nil, err
+    }
+
+    query := `
+        SELECT id, withdrawal_id, status, actor_id, note, created_at
+        FROM withdrawal_events
+        WHERE withdrawal_id = $1
+        ORDER BY id`
+
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, id)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get withdrawal history", err)
+    }
+    defer rows.Close()
+
+    for rows.Next() {
+        event := &entity.WithdrawalEvent{}
+        err := rows.Scan(
+            &event.ID,
+            &event.WithdrawalID,
+            &event.Status,
+            &event.ActorID,
+            &event.Note,
+            &event.CreatedAt,
+        )
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan withdrawal event", err)
+        }
+        withdrawal.History = append(withdrawal.History, event)
+    }
+
+    return withdrawal, nil
+}
+
+func (r *WithdrawalRepository) GetByIDForUpdate(ctx context.Context, id int64) (*entity.Withdrawal, error) {
+    return r.get(ctx, `SELECT `+withdrawalColumns+` FROM withdrawals WHERE id = $1 FOR UPDATE`, id)
+}
+
+func (r *WithdrawalRepository) get(ctx context.Context, query string, id int64) (*entity.Withdrawal, error) {
+    withdrawal, err := scanWithdrawal(conn(ctx, r.db).QueryRowContext(ctx, query, id))
+    if err == sql.ErrNoRows {
+        return nil, errors.New(errors.ErrorTypeNotFound, "withdrawal not found", nil)
+    }
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get withdrawal", err)
+    }
+    return withdrawal, nil
+}
+
+func (r *WithdrawalRepository) GetByUserID(ctx context.Context, userID int64, offset, limit int) ([]*entity.Withdrawal, int64, error) {
+    query := `
+        SELECT ` + withdrawalColumns + `
+        FROM withdrawals
+        WHERE user_id = $1
+        ORDER BY id DESC
+        LIMIT $2 OFFSET $3`
+
+    withdrawals, err := r.list(ctx, query, userID, limit, offset)
+    if err != nil {
+        return nil, 0, err
+    }
+
+    var total int64
+    countQuery := `SELECT COUNT(*) FROM withdrawals WHERE user_id = $1`
+    if err := conn(ctx, r.db).QueryRowContext(ctx, countQuery, userID).Scan(&total); err != nil {
+        return nil, 0, errors.New(errors.ErrorTypeInternal, "failed to count withdrawals", err)
+    }
+
+    return withdrawals, total, nil
+}
+
+func (r *WithdrawalRepository) GetApproved(ctx context.Context, limit int) ([]*entity.Withdrawal, error) {
+    query := `
+        SELECT ` + withdrawalColumns + `
+        FROM withdrawals
+        WHERE status = 'APPROVED'
+        ORDER BY id
+        LIMIT $1`
+
+    return r.list(ctx, query, limit)
+}
+
+func (r *WithdrawalRepository) list(ctx context.Context, query string, args ...any) ([]*entity.Withdrawal, error) {
+    rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+    if err != nil {
+        return nil, errors.New(errors.ErrorTypeInternal, "failed to get withdrawals", err)
+    }
+    defer rows.Close()
+
+    var withdrawals []*entity.Withdrawal
+    for rows.Next() {
+        withdrawal, err := scanWithdrawal(rows)
+        if err != nil {
+            return nil, errors.New(errors.ErrorTypeInternal, "failed to scan withdrawal", err)
+        }
+        withdrawals = append(withdrawals, withdrawal)
+    }
+
+    return withdrawals, nil
+}
+
+func (r *WithdrawalRepository) UpdateStatus(ctx context.Context, withdrawal *entity.Withdrawal, actorID *int64) error {
+    query := `
+        UPDATE withdrawals
+        SET status = $1, reviewer_id = $2, reference = $3, note = $4, updated_at = CURRENT_TIMESTAMP
+        WHERE id = $5
+        RETURNING updated_at`
+
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        withdrawal.Status,
+        withdrawal.ReviewerID,
+        withdrawal.Reference,
+        withdrawal.Note,
+        withdrawal.ID,
+    ).Scan(&withdrawal.UpdatedAt)
+    if err == sql.ErrNoRows {
+        return errors.New(errors.ErrorTypeNotFound, "withdrawal not found", nil)
+    }
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to update withdrawal", err)
+    }
+
+    return r.addEvent(ctx, withdrawal, actorID)
+}
+
+func (r *WithdrawalRepository) addEvent(ctx context.Context, withdrawal *entity.Withdrawal, actorID *int64) error {
+    query := `
+        INSERT INTO withdrawal_events (withdrawal_id, status, actor_id, note)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, created_at`
+
+    event := &entity.WithdrawalEvent{
+        WithdrawalID: withdrawal.ID,
+        Status:       withdrawal.Status,
+        ActorID:      actorID,
+        Note:         withdrawal.Note,
+    }
+    err := conn(ctx, r.db).QueryRowContext(
+        ctx,
+        query,
+        event.WithdrawalID,
+        event.Status,
+        event.ActorID,
+        event.Note,
+    ).Scan(&event.ID, &event.CreatedAt)
+    if err != nil {
+        return errors.New(errors.ErrorTypeInternal, "failed to record withdrawal status", err)
+    }
+
+    withdrawal.History = append(withdrawal.History, event)
+    return nil
+}
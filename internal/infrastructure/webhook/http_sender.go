@@ -0,0 +1,94 @@
+package webhook
+
+import (
+    "bytes"
+    "context"
+    "fmt"
+    "io"
+    "net"
+    "net/http"
+    "net/netip"
+    "strconv"
+    "syscall"
+    "time"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/webhook"
+)
+
+// maxErrorBody is how much of a rejecting response is kept as the error.
+const maxErrorBody = 512
+
+// HTTPSender POSTs deliveries as signed JSON requests.
+type HTTPSender struct {
+    client *http.Client
+}
+
+// NewHTTPSender gives every request timeout to complete. Redirects are not
+// followed, so a delivery only counts if the webhook's own URL accepts it.
+// Unless allowPrivate is set, connections to addresses that are not
+// webhook.PublicAddress are refused when they are dialed, after the name
+// has been resolved, so a webhook cannot reach internal services even if
+// its host name later resolves to one. Requests never go through a proxy,
+// which would hide the address from that check.
+func NewHTTPSender(timeout time.Duration, allowPrivate bool) webhook.Sender {
+    dialer := &net.Dialer{Timeout: timeout}
+    if !allowPrivate {
+        dialer.Control = refusePrivate
+    }
+    return &HTTPSender{
+        client: &http.Client{
+            Timeout: timeout,
+            Transport: &http.Transport{
+                DialContext:         dialer.DialContext,
+                TLSHandshakeTimeout: timeout,
+                MaxIdleConns:        100,
+                IdleConnTimeout:     90 * time.Second,
+            },
+            CheckRedirect: func(req *http.Request, via []*http.Request) error {
+                return http.ErrUseLastResponse
+            },
+        },
+    }
+}
+
+func refusePrivate(network, address string, c syscall.RawConn) error {
+    host, _, err := net.SplitHostPort(address)
+    if err != nil {
+        return err
+    }
+    addr, err := netip.ParseAddr(host)
+    if err != nil {
+        return err
+    }
+    if !webhook.PublicAddress(addr) {
+        return fmt.Errorf("webhook address %s is not public", addr)
+    }
+    return nil
+}
+
+func (s *HTTPSender) Send(ctx context.Context, hook *entity.Webhook, delivery *entity.WebhookDelivery) (int, error) {
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(delivery.Payload))
+    if err != nil {
+        return 0, err
+    }
+
+    timestamp := time.Now().Unix()
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("User-Agent", "auction-system-webhooks")
+    req.Header.Set(webhook.DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+    req.Header.Set(webhook.EventHeader, delivery.EventType)
+    req.Header.Set(webhook.TimestampHeader, strconv.FormatInt(timestamp, 10))
+    req.Header.Set(webhook.SignatureHeader, "sha256="+webhook.Signature(hook.Secret, timestamp, delivery.Payload))
+
+    resp, err := s.client.Do(req)
+    if err != nil {
+        return 0, err
+    }
+    defer resp.Body.Close()
+
+    body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+    if resp.StatusCode < 200 || resp.StatusCode > 299 {
+        return resp.StatusCode, fmt.Errorf("receiver answered %s: %s", resp.Status, bytes.TrimSpace(body))
+    }
+    return resp.StatusCode, nil
+}
@@ -2,12 +2,15 @@ package handler
 
 import (
     "context"
+    "time"
     "google.golang.org/grpc/codes"
     grpcStatus "google.golang.org/grpc/status"
     "google.golang.org/protobuf/types/known/timestamppb"
     
     pb "auction-system/pkg/api"
     dto "auction-system/internal/application/dto/auction"
+    "auction-system/internal/application/eventbus"
+    "auction-system/internal/domain/entity"
     auctionUseCase "auction-system/internal/application/usecase/auction"
 )
 
@@ -17,6 +20,13 @@ type AuctionHandler struct {
     GetAuctionUC    auctionUseCase.GetAuctionUseCaseInterface
     UpdateAuctionUC auctionUseCase.UpdateAuctionUseCaseInterface
     ListAuctionsUC  auctionUseCase.ListAuctionsUseCaseInterface
+    BuyNowUC        auctionUseCase.BuyNowUseCaseInterface
+    AcceptPriceUC   auctionUseCase.AcceptPriceUseCaseInterface
+    GetSettlementUC auctionUseCase.GetSettlementUseCaseInterface
+    AddToWatchlistUC      auctionUseCase.AddToWatchlistUseCaseInterface
+    RemoveFromWatchlistUC auctionUseCase.RemoveFromWatchlistUseCaseInterface
+    GetWatchlistUC        auctionUseCase.GetWatchlistUseCaseInterface
+    WatchAuctionUC  auctionUseCase.WatchAuctionUseCaseInterface
 }
 
 func NewAuctionHandler(
@@ -24,22 +34,50 @@ func NewAuctionHandler(
     getAuctionUC auctionUseCase.GetAuctionUseCaseInterface,
     updateAuctionUC auctionUseCase.UpdateAuctionUseCaseInterface,
     listAuctionsUC auctionUseCase.ListAuctionsUseCaseInterface,
+    buyNowUC auctionUseCase.BuyNowUseCaseInterface,
+    acceptPriceUC auctionUseCase.AcceptPriceUseCaseInterface,
+    getSettlementUC auctionUseCase.GetSettlementUseCaseInterface,
+    addToWatchlistUC auctionUseCase.AddToWatchlistUseCaseInterface,
+    removeFromWatchlistUC auctionUseCase.RemoveFromWatchlistUseCaseInterface,
+    getWatchlistUC auctionUseCase.GetWatchlistUseCaseInterface,
+    watchAuctionUC auctionUseCase.WatchAuctionUseCaseInterface,
 ) *AuctionHandler {
     return &AuctionHandler{
         CreateAuctionUC: createAuctionUC,
         GetAuctionUC:    getAuctionUC,
         UpdateAuctionUC: updateAuctionUC,
         ListAuctionsUC:  listAuctionsUC,
+        BuyNowUC:        buyNowUC,
+        AcceptPriceUC:   acceptPriceUC,
+        GetSettlementUC: getSettlementUC,
+        AddToWatchlistUC:      addToWatchlistUC,
+        RemoveFromWatchlistUC: removeFromWatchlistUC,
+        GetWatchlistUC:        getWatchlistUC,
+        WatchAuctionUC:  watchAuctionUC,
     }
 }
 
 func (h *AuctionHandler) CreateAuction(ctx context.Context, req *pb.CreateAuctionRequest) (*pb.CreateAuctionResponse, error) {
+    var amounts moneyFields
     createReq := &dto.CreateAuctionRequest{
         LotID:      req.LotId,
-        StartPrice: req.StartPrice,
-        MinStep:    req.MinStep,
+        Type:       entity.AuctionType(req.Type),
+        StartPrice: amounts.read("start_price", req.StartPrice),
+        ReservePrice: amounts.read("reserve_price", req.ReservePrice),
+        BuyNowPrice: amounts.read("buy_now_price", req.BuyNowPrice),
+        MinStep:    amounts.read("min_step", req.MinStep),
         StartTime:  req.StartTime.AsTime(),
         EndTime:    req.EndTime.AsTime(),
+        ExtensionWindow:   time.Duration(req.ExtensionWindowMinutes) * time.Minute,
+        ExtensionDuration: time.Duration(req.ExtensionMinutes) * time.Minute,
+        MaxExtension:      time.Duration(req.MaxExtensionMinutes) * time.Minute,
+        PriceDecrement:    amounts.read("price_decrement", req.PriceDecrement),
+        DecrementInterval: time.Duration(req.DecrementIntervalMinutes) * time.Minute,
+        FloorPrice:        amounts.read("floor_price", req.FloorPrice),
+        Pricing:           entity.MultiUnitPricing(req.Pricing),
+    }
+    if amounts.err != nil {
+        return nil, amounts.err
     }
 
     result, err := h.CreateAuctionUC.Execute(ctx, createReq)
@@ -64,8 +102,12 @@ func (h *AuctionHandler) GetAuction(ctx context.Context, req *pb.GetAuctionReque
 }
 
 func (h *AuctionHandler) UpdateAuction(ctx context.Context, req *pb.UpdateAuctionRequest) (*pb.UpdateAuctionResponse, error) {
-    startPrice := req.StartPrice
-    minStep := req.MinStep
+    var amounts moneyFields
+    startPrice := amounts.read("start_price", req.StartPrice)
+    minStep := amounts.read("min_step", req.MinStep)
+    if amounts.err != nil {
+        return nil, amounts.err
+    }
     auctionStatus := req.Status
     
     startTime := req.StartTime.AsTime()
@@ -96,7 +138,7 @@ func (h *AuctionHandler) ListAuctions(ctx context.Context, req *pb.ListAuctionsR
         auctionStatus = &s
     }
 
-    result, err := h.ListAuctionsUC.Execute(ctx, int(req.PageNumber), int(req.PageSize), auctionStatus)
+    result, err := h.ListAuctionsUC.Execute(ctx, int(req.PageNumber), int(req.PageSize), auctionStatus, req.DisplayCurrency)
     if err != nil {
         return nil, grpcStatus.Error(codes.Internal, err.Error())
     }
@@ -112,6 +154,177 @@ func (h *AuctionHandler) ListAuctions(ctx context.Context, req *pb.ListAuctionsR
     }, nil
 }
 
+func (h *AuctionHandler) BuyNow(ctx context.Context, req *pb.BuyNowRequest) (*pb.BuyNowResponse, error) {
+    buyNowReq := &dto.BuyNowRequest{
+        AuctionID: req.AuctionId,
+        UserID:    req.UserId,
+    }
+
+    result, err := h.BuyNowUC.Execute(ctx, buyNowReq)
+    if err != nil {
+        return nil, grpcStatus.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.BuyNowResponse{
+        Auction: MapAuctionToProto(result),
+    }, nil
+}
+
+func (h *AuctionHandler) AcceptPrice(ctx context.Context, req *pb.AcceptPriceRequest) (*pb.AcceptPriceResponse, error) {
+    acceptReq := &dto.AcceptPriceRequest{
+        AuctionID: req.AuctionId,
+        UserID:    req.UserId,
+    }
+
+    result, err := h.AcceptPriceUC.Execute(ctx, acceptReq)
+    if err != nil {
+        return nil, grpcStatus.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.AcceptPriceResponse{
+        Auction: MapAuctionToProto(result),
+    }, nil
+}
+
+func (h *AuctionHandler) GetSettlement(ctx context.Context, req *pb.GetSettlementRequest) (*pb.GetSettlementResponse, error) {
+    result, err := h.GetSettlementUC.Execute(ctx, &dto.GetSettlementRequest{
+        AuctionID: req.AuctionId,
+        SellerID:  req.SellerId,
+    })
+    if err != nil {
+        return nil, grpcStatus.Error(codes.Internal, err.Error())
+    }
+
+    payments := make([]*pb.SettlementPayment, len(result.Payments))
+    for i, p := range result.Payments {
+        payments[i] = &pb.SettlementPayment{
+            Id:      p.ID,
+            BuyerId: p.BuyerID,
+            Status:  string(p.Status),
+            Fees:    mapFeesToProto(&p.Fees),
+        }
+    }
+
+    return &pb.GetSettlementResponse{
+        Settlement: &pb.Settlement{
+            AuctionId: result.AuctionID,
+            SellerId:  result.SellerID,
+            Status:    string(result.Status),
+            Currency:  result.Currency,
+            Totals:    mapFeesToProto(&result.Totals),
+            PaidOut:   toProtoMoney(result.PaidOut),
+            Payments:  payments,
+        },
+    }, nil
+}
+
+func (h *AuctionHandler) AddToWatchlist(ctx context.Context, req *pb.AddToWatchlistRequest) (*pb.WatchlistResponse, error) {
+    result, err := h.AddToWatchlistUC.Execute(ctx, &dto.WatchlistRequest{
+        UserID:    req.UserId,
+        AuctionID: req.AuctionId,
+    })
+    if err != nil {
+        return nil, grpcStatus.Error(codes.Internal, err.Error())
+    }
+
+    return toProtoWatchlist(result), nil
+}
+
+func (h *AuctionHandler) RemoveFromWatchlist(ctx context.Context, req *pb.RemoveFromWatchlistRequest) (*pb.WatchlistResponse, error) {
+    result, err := h.RemoveFromWatchlistUC.Execute(ctx, &dto.WatchlistRequest{
+        UserID:    req.UserId,
+        AuctionID: req.AuctionId,
+    })
+    if err != nil {
+        return nil, grpcStatus.Error(codes.Internal, err.Error())
+    }
+
+    return toProtoWatchlist(result), nil
+}
+
+func (h *AuctionHandler) GetWatchlist(ctx context.Context, req *pb.GetWatchlistRequest) (*pb.WatchlistResponse, error) {
+    result, err := h.GetWatchlistUC.Execute(ctx, req.UserId)
+    if err != nil {
+        return nil, grpcStatus.Error(codes.Internal, err.Error())
+    }
+
+    return toProtoWatchlist(result), nil
+}
+
+func toProtoWatchlist(result *dto.WatchlistResponse) *pb.WatchlistResponse {
+    auctions := make([]*pb.Auction, len(result.Auctions))
+    for i, a := range result.Auctions {
+        auctions[i] = MapAuctionToProto(&a)
+    }
+    return &pb.WatchlistResponse{Auctions: auctions}
+}
+
+func (h *AuctionHandler) WatchAuction(req *pb.WatchAuctionRequest, stream pb.AuctionService_WatchAuctionServer) error {
+    ctx := stream.Context()
+
+    sub, err := h.WatchAuctionUC.Execute(ctx, req.AuctionId, 0)
+    if err != nil {
+        return domainStatus(err)
+    }
+    defer sub.Close()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return nil
+        case event, ok := <-sub.Events():
+            if !ok {
+                return watchEndedError(sub.Err())
+            }
+            if err := stream.Send(MapAuctionEventToProto(dto.FromEvent(event))); err != nil {
+                return err
+            }
+            if event.Type == entity.AuctionEventEnded {
+                return nil
+            }
+        }
+    }
+}
+
+// watchEndedError explains why the bus ended a subscription.
+func watchEndedError(err error) error {
+    switch err {
+    case nil:
+        return nil
+    case eventbus.ErrSlowSubscriber:
+        return grpcStatus.Error(codes.ResourceExhausted, err.Error())
+    case eventbus.ErrClosed:
+        return grpcStatus.Error(codes.Unavailable, err.Error())
+    default:
+        return grpcStatus.Error(codes.Internal, err.Error())
+    }
+}
+
+func MapAuctionEventToProto(e *dto.AuctionEventResponse) *pb.AuctionEvent {
+    event := &pb.AuctionEvent{
+        Id:           e.ID,
+        AuctionId:    e.AuctionID,
+        Type:         string(e.Type),
+        CurrentPrice: toProtoMoney(e.CurrentPrice),
+        EndTime:      timestamppb.New(e.EndTime),
+        Status:       string(e.Status),
+        OccurredAt:   timestamppb.New(e.OccurredAt),
+    }
+    if e.WinnerID != nil {
+        event.WinnerId = *e.WinnerID
+    }
+    if e.Bid != nil {
+        event.Bid = &pb.AuctionEventBid{
+            Id:       e.Bid.ID,
+            UserId:   e.Bid.UserID,
+            Amount:   toProtoMoney(e.Bid.Amount),
+            Quantity: int32(e.Bid.Quantity),
+            IsAuto:   e.Bid.IsAuto,
+        }
+    }
+    return event
+}
+
 func MapAuctionToProto(a *dto.AuctionResponse) *pb.Auction {
     var winnerID, winnerBidID int64
     if a.WinnerID != nil {
@@ -121,12 +334,12 @@ func MapAuctionToProto(a *dto.AuctionResponse) *pb.Auction {
         winnerBidID = *a.WinnerBidID
     }
 
-    return &pb.Auction{
+    auction := &pb.Auction{
         Id:           a.ID,
         LotId:        a.LotID,
-        StartPrice:   a.StartPrice,
-        MinStep:      a.MinStep,
-        CurrentPrice: a.CurrentPrice,
+        StartPrice:   toProtoMoney(a.StartPrice),
+        MinStep:      toProtoMoney(a.MinStep),
+        CurrentPrice: toProtoMoney(a.CurrentPrice),
         StartTime:    timestamppb.New(a.StartTime),
         EndTime:      timestamppb.New(a.EndTime),
         Status:       string(a.Status),
@@ -134,5 +347,54 @@ func MapAuctionToProto(a *dto.AuctionResponse) *pb.Auction {
         WinnerBidId:  winnerBidID,
         CreatedAt:    timestamppb.New(a.CreatedAt),
         UpdatedAt:    timestamppb.New(a.UpdatedAt),
+        ExtensionWindowMinutes: int32(a.ExtensionWindow / time.Minute),
+        ExtensionMinutes:       int32(a.ExtensionDuration / time.Minute),
+        MaxExtensionMinutes:    int32(a.MaxExtension / time.Minute),
+        TotalExtensionMinutes:  int32(a.TotalExtension / time.Minute),
+        ReserveMet:             a.ReserveMet,
+        BuyNowPrice:            toProtoMoney(a.BuyNowPrice),
+        BuyNowAvailable:        a.BuyNowAvailable,
+        Type:                   string(a.Type),
+        PriceDecrement:         toProtoMoney(a.PriceDecrement),
+        DecrementIntervalMinutes: int32(a.DecrementInterval / time.Minute),
+        FloorPrice:             toProtoMoney(a.FloorPrice),
+        Quantity:               int32(a.Quantity),
+        Pricing:                string(a.Pricing),
+        Winners:                mapWinnersToProto(a.Winners),
+        Currency:               a.Currency,
+        Fees:                   mapFeesToProto(a.Fees),
+    }
+    if a.DisplayCurrentPrice != nil {
+        auction.DisplayCurrentPrice = toProtoMoney(*a.DisplayCurrentPrice)
+    }
+    if a.DisplayBuyNowPrice != nil {
+        auction.DisplayBuyNowPrice = toProtoMoney(*a.DisplayBuyNowPrice)
+    }
+    return auction
+}
+
+func mapFeesToProto(f *dto.FeesResponse) *pb.Fees {
+    if f == nil {
+        return nil
+    }
+    return &pb.Fees{
+        Hammer:         toProtoMoney(f.Hammer),
+        Commission:     toProtoMoney(f.Commission),
+        BuyerPremium:   toProtoMoney(f.BuyerPremium),
+        BuyerTotal:     toProtoMoney(f.BuyerTotal),
+        SellerProceeds: toProtoMoney(f.SellerProceeds),
+    }
+}
+
+func mapWinnersToProto(winners []dto.WinnerResponse) []*pb.AuctionWinner {
+    result := make([]*pb.AuctionWinner, len(winners))
+    for i, w := range winners {
+        result[i] = &pb.AuctionWinner{
+            UserId:    w.UserID,
+            BidId:     w.BidID,
+            Quantity:  int32(w.Quantity),
+            UnitPrice: toProtoMoney(w.UnitPrice),
+        }
     }
+    return result
 }
@@ -15,25 +15,33 @@ type BidHandler struct {
     PlaceBidUseCase bidUseCase.PlaceBidUseCaseInterface
     GetBidUseCase   bidUseCase.GetBidUseCaseInterface
     ListBidsUseCase bidUseCase.ListBidsUseCaseInterface
+    SetMaxBidUseCase bidUseCase.SetMaxBidUseCaseInterface
 }
 
 func NewBidHandler(
     placeBidUseCase bidUseCase.PlaceBidUseCaseInterface,
     getBidUseCase bidUseCase.GetBidUseCaseInterface,
     listBidsUseCase bidUseCase.ListBidsUseCaseInterface,
+    setMaxBidUseCase bidUseCase.SetMaxBidUseCaseInterface,
 ) *BidHandler {
     return &BidHandler{
-        PlaceBidUseCase: placeBidUseCase,
-        GetBidUseCase:   getBidUseCase,
-        ListBidsUseCase: listBidsUseCase,
+        PlaceBidUseCase:  placeBidUseCase,
+        GetBidUseCase:    getBidUseCase,
+        ListBidsUseCase:  listBidsUseCase,
+        SetMaxBidUseCase: setMaxBidUseCase,
     }
 }
 
 func (h *BidHandler) PlaceBid(ctx context.Context, req *pb.PlaceBidRequest) (*pb.PlaceBidResponse, error) {
+    amount, err := fromProtoMoney("amount", req.Amount)
+    if err != nil {
+        return nil, err
+    }
     placeBidReq := &bid.PlaceBidRequest{
         AuctionID: req.AuctionId,
         UserID:    req.UserId,
-        Amount:    req.Amount,
+        Amount:    amount,
+        Quantity:  int(req.Quantity),
     }
 
     result, err := h.PlaceBidUseCase.Execute(ctx, placeBidReq)
@@ -80,12 +88,44 @@ func (h *BidHandler) ListBids(ctx context.Context, req *pb.ListBidsRequest) (*pb
     }, nil
 }
 
+func (h *BidHandler) SetMaxBid(ctx context.Context, req *pb.SetMaxBidRequest) (*pb.SetMaxBidResponse, error) {
+    maxAmount, err := fromProtoMoney("max_amount", req.MaxAmount)
+    if err != nil {
+        return nil, err
+    }
+    setMaxBidReq := &bid.SetMaxBidRequest{
+        AuctionID: req.AuctionId,
+        UserID:    req.UserId,
+        MaxAmount: maxAmount,
+    }
+
+    result, err := h.SetMaxBidUseCase.Execute(ctx, setMaxBidReq)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.SetMaxBidResponse{
+        MaxBid: &pb.MaxBid{
+            Id:        result.ID,
+            AuctionId: result.AuctionID,
+            UserId:    result.UserID,
+            MaxAmount: toProtoMoney(result.MaxAmount),
+            CreatedAt: timestamppb.New(result.CreatedAt),
+            UpdatedAt: timestamppb.New(result.UpdatedAt),
+        },
+        CurrentPrice: toProtoMoney(result.CurrentPrice),
+        IsLeading:    result.IsLeading,
+    }, nil
+}
+
 func mapBidToProto(b *bid.BidResponse) *pb.Bid {
     return &pb.Bid{
         Id:        b.ID,
         AuctionId: b.AuctionID,
         UserId:    b.UserID,
-        Amount:    b.Amount,
+        Amount:    toProtoMoney(b.Amount),
+        Quantity:  int32(b.Quantity),
+        IsAuto:    b.IsAuto,
         CreatedAt: timestamppb.New(b.CreatedAt),
         UpdatedAt: timestamppb.New(b.UpdatedAt),
     }
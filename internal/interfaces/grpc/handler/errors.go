@@ -0,0 +1,31 @@
+package handler
+
+import (
+    stderrors "errors"
+    "google.golang.org/grpc/codes"
+    grpcStatus "google.golang.org/grpc/status"
+
+    "auction-system/internal/domain/errors"
+)
+
+// domainStatus turns an error from a use case into a gRPC status, so that
+// the gateway answers a missing auction with 404 and a rejected request
+// with 400 instead of 500.
+func domainStatus(err error) error {
+    var appErr *errors.AppError
+    if !stderrors.As(err, &appErr) {
+        return grpcStatus.Error(codes.Internal, err.Error())
+    }
+    switch appErr.Type {
+    case errors.ErrorTypeNotFound:
+        return grpcStatus.Error(codes.NotFound, appErr.Message)
+    case errors.ErrorTypeValidation:
+        return grpcStatus.Error(codes.InvalidArgument, appErr.Message)
+    case errors.ErrorTypeConflict:
+        return grpcStatus.Error(codes.FailedPrecondition, appErr.Message)
+    case errors.ErrorTypeUnauthorized:
+        return grpcStatus.Error(codes.PermissionDenied, appErr.Message)
+    default:
+        return grpcStatus.Error(codes.Internal, appErr.Message)
+    }
+}
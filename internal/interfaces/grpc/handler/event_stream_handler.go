@@ -0,0 +1,445 @@
+package handler
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "net/url"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+    "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+    "golang.org/x/net/websocket"
+    "google.golang.org/grpc/codes"
+    grpcStatus "google.golang.org/grpc/status"
+    "google.golang.org/protobuf/proto"
+
+    dto "auction-system/internal/application/dto/auction"
+    notificationDto "auction-system/internal/application/dto/notification"
+    "auction-system/internal/application/eventbus"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/event"
+    "auction-system/internal/domain/notification"
+    auctionUseCase "auction-system/internal/application/usecase/auction"
+    notificationUseCase "auction-system/internal/application/usecase/notification"
+)
+
+// lastEventIDHeader is sent by browsers when an EventSource reconnects.
+const lastEventIDHeader = "Last-Event-ID"
+
+// EventStreamHandler serves auction events and user notifications to
+// browsers, which cannot read gRPC streams: as Server-Sent Events for a
+// single auction, and over a WebSocket that multiplexes any number of
+// auctions and users. Payloads are the gateway's JSON encoding of the
+// AuctionEvent and Notification messages.
+//
+// The streams are not authenticated, because requests carry no user
+// identity to check a subscription against. Auction events are public, but
+// a user's notifications are not, so the notifications channel is refused
+// unless UnauthenticatedNotifications is set. Only the browser origins a
+// WebSocket may be opened from are restricted.
+type EventStreamHandler struct {
+    WatchAuctionUC       auctionUseCase.WatchAuctionUseCaseInterface
+    WatchNotificationsUC notificationUseCase.WatchNotificationsUseCaseInterface
+    // Heartbeat is how often an idle connection gets a ping, which keeps
+    // proxies from closing it and detects clients that went away.
+    Heartbeat            time.Duration
+    // AllowedOrigins are the origins, e.g. "https://shop.example.com",
+    // whose pages may open a WebSocket besides the server's own; "*"
+    // allows any. Clients that send no Origin, which browsers always do,
+    // are accepted.
+    AllowedOrigins       []string
+    // UnauthenticatedNotifications lets anyone who can reach the server
+    // watch the notifications of any user ID. It is only for deployments
+    // where every client is trusted.
+    UnauthenticatedNotifications bool
+}
+
+func NewEventStreamHandler(
+    watchAuctionUC auctionUseCase.WatchAuctionUseCaseInterface,
+    watchNotificationsUC notificationUseCase.WatchNotificationsUseCaseInterface,
+    heartbeat time.Duration,
+    allowedOrigins []string,
+    unauthenticatedNotifications bool,
+) *EventStreamHandler {
+    return &EventStreamHandler{
+        WatchAuctionUC:               watchAuctionUC,
+        WatchNotificationsUC:         watchNotificationsUC,
+        Heartbeat:                    heartbeat,
+        AllowedOrigins:               allowedOrigins,
+        UnauthenticatedNotifications: unauthenticatedNotifications,
+    }
+}
+
+// Register adds the event stream routes to the gateway's mux.
+func (h *EventStreamHandler) Register(mux *runtime.ServeMux) error {
+    if err := mux.HandlePath(http.MethodGet, "/api/v1/auctions/{id}/events", h.AuctionEvents(mux)); err != nil {
+        return err
+    }
+    return mux.HandlePath(http.MethodGet, "/api/v1/ws", h.WebSocket(mux))
+}
+
+// AuctionEvents serves GET /api/v1/auctions/{id}/events. A reconnecting
+// client passes the last event ID it received in the Last-Event-ID header
+// or the last_event_id query parameter.
+func (h *EventStreamHandler) AuctionEvents(mux *runtime.ServeMux) runtime.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+        ctx := r.Context()
+        _, marshaler := runtime.MarshalerForRequest(mux, r)
+
+        auctionID, err := strconv.ParseInt(params["id"], 10, 64)
+        if err != nil {
+            runtime.HTTPError(ctx, mux, marshaler, w, r, grpcStatus.Error(codes.InvalidArgument, "invalid auction id"))
+            return
+        }
+        lastEventID, err := requestLastEventID(r)
+        if err != nil {
+            runtime.HTTPError(ctx, mux, marshaler, w, r, grpcStatus.Error(codes.InvalidArgument, err.Error()))
+            return
+        }
+        flusher, ok := w.(http.Flusher)
+        if !ok {
+            runtime.HTTPError(ctx, mux, marshaler, w, r, grpcStatus.Error(codes.Unimplemented, "streaming is not supported"))
+            return
+        }
+
+        sub, err := h.WatchAuctionUC.Execute(ctx, auctionID, lastEventID)
+        if err != nil {
+            runtime.HTTPError(ctx, mux, marshaler, w, r, domainStatus(err))
+            return
+        }
+        defer sub.Close()
+
+        w.Header().Set("Content-Type", "text/event-stream")
+        w.Header().Set("Cache-Control", "no-cache")
+        w.Header().Set("Connection", "keep-alive")
+        w.Header().Set("X-Accel-Buffering", "no")
+        w.WriteHeader(http.StatusOK)
+        if sub.Gap() {
+            fmt.Fprint(w, "event: resync\ndata: {}\n\n")
+        }
+        flusher.Flush()
+
+        heartbeat := time.NewTicker(h.Heartbeat)
+        defer heartbeat.Stop()
+
+        for {
+            select {
+            case <-ctx.Done():
+                return
+            case <-heartbeat.C:
+                fmt.Fprint(w, ": ping\n\n")
+            case e, ok := <-sub.Events():
+                if !ok {
+                    if err := sub.Err(); err != nil {
+                        data, _ := json.Marshal(map[string]string{"message": err.Error()})
+                        fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+                        flusher.Flush()
+                    }
+                    return
+                }
+                data, err := marshaler.Marshal(MapAuctionEventToProto(dto.FromEvent(e)))
+                if err != nil {
+                    return
+                }
+                fmt.Fprintf(w, "id: %d\ndata: %s\n\n", e.ID, data)
+                if e.Type == entity.AuctionEventEnded {
+                    flusher.Flush()
+                    return
+                }
+            }
+            flusher.Flush()
+        }
+    }
+}
+
+func requestLastEventID(r *http.Request) (int64, error) {
+    value := r.Header.Get(lastEventIDHeader)
+    if value == "" {
+        value = r.URL.Query().Get("last_event_id")
+    }
+    if value == "" {
+        return 0, nil
+    }
+    id, err := strconv.ParseInt(value, 10, 64)
+    if err != nil || id < 0 {
+        return 0, fmt.Errorf("invalid last event id %q", value)
+    }
+    return id, nil
+}
+
+// Channels a WebSocket client can subscribe to. The subscription ID is the
+// auction ID or the user ID.
+const (
+    wsChannelAuction       = "auction"
+    wsChannelNotifications = "notifications"
+)
+
+// wsClientMessage is a command from a WebSocket client:
+// {"action": "subscribe", "channel": "auction", "id": 1, "last_event_id": 10}
+// or {"action": "unsubscribe", "channel": "notifications", "id": 2}.
+type wsClientMessage struct {
+    Action      string `json:"action"`
+    Channel     string `json:"channel"`
+    ID          int64  `json:"id"`
+    LastEventID int64  `json:"last_event_id"`
+}
+
+// wsServerMessage is sent to WebSocket clients. Type is "event" with the
+// payload in Data, "subscribed", "unsubscribed" (with the reason in Message
+// when the server ended it), "resync" when events were missed while
+// resuming, "error" or "ping".
+type wsServerMessage struct {
+    Type    string          `json:"type"`
+    Channel string          `json:"channel,omitempty"`
+    ID      int64           `json:"id,omitempty"`
+    EventID int64           `json:"event_id,omitempty"`
+    Data    json.RawMessage `json:"data,omitempty"`
+    Message string          `json:"message,omitempty"`
+}
+
+type wsTopic struct {
+    channel string
+    id      int64
+}
+
+// WebSocket serves GET /api/v1/ws.
+func (h *EventStreamHandler) WebSocket(mux *runtime.ServeMux) runtime.HandlerFunc {
+    return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+        _, marshaler := runtime.MarshalerForRequest(mux, r)
+        server := websocket.Server{
+            Handshake: h.checkOrigin,
+            Handler: func(conn *websocket.Conn) {
+                h.serveWebSocket(conn, marshaler)
+            },
+        }
+        server.ServeHTTP(w, r)
+    }
+}
+
+// checkOrigin refuses WebSocket handshakes from pages on other sites, which
+// browsers would otherwise let open the socket with the user's cookies.
+// A refused handshake is answered with 403.
+func (h *EventStreamHandler) checkOrigin(config *websocket.Config, r *http.Request) error {
+    origin := r.Header.Get("Origin")
+    if origin == "" {
+        return nil
+    }
+    parsed, err := url.Parse(origin)
+    if err != nil {
+        return err
+    }
+    if parsed.Host == r.Host {
+        return nil
+    }
+    for _, allowed := range h.AllowedOrigins {
+        if allowed == "*" || strings.EqualFold(allowed, origin) {
+            return nil
+        }
+    }
+    return fmt.Errorf("origin %q is not allowed", origin)
+}
+
+// wsSession is one WebSocket connection. Commands are read on the
+// connection's goroutine, each subscription forwards its events from its
+// own goroutine, and a single writer sends everything to the client.
+type wsSession struct {
+    h         *EventStreamHandler
+    conn      *websocket.Conn
+    marshaler runtime.Marshaler
+    ctx       context.Context
+    out       chan *wsServerMessage
+
+    mu   sync.Mutex
+    subs map[wsTopic]interface{ Close() }
+}
+
+func (h *EventStreamHandler) serveWebSocket(conn *websocket.Conn, marshaler runtime.Marshaler) {
+    ctx, cancel := context.WithCancel(conn.Request().Context())
+    defer cancel()
+
+    s := &wsSession{
+        h:         h,
+        conn:      conn,
+        marshaler: marshaler,
+        ctx:       ctx,
+        out:       make(chan *wsServerMessage),
+        subs:      make(map[wsTopic]interface{ Close() }),
+    }
+    defer s.closeAll()
+    go s.write(cancel)
+
+    for {
+        var data []byte
+        if err := websocket.Message.Receive(conn, &data); err != nil {
+            return
+        }
+
+        var msg wsClientMessage
+        if err := json.Unmarshal(data, &msg); err != nil {
+            s.send(&wsServerMessage{Type: "error", Message: "invalid message: " + err.Error()})
+            continue
+        }
+        s.handle(&msg)
+    }
+}
+
+// write sends queued messages and heartbeats until the session ends or the
+// client stops accepting them.
+func (s *wsSession) write(cancel context.CancelFunc) {
+    defer cancel()
+    defer s.conn.Close()
+
+    heartbeat := time.NewTicker(s.h.Heartbeat)
+    defer heartbeat.Stop()
+
+    for {
+        var msg *wsServerMessage
+        select {
+        case <-s.ctx.Done():
+            return
+        case <-heartbeat.C:
+            msg = &wsServerMessage{Type: "ping"}
+        case msg = <-s.out:
+        }
+        if err := websocket.JSON.Send(s.conn, msg); err != nil {
+            return
+        }
+    }
+}
+
+func (s *wsSession) send(msg *wsServerMessage) {
+    select {
+    case s.out <- msg:
+    case <-s.ctx.Done():
+    }
+}
+
+func (s *wsSession) handle(msg *wsClientMessage) {
+    topic := wsTopic{channel: msg.Channel, id: msg.ID}
+    reply := func(msgType, message string) {
+        s.send(&wsServerMessage{Type: msgType, Channel: topic.channel, ID: topic.id, Message: message})
+    }
+
+    switch msg.Action {
+    case "subscribe":
+        s.mu.Lock()
+        _, subscribed := s.subs[topic]
+        s.mu.Unlock()
+        if subscribed {
+            reply("error", "already subscribed")
+            return
+        }
+
+        var err error
+        switch topic.channel {
+        case wsChannelAuction:
+            err = s.subscribeAuction(topic, msg.LastEventID)
+        case wsChannelNotifications:
+            err = s.subscribeNotifications(topic, msg.LastEventID)
+        default:
+            reply("error", fmt.Sprintf("unknown channel %q", topic.channel))
+            return
+        }
+        if err != nil {
+            reply("error", err.Error())
+        }
+    case "unsubscribe":
+        s.mu.Lock()
+        sub, ok := s.subs[topic]
+        delete(s.subs, topic)
+        s.mu.Unlock()
+        if !ok {
+            reply("error", "not subscribed")
+            return
+        }
+        sub.Close()
+        reply("unsubscribed", "")
+    default:
+        reply("error", fmt.Sprintf("unknown action %q", msg.Action))
+    }
+}
+
+func (s *wsSession) subscribeAuction(topic wsTopic, lastEventID int64) error {
+    sub, err := s.h.WatchAuctionUC.Execute(s.ctx, topic.id, lastEventID)
+    if err != nil {
+        return err
+    }
+    s.started(topic, sub, sub.Gap())
+    go forward(s, topic, sub, func(e *entity.AuctionEvent) (proto.Message, bool) {
+        return MapAuctionEventToProto(dto.FromEvent(e)), e.Type == entity.AuctionEventEnded
+    })
+    return nil
+}
+
+func (s *wsSession) subscribeNotifications(topic wsTopic, lastEventID int64) error {
+    if !s.h.UnauthenticatedNotifications {
+        return fmt.Errorf("channel %q needs an authenticated user, which the API does not have", wsChannelNotifications)
+    }
+    sub, err := s.h.WatchNotificationsUC.Execute(s.ctx, topic.id, lastEventID)
+    if err != nil {
+        return err
+    }
+    s.started(topic, sub, sub.Gap())
+    go forward(s, topic, sub, func(n *notification.Notification) (proto.Message, bool) {
+        return MapNotificationToProto(notificationDto.FromEntity(n)), false
+    })
+    return nil
+}
+
+func (s *wsSession) started(topic wsTopic, sub interface{ Close() }, gap bool) {
+    s.mu.Lock()
+    s.subs[topic] = sub
+    s.mu.Unlock()
+
+    s.send(&wsServerMessage{Type: "subscribed", Channel: topic.channel, ID: topic.id})
+    if gap {
+        s.send(&wsServerMessage{Type: "resync", Channel: topic.channel, ID: topic.id})
+    }
+}
+
+// forward relays sub's events to the client until the subscription ends.
+// toProto also reports whether an event is the last one of its stream.
+func forward[E event.Event](s *wsSession, topic wsTopic, sub *eventbus.Subscription[E], toProto func(E) (proto.Message, bool)) {
+    for e := range sub.Events() {
+        msg, last := toProto(e)
+        data, err := s.marshaler.Marshal(msg)
+        if err != nil {
+            break
+        }
+        s.send(&wsServerMessage{Type: "event", Channel: topic.channel, ID: topic.id, EventID: e.EventID(), Data: data})
+        if last {
+            break
+        }
+    }
+
+    // The stream ended on its own rather than by an unsubscribe command.
+    s.mu.Lock()
+    current, ok := s.subs[topic]
+    ended := ok && current == interface{ Close() }(sub)
+    if ended {
+        delete(s.subs, topic)
+    }
+    s.mu.Unlock()
+    if !ended {
+        return
+    }
+
+    sub.Close()
+    reason := "stream ended"
+    if err := sub.Err(); err != nil {
+        reason = err.Error()
+    }
+    s.send(&wsServerMessage{Type: "unsubscribed", Channel: topic.channel, ID: topic.id, Message: reason})
+}
+
+func (s *wsSession) closeAll() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    for topic, sub := range s.subs {
+        sub.Close()
+        delete(s.subs, topic)
+    }
+}
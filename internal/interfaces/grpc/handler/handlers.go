@@ -5,6 +5,7 @@ import (
     "fmt"
     "net"
     "net/http"
+    "strings"
     "auction-system/internal/config"
     "auction-system/pkg/api"
     "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -12,31 +13,65 @@ import (
 )
 
 type Handlers struct {
-    userHandler    *UserHandler
-    auctionHandler *AuctionHandler
-    lotHandler     *LotHandler
-    bidHandler     *BidHandler
-    grpcServer     *grpc.Server
-    httpServer     *http.Server
+    userHandler       *UserHandler
+    auctionHandler    *AuctionHandler
+    lotHandler        *LotHandler
+    bidHandler        *BidHandler
+    invoiceHandler    *InvoiceHandler
+    withdrawalHandler *WithdrawalHandler
+    notificationHandler *NotificationHandler
+    webhookHandler    *WebhookHandler
+    eventStream       *EventStreamHandler
+    idempotency       *IdempotencyInterceptor
+    adminAuth         *AdminAuthInterceptor
+    grpcServer        *grpc.Server
+    httpServer        *http.Server
 }
 
-func NewHandlers(userHandler *UserHandler, auctionHandler *AuctionHandler, lotHandler *LotHandler, bidHandler *BidHandler) *Handlers {
+// contentDispositionHeader is passed from gRPC response metadata to HTTP
+// responses as is, so downloads keep their file names.
+const contentDispositionHeader = "content-disposition"
+
+func NewHandlers(
+    userHandler *UserHandler,
+    auctionHandler *AuctionHandler,
+    lotHandler *LotHandler,
+    bidHandler *BidHandler,
+    invoiceHandler *InvoiceHandler,
+    withdrawalHandler *WithdrawalHandler,
+    notificationHandler *NotificationHandler,
+    webhookHandler *WebhookHandler,
+    eventStream *EventStreamHandler,
+    idempotency *IdempotencyInterceptor,
+    adminAuth *AdminAuthInterceptor,
+) *Handlers {
     return &Handlers{
-        userHandler:    userHandler,
-        auctionHandler: auctionHandler,
-        lotHandler:     lotHandler,
-        bidHandler:     bidHandler,
+        userHandler:       userHandler,
+        auctionHandler:    auctionHandler,
+        lotHandler:        lotHandler,
+        bidHandler:        bidHandler,
+        invoiceHandler:    invoiceHandler,
+        withdrawalHandler: withdrawalHandler,
+        notificationHandler: notificationHandler,
+        webhookHandler:    webhookHandler,
+        eventStream:       eventStream,
+        idempotency:       idempotency,
+        adminAuth:         adminAuth,
     }
 }
 
 func (h *Handlers) Serve(ctx context.Context, cfg *config.Config) error {
-    grpcServer := grpc.NewServer()
+    grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(h.adminAuth.Unary(), h.idempotency.Unary()))
     h.grpcServer = grpcServer
 
     api.RegisterUserServiceServer(grpcServer, h.userHandler)
     api.RegisterAuctionServiceServer(grpcServer, h.auctionHandler)
     api.RegisterLotServiceServer(grpcServer, h.lotHandler)
     api.RegisterBidServiceServer(grpcServer, h.bidHandler)
+    api.RegisterInvoiceServiceServer(grpcServer, h.invoiceHandler)
+    api.RegisterWithdrawalServiceServer(grpcServer, h.withdrawalHandler)
+    api.RegisterNotificationServiceServer(grpcServer, h.notificationHandler)
+    api.RegisterWebhookServiceServer(grpcServer, h.webhookHandler)
 
     grpcListener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port))
     if err != nil {
@@ -49,7 +84,20 @@ func (h *Handlers) Serve(ctx context.Context, cfg *config.Config) error {
         }
     }()
 
-    mux := runtime.NewServeMux()
+    mux := runtime.NewServeMux(
+        runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+            if strings.ToLower(key) == idempotencyKeyHeader {
+                return idempotencyKeyHeader, true
+            }
+            return runtime.DefaultHeaderMatcher(key)
+        }),
+        runtime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+            if strings.ToLower(key) == contentDispositionHeader {
+                return "Content-Disposition", true
+            }
+            return runtime.MetadataHeaderPrefix + key, true
+        }),
+    )
     opts := []grpc.DialOption{grpc.WithInsecure()}
 
     if err := api.RegisterUserServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port), opts); err != nil {
@@ -64,6 +112,21 @@ func (h *Handlers) Serve(ctx context.Context, cfg *config.Config) error {
     if err := api.RegisterBidServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port), opts); err != nil {
         return fmt.Errorf("failed to register bid service handler: %v", err)
     }
+    if err := api.RegisterInvoiceServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port), opts); err != nil {
+        return fmt.Errorf("failed to register invoice service handler: %v", err)
+    }
+    if err := api.RegisterWithdrawalServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port), opts); err != nil {
+        return fmt.Errorf("failed to register withdrawal service handler: %v", err)
+    }
+    if err := api.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port), opts); err != nil {
+        return fmt.Errorf("failed to register notification service handler: %v", err)
+    }
+    if err := api.RegisterWebhookServiceHandlerFromEndpoint(ctx, mux, fmt.Sprintf("%s:%d", cfg.GRPC.Host, cfg.GRPC.Port), opts); err != nil {
+        return fmt.Errorf("failed to register webhook service handler: %v", err)
+    }
+    if err := h.eventStream.Register(mux); err != nil {
+        return fmt.Errorf("failed to register event stream handler: %v", err)
+    }
 
     h.httpServer = &http.Server{
         Addr:    fmt.Sprintf("%s:%d", cfg.HTTP.Host, cfg.HTTP.Port),
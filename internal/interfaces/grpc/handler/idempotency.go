@@ -0,0 +1,160 @@
+package handler
+
+import (
+    "context"
+    "log"
+    spb "google.golang.org/genproto/googleapis/rpc/status"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/metadata"
+    "google.golang.org/grpc/status"
+    "google.golang.org/protobuf/proto"
+    "google.golang.org/protobuf/types/known/anypb"
+
+    "auction-system/internal/application/idempotency"
+)
+
+// idempotencyKeyHeader carries the idempotency key in gRPC metadata. The
+// HTTP gateway fills it from the Idempotency-Key header.
+const idempotencyKeyHeader = "idempotency-key"
+
+// IdempotencyInterceptor makes the configured unary methods idempotent for
+// callers that send an idempotency key: a retried request gets the
+// response of the first one, and reusing a key for a different request
+// fails. Keys belong to the caller, so users cannot collide with or
+// replay each other's requests. Requests without a key are passed through.
+type IdempotencyInterceptor struct {
+    keys    *idempotency.Service
+    methods map[string]bool
+}
+
+// NewIdempotencyInterceptor applies to the given full method names, e.g.
+// api.BidService_PlaceBid_FullMethodName.
+func NewIdempotencyInterceptor(keys *idempotency.Service, methods ...string) *IdempotencyInterceptor {
+    i := &IdempotencyInterceptor{
+        keys:    keys,
+        methods: make(map[string]bool, len(methods)),
+    }
+    for _, method := range methods {
+        i.methods[method] = true
+    }
+    return i
+}
+
+func (i *IdempotencyInterceptor) Unary() grpc.UnaryServerInterceptor {
+    return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+        if !i.methods[info.FullMethod] {
+            return handler(ctx, req)
+        }
+        key := idempotencyKey(ctx)
+        if key == "" {
+            return handler(ctx, req)
+        }
+
+        message, ok := req.(proto.Message)
+        if !ok {
+            return handler(ctx, req)
+        }
+        request, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+        if err != nil {
+            return nil, status.Error(codes.Internal, err.Error())
+        }
+
+        userID := caller(req)
+        stored, err := i.keys.Begin(ctx, userID, key, info.FullMethod, idempotency.HashRequest(request))
+        switch {
+        case err == idempotency.ErrInvalidKey:
+            return nil, status.Error(codes.InvalidArgument, err.Error())
+        case err == idempotency.ErrKeyReused:
+            return nil, status.Error(codes.FailedPrecondition, err.Error())
+        case err == idempotency.ErrInProgress:
+            return nil, status.Error(codes.Aborted, err.Error())
+        case err != nil:
+            return nil, status.Error(codes.Internal, err.Error())
+        case stored != nil:
+            return replay(stored)
+        }
+
+        resp, err := handler(ctx, req)
+        if err != nil {
+            if releaseErr := i.keys.Release(ctx, userID, key, info.FullMethod); releaseErr != nil {
+                log.Printf("Error releasing idempotency key %q: %v", key, releaseErr)
+            }
+            return nil, err
+        }
+
+        if err := i.save(ctx, userID, key, info.FullMethod, resp); err != nil {
+            log.Printf("Error saving response for idempotency key %q: %v", key, err)
+            i.abandon(ctx, userID, key, info.FullMethod)
+        }
+        return resp, nil
+    }
+}
+
+// abandon settles a key whose request went through but whose response
+// could not be saved. Its retries get an error rather than repeating the
+// request; if even that cannot be recorded, the key is released so it does
+// not stay in progress until it expires.
+func (i *IdempotencyInterceptor) abandon(ctx context.Context, userID int64, key, method string) {
+    lost := status.New(codes.DataLoss, "the request was carried out but its response was lost; do not repeat it").Proto()
+    err := i.save(ctx, userID, key, method, lost)
+    if err == nil {
+        return
+    }
+    log.Printf("Error recording lost response for idempotency key %q: %v", key, err)
+    if err := i.keys.Release(ctx, userID, key, method); err != nil {
+        log.Printf("Error releasing idempotency key %q: %v", key, err)
+    }
+}
+
+func (i *IdempotencyInterceptor) save(ctx context.Context, userID int64, key, method string, resp any) error {
+    message, ok := resp.(proto.Message)
+    if !ok {
+        return status.Errorf(codes.Internal, "response %T is not a protobuf message", resp)
+    }
+    wrapped, err := anypb.New(message)
+    if err != nil {
+        return err
+    }
+    response, err := proto.Marshal(wrapped)
+    if err != nil {
+        return err
+    }
+    return i.keys.Complete(ctx, userID, key, method, response)
+}
+
+func replay(stored []byte) (any, error) {
+    wrapped := &anypb.Any{}
+    if err := proto.Unmarshal(stored, wrapped); err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    resp, err := wrapped.UnmarshalNew()
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    if failure, ok := resp.(*spb.Status); ok {
+        return nil, status.ErrorProto(failure)
+    }
+    return resp, nil
+}
+
+// caller identifies the user a request is made by. Requests are not
+// authenticated yet, so this is the user the request names.
+func caller(req any) int64 {
+    if r, ok := req.(interface{ GetUserId() int64 }); ok {
+        return r.GetUserId()
+    }
+    return 0
+}
+
+func idempotencyKey(ctx context.Context) string {
+    md, ok := metadata.FromIncomingContext(ctx)
+    if !ok {
+        return ""
+    }
+    values := md.Get(idempotencyKeyHeader)
+    if len(values) == 0 {
+        return ""
+    }
+    return values[0]
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+    "context"
+    "mime"
+    "google.golang.org/genproto/googleapis/api/httpbody"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/metadata"
+    "google.golang.org/grpc/status"
+    "google.golang.org/protobuf/types/known/timestamppb"
+
+    pb "auction-system/pkg/api"
+    dto "auction-system/internal/application/dto/invoice"
+    invoiceUseCase "auction-system/internal/application/usecase/invoice"
+)
+
+type InvoiceHandler struct {
+    pb.UnimplementedInvoiceServiceServer
+    GetInvoiceUC      invoiceUseCase.GetInvoiceUseCaseInterface
+    ListInvoicesUC    invoiceUseCase.ListInvoicesUseCaseInterface
+    DownloadInvoiceUC invoiceUseCase.DownloadInvoiceUseCaseInterface
+}
+
+func NewInvoiceHandler(
+    getInvoiceUC invoiceUseCase.GetInvoiceUseCaseInterface,
+    listInvoicesUC invoiceUseCase.ListInvoicesUseCaseInterface,
+    downloadInvoiceUC invoiceUseCase.DownloadInvoiceUseCaseInterface,
+) *InvoiceHandler {
+    return &InvoiceHandler{
+        GetInvoiceUC:      getInvoiceUC,
+        ListInvoicesUC:    listInvoicesUC,
+        DownloadInvoiceUC: downloadInvoiceUC,
+    }
+}
+
+func (h *InvoiceHandler) ListInvoices(ctx context.Context, req *pb.ListInvoicesRequest) (*pb.ListInvoicesResponse, error) {
+    resp, err := h.ListInvoicesUC.Execute(ctx, &dto.ListInvoicesRequest{
+        UserID:     req.UserId,
+        PageSize:   int(req.PageSize),
+        PageNumber: int(req.PageNumber),
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    invoices := make([]*pb.Invoice, len(resp.Invoices))
+    for i := range resp.Invoices {
+        invoices[i] = toProtoInvoice(&resp.Invoices[i])
+    }
+
+    return &pb.ListInvoicesResponse{
+        Invoices:   invoices,
+        TotalCount: resp.TotalCount,
+    }, nil
+}
+
+func (h *InvoiceHandler) GetInvoice(ctx context.Context, req *pb.GetInvoiceRequest) (*pb.GetInvoiceResponse, error) {
+    resp, err := h.GetInvoiceUC.Execute(ctx, &dto.GetInvoiceRequest{
+        InvoiceID: req.InvoiceId,
+        UserID:    req.UserId,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.GetInvoiceResponse{
+        Invoice: toProtoInvoice(resp),
+    }, nil
+}
+
+// DownloadInvoice also sends the file name in a Content-Disposition header,
+// which the HTTP gateway passes on.
+func (h *InvoiceHandler) DownloadInvoice(ctx context.Context, req *pb.DownloadInvoiceRequest) (*httpbody.HttpBody, error) {
+    resp, err := h.DownloadInvoiceUC.Execute(ctx, &dto.DownloadInvoiceRequest{
+        InvoiceID: req.InvoiceId,
+        UserID:    req.UserId,
+        Format:    req.Format,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    disposition := mime.FormatMediaType("attachment", map[string]string{"filename": resp.Filename})
+    // Outside a gRPC call, e.g. in tests, there is no header to set.
+    _ = grpc.SetHeader(ctx, metadata.Pairs(contentDispositionHeader, disposition))
+
+    return &httpbody.HttpBody{
+        ContentType: resp.ContentType,
+        Data:        resp.Content,
+    }, nil
+}
+
+func toProtoInvoice(i *dto.InvoiceResponse) *pb.Invoice {
+    return &pb.Invoice{
+        Id:           i.ID,
+        Number:       i.Number,
+        AuctionId:    i.AuctionID,
+        PaymentId:    i.PaymentID,
+        LotId:        i.LotID,
+        LotTitle:     i.LotTitle,
+        BuyerId:      i.BuyerID,
+        BuyerName:    i.BuyerName,
+        BuyerEmail:   i.BuyerEmail,
+        SellerId:     i.SellerID,
+        SellerName:   i.SellerName,
+        SellerEmail:  i.SellerEmail,
+        Currency:     i.Currency,
+        Hammer:       toProtoMoney(i.Hammer),
+        BuyerPremium: toProtoMoney(i.BuyerPremium),
+        Commission:   toProtoMoney(i.Commission),
+        TaxRate:      i.TaxRate,
+        Tax:          toProtoMoney(i.Tax),
+        Total:        toProtoMoney(i.Total),
+        IssuedAt:     timestamppb.New(i.IssuedAt),
+    }
+}
@@ -37,10 +37,16 @@ func NewLotHandler(
 }
 
 func (h *LotHandler) CreateLot(ctx context.Context, req *pb.CreateLotRequest) (*pb.CreateLotResponse, error) {
+    startPrice, err := fromProtoMoney("start_price", req.StartPrice)
+    if err != nil {
+        return nil, err
+    }
     createReq := &lot.CreateLotRequest{
         Title:       req.Title,
         Description: req.Description,
-        StartPrice:  req.StartPrice,
+        StartPrice:  startPrice,
+        Quantity:    int(req.Quantity),
+        Category:    req.Category,
         CreatorID:   req.CreatorId,
     }
 
@@ -66,10 +72,15 @@ func (h *LotHandler) GetLot(ctx context.Context, req *pb.GetLotRequest) (*pb.Get
 }
 
 func (h *LotHandler) UpdateLot(ctx context.Context, req *pb.UpdateLotRequest) (*pb.UpdateLotResponse, error) {
+    startPrice, err := fromProtoMoney("start_price", req.StartPrice)
+    if err != nil {
+        return nil, err
+    }
     updateReq := &lot.UpdateLotRequest{
         Title:       req.Title,
         Description: req.Description,
-        StartPrice:  req.StartPrice,
+        StartPrice:  startPrice,
+        Category:    req.Category,
     }
 
     lotResp, err := h.UpdateLotUC.Execute(ctx, req.Id, updateReq)
@@ -115,7 +126,10 @@ func toLotProto(lot *lot.LotResponse) *pb.Lot {
         Id:          lot.ID,
         Title:       lot.Title,
         Description: lot.Description,
-        StartPrice:  lot.StartPrice,
+        StartPrice:  toProtoMoney(lot.StartPrice),
+        Quantity:    int32(lot.Quantity),
+        Currency:    lot.Currency,
+        Category:    lot.Category,
         CreatorId:   lot.CreatorID,
         CreatedAt:   timestamppb.New(lot.CreatedAt),
         UpdatedAt:   timestamppb.New(lot.UpdatedAt),
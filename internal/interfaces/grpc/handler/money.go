@@ -0,0 +1,51 @@
+package handler
+
+import (
+    "fmt"
+    "google.golang.org/grpc/codes"
+    grpcStatus "google.golang.org/grpc/status"
+
+    "auction-system/internal/domain/entity"
+    pb "auction-system/pkg/api"
+)
+
+func toProtoMoney(m entity.Money) *pb.Money {
+    currency := m.Currency
+    if currency == "" {
+        currency = entity.DefaultCurrency
+    }
+    units, nanos := m.Units()
+    return &pb.Money{CurrencyCode: currency, Units: units, Nanos: nanos}
+}
+
+// fromProtoMoney treats a missing amount as zero and a missing currency as
+// the default one. An amount that is not valid money is an InvalidArgument
+// status naming field.
+func fromProtoMoney(field string, m *pb.Money) (entity.Money, error) {
+    if m == nil {
+        return entity.NewMoney(0, entity.DefaultCurrency), nil
+    }
+    currency := m.CurrencyCode
+    if currency == "" {
+        currency = entity.DefaultCurrency
+    }
+    money, err := entity.MoneyFromUnits(m.Units, m.Nanos, currency)
+    if err != nil {
+        return entity.Money{}, grpcStatus.Error(codes.InvalidArgument, fmt.Sprintf("%s: %s", field, err.Error()))
+    }
+    return money, nil
+}
+
+// moneyFields reads the amounts of a request with several of them, keeping
+// the first invalid one so the handler checks once.
+type moneyFields struct {
+    err error
+}
+
+func (f *moneyFields) read(field string, m *pb.Money) entity.Money {
+    money, err := fromProtoMoney(field, m)
+    if err != nil && f.err == nil {
+        f.err = err
+    }
+    return money
+}
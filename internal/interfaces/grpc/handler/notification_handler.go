@@ -0,0 +1,166 @@
+package handler
+
+import (
+    "context"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "google.golang.org/protobuf/types/known/timestamppb"
+
+    pb "auction-system/pkg/api"
+    dto "auction-system/internal/application/dto/notification"
+    notificationUseCase "auction-system/internal/application/usecase/notification"
+)
+
+type NotificationHandler struct {
+    pb.UnimplementedNotificationServiceServer
+    ListNotificationsUC notificationUseCase.ListNotificationsUseCaseInterface
+    GetUnreadCountUC    notificationUseCase.GetUnreadCountUseCaseInterface
+    MarkReadUC          notificationUseCase.MarkReadUseCaseInterface
+    MarkAllReadUC       notificationUseCase.MarkAllReadUseCaseInterface
+    GetPreferencesUC    notificationUseCase.GetPreferencesUseCaseInterface
+    UpdatePreferencesUC notificationUseCase.UpdatePreferencesUseCaseInterface
+}
+
+func NewNotificationHandler(
+    listNotificationsUC notificationUseCase.ListNotificationsUseCaseInterface,
+    getUnreadCountUC notificationUseCase.GetUnreadCountUseCaseInterface,
+    markReadUC notificationUseCase.MarkReadUseCaseInterface,
+    markAllReadUC notificationUseCase.MarkAllReadUseCaseInterface,
+    getPreferencesUC notificationUseCase.GetPreferencesUseCaseInterface,
+    updatePreferencesUC notificationUseCase.UpdatePreferencesUseCaseInterface,
+) *NotificationHandler {
+    return &NotificationHandler{
+        ListNotificationsUC: listNotificationsUC,
+        GetUnreadCountUC:    getUnreadCountUC,
+        MarkReadUC:          markReadUC,
+        MarkAllReadUC:       markAllReadUC,
+        GetPreferencesUC:    getPreferencesUC,
+        UpdatePreferencesUC: updatePreferencesUC,
+    }
+}
+
+func (h *NotificationHandler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+    resp, err := h.ListNotificationsUC.Execute(ctx, &dto.ListNotificationsRequest{
+        UserID:     req.UserId,
+        PageSize:   int(req.PageSize),
+        PageNumber: int(req.PageNumber),
+        UnreadOnly: req.UnreadOnly,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    notifications := make([]*pb.Notification, len(resp.Notifications))
+    for i := range resp.Notifications {
+        notifications[i] = MapNotificationToProto(&resp.Notifications[i])
+    }
+
+    return &pb.ListNotificationsResponse{
+        Notifications: notifications,
+        TotalCount:    resp.TotalCount,
+        UnreadCount:   resp.UnreadCount,
+    }, nil
+}
+
+func (h *NotificationHandler) GetUnreadCount(ctx context.Context, req *pb.GetUnreadCountRequest) (*pb.UnreadCountResponse, error) {
+    unread, err := h.GetUnreadCountUC.Execute(ctx, req.UserId)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.UnreadCountResponse{UnreadCount: unread}, nil
+}
+
+func (h *NotificationHandler) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.MarkReadResponse, error) {
+    resp, err := h.MarkReadUC.Execute(ctx, &dto.MarkReadRequest{
+        UserID: req.UserId,
+        IDs:    req.Ids,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return toProtoMarkRead(resp), nil
+}
+
+func (h *NotificationHandler) MarkAllRead(ctx context.Context, req *pb.MarkAllReadRequest) (*pb.MarkReadResponse, error) {
+    resp, err := h.MarkAllReadUC.Execute(ctx, req.UserId)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return toProtoMarkRead(resp), nil
+}
+
+func (h *NotificationHandler) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.NotificationPreferencesResponse, error) {
+    resp, err := h.GetPreferencesUC.Execute(ctx, req.UserId)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.NotificationPreferencesResponse{Preferences: toProtoPreferences(resp)}, nil
+}
+
+func (h *NotificationHandler) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.NotificationPreferencesResponse, error) {
+    update := &dto.UpdatePreferencesRequest{
+        UserID:   req.UserId,
+        Channels: make([]dto.NotificationChannels, len(req.Channels)),
+        TimeZone: req.TimeZone,
+        Digest:   req.Digest,
+        FollowedCategories: req.FollowedCategories,
+    }
+    for i, c := range req.Channels {
+        update.Channels[i] = dto.NotificationChannels{Type: c.Type, Channels: c.Channels}
+    }
+    if req.QuietHours != nil {
+        update.QuietHours = &dto.QuietHours{Start: req.QuietHours.Start, End: req.QuietHours.End}
+    }
+
+    resp, err := h.UpdatePreferencesUC.Execute(ctx, update)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.NotificationPreferencesResponse{Preferences: toProtoPreferences(resp)}, nil
+}
+
+func toProtoPreferences(p *dto.PreferencesResponse) *pb.NotificationPreferences {
+    preferences := &pb.NotificationPreferences{
+        UserId:   p.UserID,
+        Channels: make([]*pb.NotificationChannels, len(p.Channels)),
+        TimeZone: p.TimeZone,
+        Digest:   p.Digest,
+        FollowedCategories: p.FollowedCategories,
+    }
+    for i, c := range p.Channels {
+        preferences.Channels[i] = &pb.NotificationChannels{Type: c.Type, Channels: c.Channels}
+    }
+    if p.QuietHours != nil {
+        preferences.QuietHours = &pb.QuietHours{Start: p.QuietHours.Start, End: p.QuietHours.End}
+    }
+    if !p.UpdatedAt.IsZero() {
+        preferences.UpdatedAt = timestamppb.New(p.UpdatedAt)
+    }
+    return preferences
+}
+
+func toProtoMarkRead(resp *dto.MarkReadResponse) *pb.MarkReadResponse {
+    return &pb.MarkReadResponse{
+        MarkedCount: resp.MarkedCount,
+        UnreadCount: resp.UnreadCount,
+    }
+}
+
+func MapNotificationToProto(n *dto.NotificationResponse) *pb.Notification {
+    notification := &pb.Notification{
+        Id:        n.ID,
+        UserId:    n.UserID,
+        Type:      string(n.Type),
+        Message:   n.Message,
+        CreatedAt: timestamppb.New(n.CreatedAt),
+    }
+    if n.ReadAt != nil {
+        notification.ReadAt = timestamppb.New(*n.ReadAt)
+    }
+    return notification
+}
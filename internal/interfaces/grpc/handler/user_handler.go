@@ -19,6 +19,7 @@ type UserHandler struct {
     DeleteUserUC     userUseCase.DeleteUserUseCaseInterface
     GetAllUsersUC    userUseCase.GetAllUsersUseCaseInterface
     UpdateBalanceUC  userUseCase.UpdateBalanceUseCaseInterface
+    ListTransactionsUC userUseCase.ListTransactionsUseCaseInterface
 }
 
 func NewUserHandler(
@@ -28,6 +29,7 @@ func NewUserHandler(
     deleteUserUC *userUseCase.DeleteUserUseCase,
     getAllUsersUC *userUseCase.GetAllUserUseCase,
     updateBalanceUC *userUseCase.UpdateBalanceUseCase,
+    listTransactionsUC *userUseCase.ListTransactionsUseCase,
 ) *UserHandler {
     return &UserHandler{
         CreateUserUC:    createUserUC,
@@ -36,6 +38,7 @@ func NewUserHandler(
         DeleteUserUC:    deleteUserUC,
         GetAllUsersUC:   getAllUsersUC,
         UpdateBalanceUC: updateBalanceUC,
+        ListTransactionsUC: listTransactionsUC,
     }
 }
 
@@ -109,12 +112,16 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 }
 
 func (h *UserHandler) UpdateBalance(ctx context.Context, req *pb.UpdateBalanceRequest) (*pb.UpdateBalanceResponse, error) {
+    amount, err := fromProtoMoney("amount", req.Amount)
+    if err != nil {
+        return nil, err
+    }
     input := userUseCase.UpdateBalanceInput{
         UserID: req.UserId,
-        Amount: req.Amount,
+        Amount: amount,
     }
     
-    err := h.UpdateBalanceUC.Execute(ctx, input)
+    err = h.UpdateBalanceUC.Execute(ctx, input)
     if err != nil {
         return nil, status.Error(codes.Internal, err.Error())
     }
@@ -129,13 +136,66 @@ func (h *UserHandler) UpdateBalance(ctx context.Context, req *pb.UpdateBalanceRe
     }, nil
 }
 
+func (h *UserHandler) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+    listReq := &user.ListTransactionsRequest{
+        UserID:     req.UserId,
+        PageSize:   int(req.PageSize),
+        PageNumber: int(req.PageNumber),
+        Currency:   req.Currency,
+    }
+
+    resp, err := h.ListTransactionsUC.Execute(ctx, listReq)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    transactions := make([]*pb.Transaction, 0, len(resp.Transactions))
+    for _, t := range resp.Transactions {
+        transactions = append(transactions, toProtoTransaction(&t))
+    }
+
+    return &pb.ListTransactionsResponse{
+        Transactions: transactions,
+        TotalCount:   resp.TotalCount,
+        Balance:      toProtoMoney(resp.Balance),
+    }, nil
+}
+
+func toProtoTransaction(t *user.TransactionResponse) *pb.Transaction {
+    transaction := &pb.Transaction{
+        Id:           t.ID,
+        Kind:         t.Kind,
+        Description:  t.Description,
+        Amount:       toProtoMoney(t.Amount),
+        BalanceAfter: toProtoMoney(t.BalanceAfter),
+        CreatedAt:    timestamppb.New(t.CreatedAt),
+    }
+    if t.AuctionID != nil {
+        transaction.AuctionId = *t.AuctionID
+    }
+    return transaction
+}
+
 func toProtoUser(u *user.UserResponse) *pb.User {
-    return &pb.User{
+    result := &pb.User{
         Id:        u.ID,
         Username:  u.Username,
         Email:     u.Email,
-        Balance:   u.Balance,
+        Wallets:   make([]*pb.Wallet, len(u.Wallets)),
         CreatedAt: timestamppb.New(u.CreatedAt),
         UpdatedAt: timestamppb.New(u.CreatedAt),
     }
+    for i := range u.Wallets {
+        result.Wallets[i] = toProtoWallet(&u.Wallets[i])
+    }
+    return result
+}
+
+func toProtoWallet(w *user.WalletResponse) *pb.Wallet {
+    return &pb.Wallet{
+        Currency:         w.Currency,
+        Balance:          toProtoMoney(w.Balance),
+        HeldBalance:      toProtoMoney(w.HeldBalance),
+        AvailableBalance: toProtoMoney(w.AvailableBalance),
+    }
 }
@@ -0,0 +1,145 @@
+package handler
+
+import (
+    "context"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "google.golang.org/protobuf/types/known/timestamppb"
+
+    pb "auction-system/pkg/api"
+    dto "auction-system/internal/application/dto/webhook"
+    webhookUseCase "auction-system/internal/application/usecase/webhook"
+)
+
+type WebhookHandler struct {
+    pb.UnimplementedWebhookServiceServer
+    CreateWebhookUC         webhookUseCase.CreateWebhookUseCaseInterface
+    ListWebhooksUC          webhookUseCase.ListWebhooksUseCaseInterface
+    DeleteWebhookUC         webhookUseCase.DeleteWebhookUseCaseInterface
+    ListWebhookDeliveriesUC webhookUseCase.ListWebhookDeliveriesUseCaseInterface
+    RedeliverWebhookUC      webhookUseCase.RedeliverWebhookUseCaseInterface
+}
+
+func NewWebhookHandler(
+    createWebhookUC webhookUseCase.CreateWebhookUseCaseInterface,
+    listWebhooksUC webhookUseCase.ListWebhooksUseCaseInterface,
+    deleteWebhookUC webhookUseCase.DeleteWebhookUseCaseInterface,
+    listWebhookDeliveriesUC webhookUseCase.ListWebhookDeliveriesUseCaseInterface,
+    redeliverWebhookUC webhookUseCase.RedeliverWebhookUseCaseInterface,
+) *WebhookHandler {
+    return &WebhookHandler{
+        CreateWebhookUC:         createWebhookUC,
+        ListWebhooksUC:          listWebhooksUC,
+        DeleteWebhookUC:         deleteWebhookUC,
+        ListWebhookDeliveriesUC: listWebhookDeliveriesUC,
+        RedeliverWebhookUC:      redeliverWebhookUC,
+    }
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.WebhookResponse, error) {
+    resp, err := h.CreateWebhookUC.Execute(ctx, &dto.CreateWebhookRequest{
+        UserID:     req.UserId,
+        URL:        req.Url,
+        EventTypes: req.EventTypes,
+        Secret:     req.Secret,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.WebhookResponse{Webhook: toProtoWebhook(resp)}, nil
+}
+
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+    resp, err := h.ListWebhooksUC.Execute(ctx, req.UserId)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    webhooks := make([]*pb.Webhook, len(resp.Webhooks))
+    for i := range resp.Webhooks {
+        webhooks[i] = toProtoWebhook(&resp.Webhooks[i])
+    }
+
+    return &pb.ListWebhooksResponse{Webhooks: webhooks}, nil
+}
+
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+    err := h.DeleteWebhookUC.Execute(ctx, &dto.DeleteWebhookRequest{
+        UserID:    req.UserId,
+        WebhookID: req.WebhookId,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.DeleteWebhookResponse{Success: true}, nil
+}
+
+func (h *WebhookHandler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+    resp, err := h.ListWebhookDeliveriesUC.Execute(ctx, &dto.ListWebhookDeliveriesRequest{
+        UserID:     req.UserId,
+        WebhookID:  req.WebhookId,
+        PageSize:   int(req.PageSize),
+        PageNumber: int(req.PageNumber),
+        Status:     req.Status,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    deliveries := make([]*pb.WebhookDelivery, len(resp.Deliveries))
+    for i := range resp.Deliveries {
+        deliveries[i] = toProtoWebhookDelivery(&resp.Deliveries[i])
+    }
+
+    return &pb.ListWebhookDeliveriesResponse{
+        Deliveries: deliveries,
+        TotalCount: resp.TotalCount,
+    }, nil
+}
+
+func (h *WebhookHandler) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.WebhookDeliveryResponse, error) {
+    resp, err := h.RedeliverWebhookUC.Execute(ctx, &dto.RedeliverWebhookRequest{
+        UserID:     req.UserId,
+        WebhookID:  req.WebhookId,
+        DeliveryID: req.DeliveryId,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.WebhookDeliveryResponse{Delivery: toProtoWebhookDelivery(resp)}, nil
+}
+
+func toProtoWebhook(w *dto.WebhookResponse) *pb.Webhook {
+    return &pb.Webhook{
+        Id:         w.ID,
+        UserId:     w.UserID,
+        Url:        w.URL,
+        EventTypes: w.EventTypes,
+        Secret:     w.Secret,
+        CreatedAt:  timestamppb.New(w.CreatedAt),
+    }
+}
+
+func toProtoWebhookDelivery(d *dto.WebhookDeliveryResponse) *pb.WebhookDelivery {
+    delivery := &pb.WebhookDelivery{
+        Id:             d.ID,
+        WebhookId:      d.WebhookID,
+        EventId:        d.EventID,
+        EventType:      d.EventType,
+        Payload:        d.Payload,
+        Status:         d.Status,
+        Attempts:       int32(d.Attempts),
+        NextAttemptAt:  timestamppb.New(d.NextAttemptAt),
+        LastStatusCode: int32(d.LastStatusCode),
+        LastError:      d.LastError,
+        CreatedAt:      timestamppb.New(d.CreatedAt),
+        UpdatedAt:      timestamppb.New(d.UpdatedAt),
+    }
+    if d.DeliveredAt != nil {
+        delivery.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+    }
+    return delivery
+}
@@ -0,0 +1,139 @@
+package handler
+
+import (
+    "context"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "google.golang.org/protobuf/types/known/timestamppb"
+
+    pb "auction-system/pkg/api"
+    dto "auction-system/internal/application/dto/withdrawal"
+    withdrawalUseCase "auction-system/internal/application/usecase/withdrawal"
+)
+
+type WithdrawalHandler struct {
+    pb.UnimplementedWithdrawalServiceServer
+    RequestWithdrawalUC withdrawalUseCase.RequestWithdrawalUseCaseInterface
+    GetWithdrawalUC     withdrawalUseCase.GetWithdrawalUseCaseInterface
+    ListWithdrawalsUC   withdrawalUseCase.ListWithdrawalsUseCaseInterface
+    ApproveWithdrawalUC withdrawalUseCase.ApproveWithdrawalUseCaseInterface
+    RejectWithdrawalUC  withdrawalUseCase.RejectWithdrawalUseCaseInterface
+}
+
+func NewWithdrawalHandler(
+    requestWithdrawalUC withdrawalUseCase.RequestWithdrawalUseCaseInterface,
+    getWithdrawalUC withdrawalUseCase.GetWithdrawalUseCaseInterface,
+    listWithdrawalsUC withdrawalUseCase.ListWithdrawalsUseCaseInterface,
+    approveWithdrawalUC withdrawalUseCase.ApproveWithdrawalUseCaseInterface,
+    rejectWithdrawalUC withdrawalUseCase.RejectWithdrawalUseCaseInterface,
+) *WithdrawalHandler {
+    return &WithdrawalHandler{
+        RequestWithdrawalUC: requestWithdrawalUC,
+        GetWithdrawalUC:     getWithdrawalUC,
+        ListWithdrawalsUC:   listWithdrawalsUC,
+        ApproveWithdrawalUC: approveWithdrawalUC,
+        RejectWithdrawalUC:  rejectWithdrawalUC,
+    }
+}
+
+func (h *WithdrawalHandler) RequestWithdrawal(ctx context.Context, req *pb.RequestWithdrawalRequest) (*pb.WithdrawalResponse, error) {
+    amount, err := fromProtoMoney("amount", req.Amount)
+    if err != nil {
+        return nil, err
+    }
+    resp, err := h.RequestWithdrawalUC.Execute(ctx, &dto.RequestWithdrawalRequest{
+        UserID:      req.UserId,
+        Amount:      amount,
+        Destination: req.Destination,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.WithdrawalResponse{Withdrawal: toProtoWithdrawal(resp)}, nil
+}
+
+func (h *WithdrawalHandler) ListWithdrawals(ctx context.Context, req *pb.ListWithdrawalsRequest) (*pb.ListWithdrawalsResponse, error) {
+    resp, err := h.ListWithdrawalsUC.Execute(ctx, &dto.ListWithdrawalsRequest{
+        UserID:     req.UserId,
+        PageSize:   int(req.PageSize),
+        PageNumber: int(req.PageNumber),
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    withdrawals := make([]*pb.Withdrawal, len(resp.Withdrawals))
+    for i := range resp.Withdrawals {
+        withdrawals[i] = toProtoWithdrawal(&resp.Withdrawals[i])
+    }
+
+    return &pb.ListWithdrawalsResponse{
+        Withdrawals: withdrawals,
+        TotalCount:  resp.TotalCount,
+    }, nil
+}
+
+func (h *WithdrawalHandler) GetWithdrawal(ctx context.Context, req *pb.GetWithdrawalRequest) (*pb.WithdrawalResponse, error) {
+    resp, err := h.GetWithdrawalUC.Execute(ctx, &dto.GetWithdrawalRequest{
+        WithdrawalID: req.WithdrawalId,
+        UserID:       req.UserId,
+    })
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &pb.WithdrawalResponse{Withdrawal: toProtoWithdrawal(resp)}, nil
+}
+
+func (h *WithdrawalHandler) ApproveWithdrawal(ctx context.Context, req *pb.ApproveWithdrawalRequest) (*pb.WithdrawalResponse, error) {
+    resp, err := h.ApproveWithdrawalUC.Execute(ctx, &dto.ApproveWithdrawalRequest{
+        WithdrawalID: req.WithdrawalId,
+    })
+    if err != nil {
+        return nil, domainStatus(err)
+    }
+
+    return &pb.WithdrawalResponse{Withdrawal: toProtoWithdrawal(resp)}, nil
+}
+
+func (h *WithdrawalHandler) RejectWithdrawal(ctx context.Context, req *pb.RejectWithdrawalRequest) (*pb.WithdrawalResponse, error) {
+    resp, err := h.RejectWithdrawalUC.Execute(ctx, &dto.RejectWithdrawalRequest{
+        WithdrawalID: req.WithdrawalId,
+        Reason:       req.Reason,
+    })
+    if err != nil {
+        return nil, domainStatus(err)
+    }
+
+    return &pb.WithdrawalResponse{Withdrawal: toProtoWithdrawal(resp)}, nil
+}
+
+func toProtoWithdrawal(w *dto.WithdrawalResponse) *pb.Withdrawal {
+    withdrawal := &pb.Withdrawal{
+        Id:          w.ID,
+        UserId:      w.UserID,
+        Amount:      toProtoMoney(w.Amount),
+        Destination: w.Destination,
+        Status:      w.Status,
+        Reference:   w.Reference,
+        Note:        w.Note,
+        CreatedAt:   timestamppb.New(w.CreatedAt),
+        UpdatedAt:   timestamppb.New(w.UpdatedAt),
+    }
+    if w.ReviewerID != nil {
+        withdrawal.ReviewerId = *w.ReviewerID
+    }
+    for _, e := range w.History {
+        event := &pb.WithdrawalEvent{
+            Status:    e.Status,
+            Note:      e.Note,
+            CreatedAt: timestamppb.New(e.CreatedAt),
+        }
+        if e.ActorID != nil {
+            event.ActorId = *e.ActorID
+        }
+        withdrawal.History = append(withdrawal.History, event)
+    }
+    return withdrawal
+}
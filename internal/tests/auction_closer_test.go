@@ -0,0 +1,128 @@
+package tests
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    "auction-system/internal/application/eventbus"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/worker"
+)
+
+type closerFixture struct {
+    *market
+    auctionRepo *memoryAuctionRepo
+    lotRepo       *memoryLotRepo
+    events        *eventbus.AuctionBus
+    notifications *notifier.Dispatcher
+}
+
+func newCloserFixture(auction *entity.Auction, bids ...*entity.Bid) *closerFixture {
+    f := &closerFixture{
+        market: newMarket(
+            userWithBalance(1, usd(1000)),
+            userWithBalance(2, usd(5000)),
+            userWithBalance(3, usd(5000)),
+        ),
+        auctionRepo: newMemoryAuctionRepo(auction),
+        lotRepo:       newMemoryLotRepo(&entity.Lot{ID: auction.LotID, CreatorID: 1}),
+        events:        eventbus.NewAuctionBus(16, 0),
+        notifications: newTestDispatcher(),
+    }
+    for _, b := range bids {
+        _ = f.bidRepo.Create(context.Background(), b)
+    }
+    return f
+}
+
+// run starts the closer and waits until the auction leaves ACTIVE.
+func (f *closerFixture) run(t *testing.T, auctionID int64) *entity.Auction {
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+
+    w := worker.NewAuctionCloserWorker(f.auctionRepo, f.bidRepo, f.lotRepo, &memoryTxManager{}, f.settlement(f.lotRepo, entity.FeeSchedule{}, newInvoiceIssuer(f.userRepo)), f.events, f.notifications, 10*time.Millisecond)
+    go w.Start(ctx)
+
+    var auction *entity.Auction
+    require.Eventually(t, func() bool {
+        var err error
+        auction, err = f.auctionRepo.GetByID(context.Background(), auctionID)
+        return err == nil && auction.Status != entity.AuctionStatusActive
+    }, time.Second, 10*time.Millisecond)
+    return auction
+}
+
+// collect captures the payments opened when the auction closed.
+func (f *closerFixture) collect(t *testing.T) {
+    processor := f.paymentProcessor(f.auctionRepo, f.lotRepo, &fakeGateway{}, &recordingNotifier{})
+    require.NoError(t, processor.ProcessDue(context.Background(), time.Now()))
+}
+
+func endedAuction(reservePrice entity.Money) *entity.Auction {
+    return &entity.Auction{
+        ID:           1,
+        LotID:        1,
+        StartPrice:   usd(100),
+        ReservePrice: reservePrice,
+        MinStep:      usd(10),
+        CurrentPrice: usd(300),
+        Status:       entity.AuctionStatusActive,
+        EndTime:      time.Now().Add(-time.Second),
+    }
+}
+
+func TestCloseAuctionReserveNotMet(t *testing.T) {
+    f := newCloserFixture(endedAuction(usd(500)), &entity.Bid{AuctionID: 1, UserID: 2, Amount: usd(300)})
+
+    auction := f.run(t, 1)
+
+    assert.Equal(t, entity.AuctionStatusReserveNotMet, auction.Status)
+    assert.False(t, auction.ReserveMet())
+    assert.Nil(t, auction.WinnerID)
+    assert.Nil(t, auction.WinnerBidID)
+    assert.Equal(t, usd(1000), f.balance(t, 1))
+    assert.Equal(t, usd(5000), f.balance(t, 2))
+}
+
+func TestCloseAuctionReserveMet(t *testing.T) {
+    f := newCloserFixture(endedAuction(usd(250)), &entity.Bid{AuctionID: 1, UserID: 2, Amount: usd(300)})
+
+    auction := f.run(t, 1)
+
+    assert.Equal(t, entity.AuctionStatusEnded, auction.Status)
+    require.NotNil(t, auction.WinnerID)
+    assert.Equal(t, int64(2), *auction.WinnerID)
+    assert.Equal(t, usd(1000), f.balance(t, 1), "nothing moves until the payment is captured")
+
+    f.collect(t)
+    assert.Equal(t, usd(1300), f.balance(t, 1))
+    assert.Equal(t, usd(4700), f.balance(t, 2))
+}
+
+func TestCloseAuctionEmailsResults(t *testing.T) {
+    f := newCloserFixture(endedAuction(usd(250)),
+        &entity.Bid{AuctionID: 1, UserID: 2, Amount: usd(300)},
+        &entity.Bid{AuctionID: 1, UserID: 4, Amount: usd(280)},
+    )
+    sink := newSMTPSink(t)
+    f.notifications = notifier.NewDispatcher(newEmailNotifier(t, sink, "en"), 16, 1, time.Second)
+
+    f.run(t, 1)
+    f.notifications.Close()
+
+    subjects := make(map[string]string)
+    for _, email := range sink.emails() {
+        require.Len(t, email.to, 1)
+        subjects[email.to[0]] = email.subject
+    }
+    assert.Equal(t, map[string]string{
+        "bob@auction.test":   "You won auction #1",
+        "dave@auction.test":  "Auction #1 has ended",
+        "alice@auction.test": "Auction #1 has ended",
+    }, subjects, "the winner, the outbid bidder and the seller hear once each")
+}
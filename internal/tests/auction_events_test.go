@@ -0,0 +1,279 @@
+package tests
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    auctionUC "auction-system/internal/application/usecase/auction"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+// drain returns the events already delivered to sub.
+func drain(sub *eventbus.AuctionSubscription) []*entity.AuctionEvent {
+    var events []*entity.AuctionEvent
+    for {
+        select {
+        case e, ok := <-sub.Events():
+            if !ok {
+                return events
+            }
+            events = append(events, e)
+        default:
+            return events
+        }
+    }
+}
+
+func eventTypes(events []*entity.AuctionEvent) []entity.AuctionEventType {
+    types := make([]entity.AuctionEventType, len(events))
+    for i, e := range events {
+        types[i] = e.Type
+    }
+    return types
+}
+
+func TestEventBusDeliversToAuctionSubscribers(t *testing.T) {
+    ctx := context.Background()
+    bus := eventbus.NewAuctionBus(4, 0)
+    first := bus.Subscribe(1)
+    second := bus.Subscribe(1)
+    other := bus.Subscribe(2)
+
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventStarted}))
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventPriceChanged}))
+
+    for _, sub := range []*eventbus.AuctionSubscription{first, second} {
+        events := drain(sub)
+        require.Len(t, events, 2)
+        assert.Equal(t, []entity.AuctionEventType{entity.AuctionEventStarted, entity.AuctionEventPriceChanged}, eventTypes(events))
+        assert.Less(t, events[0].ID, events[1].ID)
+    }
+    assert.Empty(t, drain(other))
+
+    first.Close()
+    first.Close()
+    assert.NoError(t, first.Err())
+    assert.Equal(t, 1, bus.Subscribers(1))
+
+    bus.Close()
+    _, open := <-second.Events()
+    assert.False(t, open)
+    assert.Equal(t, eventbus.ErrClosed, second.Err())
+    assert.Equal(t, eventbus.ErrClosed, bus.Subscribe(1).Err())
+}
+
+func TestEventBusDropsSlowSubscribers(t *testing.T) {
+    ctx := context.Background()
+    bus := eventbus.NewAuctionBus(2, 0)
+    slow := bus.Subscribe(1)
+    fast := bus.Subscribe(1)
+
+    for i := 0; i < 3; i++ {
+        require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventBidPlaced}))
+        if i < 2 {
+            assert.Len(t, drain(fast), 1)
+        }
+    }
+
+    assert.Len(t, drain(slow), 2, "the buffered events are still delivered")
+    assert.Equal(t, eventbus.ErrSlowSubscriber, slow.Err())
+    assert.Len(t, drain(fast), 1, "other subscribers are not held up")
+    assert.NoError(t, fast.Err())
+    assert.Equal(t, 1, bus.Subscribers(1))
+}
+
+func TestPlaceBidPublishesEvents(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(&entity.Auction{
+        ID:                1,
+        CurrentPrice:      usd(100),
+        MinStep:           usd(10),
+        Status:            entity.AuctionStatusActive,
+        EndTime:           time.Now().Add(time.Minute),
+        ExtensionWindow:   2 * time.Minute,
+        ExtensionDuration: 5 * time.Minute,
+    })
+    userRepo := newMemoryUserRepo(userWithBalance(1, usd(1000)))
+    bidRepo := &memoryBidRepo{}
+    bus := eventbus.NewAuctionBus(16, 0)
+    sub := bus.Subscribe(1)
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), bus, newTestDispatcher(), 50)
+
+    placed, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 1, Amount: usd(110)})
+    require.NoError(t, err)
+
+    events := drain(sub)
+    require.Equal(t, []entity.AuctionEventType{
+        entity.AuctionEventBidPlaced,
+        entity.AuctionEventPriceChanged,
+        entity.AuctionEventExtended,
+    }, eventTypes(events))
+    require.NotNil(t, events[0].Bid)
+    assert.Equal(t, placed.ID, events[0].Bid.ID)
+    assert.Equal(t, usd(110), events[0].Bid.Amount)
+    assert.Equal(t, usd(110), events[1].CurrentPrice)
+
+    auction, err := auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, auction.EndTime, events[2].EndTime)
+
+    _, err = uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 1, Amount: usd(115)})
+    assert.Error(t, err)
+    assert.Empty(t, drain(sub), "rejected bids are not announced")
+}
+
+func TestSealedBidsAreNotPublished(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(&entity.Auction{
+        ID:           1,
+        Type:         entity.AuctionTypeSealedFirstPrice,
+        StartPrice:   usd(100),
+        CurrentPrice: usd(100),
+        Status:       entity.AuctionStatusActive,
+        EndTime:      time.Now().Add(time.Hour),
+    })
+    userRepo := newMemoryUserRepo(userWithBalance(2, usd(1000)))
+    bidRepo := &memoryBidRepo{}
+    bus := eventbus.NewAuctionBus(16, 0)
+    sub := bus.Subscribe(1)
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), bus, newTestDispatcher(), 50)
+
+    _, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(300)})
+    require.NoError(t, err)
+    assert.Empty(t, drain(sub))
+}
+
+func TestAuctionCloserPublishesEnded(t *testing.T) {
+    f := newCloserFixture(endedAuction(usd(250)), &entity.Bid{AuctionID: 1, UserID: 2, Amount: usd(300)})
+    sub := f.events.Subscribe(1)
+
+    f.run(t, 1)
+
+    events := drain(sub)
+    require.Len(t, events, 1)
+    assert.Equal(t, entity.AuctionEventEnded, events[0].Type)
+    assert.Equal(t, entity.AuctionStatusEnded, events[0].Status)
+    require.NotNil(t, events[0].WinnerID)
+    assert.Equal(t, int64(2), *events[0].WinnerID)
+}
+
+// watchStream records what WatchAuction sends.
+type watchStream struct {
+    grpc.ServerStream
+    ctx  context.Context
+    sent chan *pb.AuctionEvent
+}
+
+func newWatchStream(ctx context.Context) *watchStream {
+    return &watchStream{ctx: ctx, sent: make(chan *pb.AuctionEvent, 16)}
+}
+
+func (s *watchStream) Context() context.Context {
+    return s.ctx
+}
+
+func (s *watchStream) Send(e *pb.AuctionEvent) error {
+    s.sent <- e
+    return nil
+}
+
+func watchHandler(bus *eventbus.AuctionBus, auctions ...*entity.Auction) *handler.AuctionHandler {
+    return &handler.AuctionHandler{
+        WatchAuctionUC: auctionUC.NewWatchAuctionUseCase(newMemoryAuctionRepo(auctions...), bus),
+    }
+}
+
+func TestWatchAuctionStreamsUntilEnded(t *testing.T) {
+    ctx := context.Background()
+    bus := eventbus.NewAuctionBus(16, 0)
+    h := watchHandler(bus, &entity.Auction{ID: 1, Status: entity.AuctionStatusActive})
+    stream := newWatchStream(ctx)
+
+    done := make(chan error, 1)
+    go func() {
+        done <- h.WatchAuction(&pb.WatchAuctionRequest{AuctionId: 1}, stream)
+    }()
+    require.Eventually(t, func() bool { return bus.Subscribers(1) == 1 }, time.Second, time.Millisecond)
+
+    winner := int64(2)
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{
+        AuctionID: 1, Type: entity.AuctionEventBidPlaced, CurrentPrice: usd(150),
+        Bid: &entity.Bid{ID: 7, UserID: 2, Amount: usd(150), Quantity: 1},
+    }))
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 2, Type: entity.AuctionEventStarted}))
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{
+        AuctionID: 1, Type: entity.AuctionEventEnded, Status: entity.AuctionStatusEnded, WinnerID: &winner,
+    }))
+
+    select {
+    case err := <-done:
+        require.NoError(t, err)
+    case <-time.After(time.Second):
+        t.Fatal("stream did not end after the auction ended")
+    }
+    close(stream.sent)
+
+    var sent []*pb.AuctionEvent
+    for e := range stream.sent {
+        sent = append(sent, e)
+    }
+    require.Len(t, sent, 2)
+    assert.Equal(t, "BID_PLACED", sent[0].Type)
+    assert.Equal(t, int64(7), sent[0].Bid.Id)
+    assert.Equal(t, int64(150), sent[0].CurrentPrice.Units)
+    assert.Equal(t, "ENDED", sent[1].Type)
+    assert.Equal(t, int64(2), sent[1].WinnerId)
+    assert.Equal(t, 0, bus.Subscribers(1))
+}
+
+func TestWatchAuctionRejectsFinishedAuctions(t *testing.T) {
+    bus := eventbus.NewAuctionBus(16, 0)
+    h := watchHandler(bus, &entity.Auction{ID: 1, Status: entity.AuctionStatusCompleted})
+
+    err := h.WatchAuction(&pb.WatchAuctionRequest{AuctionId: 1}, newWatchStream(context.Background()))
+    assert.Error(t, err)
+    err = h.WatchAuction(&pb.WatchAuctionRequest{AuctionId: 2}, newWatchStream(context.Background()))
+    assert.Error(t, err)
+    assert.Equal(t, 0, bus.Subscribers(1))
+}
+
+func TestWatchAuctionDisconnectsSlowWatchers(t *testing.T) {
+    ctx := context.Background()
+    bus := eventbus.NewAuctionBus(1, 0)
+    h := watchHandler(bus, &entity.Auction{ID: 1, Status: entity.AuctionStatusActive})
+
+    // An unbuffered stream nobody reads blocks the handler on its first send.
+    stream := &watchStream{ctx: ctx, sent: make(chan *pb.AuctionEvent)}
+    done := make(chan error, 1)
+    go func() {
+        done <- h.WatchAuction(&pb.WatchAuctionRequest{AuctionId: 1}, stream)
+    }()
+    require.Eventually(t, func() bool { return bus.Subscribers(1) == 1 }, time.Second, time.Millisecond)
+
+    for i := 0; i < 3; i++ {
+        require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventPriceChanged}))
+    }
+    assert.Equal(t, 0, bus.Subscribers(1))
+
+    go func() {
+        for range stream.sent {
+        }
+    }()
+    select {
+    case err := <-done:
+        assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+    case <-time.After(time.Second):
+        t.Fatal("slow watcher was not disconnected")
+    }
+}
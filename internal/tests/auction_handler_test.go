@@ -7,6 +7,8 @@ import (
     
     "github.com/stretchr/testify/assert"
     "github.com/stretchr/testify/mock"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
     "google.golang.org/protobuf/types/known/timestamppb"
     
     pb "auction-system/pkg/api"
@@ -56,8 +58,8 @@ type mockListAuctionsUC struct {
     mock.Mock
 }
 
-func (m *mockListAuctionsUC) Execute(ctx context.Context, page, pageSize int, status *string) (*dto.ListAuctionsResponse, error) {
-    args := m.Called(ctx, page, pageSize, status)
+func (m *mockListAuctionsUC) Execute(ctx context.Context, page, pageSize int, status *string, displayCurrency string) (*dto.ListAuctionsResponse, error) {
+    args := m.Called(ctx, page, pageSize, status, displayCurrency)
     if args.Get(0) == nil {
         return nil, args.Error(1)
     }
@@ -71,9 +73,9 @@ func createTestAuctionResponse() *dto.AuctionResponse {
     return &dto.AuctionResponse{
         ID:           1,
         LotID:        1,
-        StartPrice:   100,
-        MinStep:      10,
-        CurrentPrice: 110,
+        StartPrice:   usd(100),
+        MinStep:      usd(10),
+        CurrentPrice: usd(110),
         StartTime:    now,
         EndTime:      now.Add(24 * time.Hour),
         Status:       entity.AuctionStatusActive,
@@ -99,15 +101,15 @@ func TestCreateAuction(t *testing.T) {
     now := time.Now()
     req := &pb.CreateAuctionRequest{
         LotId:      1,
-        StartPrice: 100,
-        MinStep:    10,
+        StartPrice: pbUSD(100),
+        MinStep:    pbUSD(10),
         StartTime:  timestamppb.New(now),
         EndTime:    timestamppb.New(now.Add(24 * time.Hour)),
     }
 
     expectedResp := createTestAuctionResponse()
     mockUC.On("Execute", ctx, mock.MatchedBy(func(req *dto.CreateAuctionRequest) bool {
-        return req.LotID == 1 && req.StartPrice == 100 && req.MinStep == 10
+        return req.LotID == 1 && req.StartPrice.Cmp(usd(100)) == 0 && req.MinStep.Cmp(usd(10)) == 0
     })).Return(expectedResp, nil)
 
     resp, err := h.CreateAuction(ctx, req)
@@ -118,6 +120,23 @@ func TestCreateAuction(t *testing.T) {
     mockUC.AssertExpectations(t)
 }
 
+func TestCreateAuctionRefusesInvalidMoney(t *testing.T) {
+    mockUC := new(mockCreateAuctionUC)
+    h := &handler.AuctionHandler{
+        CreateAuctionUC: mockUC,
+    }
+
+    _, err := h.CreateAuction(context.Background(), &pb.CreateAuctionRequest{
+        LotId:      1,
+        StartPrice: pbUSD(100),
+        MinStep:    pbUSD(10),
+        FloorPrice: &pb.Money{Units: 5, Nanos: -1, CurrencyCode: "USD"},
+    })
+    assert.Equal(t, codes.InvalidArgument, status.Code(err))
+    assert.Contains(t, err.Error(), "floor_price")
+    mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
 func TestGetAuction(t *testing.T) {
     mockUC := new(mockGetAuctionUC)
     h := &handler.AuctionHandler{
@@ -148,8 +167,8 @@ func TestUpdateAuction(t *testing.T) {
     now := time.Now()
     req := &pb.UpdateAuctionRequest{
         Id:         1,
-        StartPrice: 100,
-        MinStep:    10,
+        StartPrice: pbUSD(100),
+        MinStep:    pbUSD(10),
         StartTime:  timestamppb.New(now),
         EndTime:    timestamppb.New(now.Add(24 * time.Hour)),
         Status:     string(entity.AuctionStatusActive),
@@ -157,7 +176,7 @@ func TestUpdateAuction(t *testing.T) {
 
     expectedResp := createTestAuctionResponse()
     mockUC.On("Execute", ctx, int64(1), mock.MatchedBy(func(req *dto.UpdateAuctionRequest) bool {
-        return *req.StartPrice == 100 && *req.MinStep == 10 && *req.Status == string(entity.AuctionStatusActive)
+        return req.StartPrice.Cmp(usd(100)) == 0 && req.MinStep.Cmp(usd(10)) == 0 && *req.Status == string(entity.AuctionStatusActive)
     })).Return(expectedResp, nil)
 
     resp, err := h.UpdateAuction(ctx, req)
@@ -186,7 +205,7 @@ func TestListAuctions(t *testing.T) {
         Auctions:   []dto.AuctionResponse{*createTestAuctionResponse()},
         TotalCount: 1,
     }
-    mockUC.On("Execute", ctx, 1, 10, &status).Return(expectedResp, nil)
+    mockUC.On("Execute", ctx, 1, 10, &status, "").Return(expectedResp, nil)
 
     resp, err := h.ListAuctions(ctx, req)
 
@@ -204,9 +223,9 @@ func TestMapAuctionToProto(t *testing.T) {
     assert.NotNil(t, protoAuction)
     assert.Equal(t, auctionResp.ID, protoAuction.Id)
     assert.Equal(t, auctionResp.LotID, protoAuction.LotId)
-    assert.Equal(t, auctionResp.StartPrice, protoAuction.StartPrice)
-    assert.Equal(t, auctionResp.MinStep, protoAuction.MinStep)
-    assert.Equal(t, auctionResp.CurrentPrice, protoAuction.CurrentPrice)
+    assert.Equal(t, auctionResp.StartPrice, fromPB(protoAuction.StartPrice))
+    assert.Equal(t, auctionResp.MinStep, fromPB(protoAuction.MinStep))
+    assert.Equal(t, auctionResp.CurrentPrice, fromPB(protoAuction.CurrentPrice))
     assert.Equal(t, string(auctionResp.Status), protoAuction.Status)
     assert.Equal(t, *auctionResp.WinnerID, protoAuction.WinnerId)
     assert.Equal(t, *auctionResp.WinnerBidID, protoAuction.WinnerBidId)
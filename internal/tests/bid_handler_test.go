@@ -2,11 +2,14 @@ package tests
 
 import (
     "context"
+    "math"
     "testing"
     "time"
     
     "github.com/stretchr/testify/assert"
     "github.com/stretchr/testify/mock"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
     
     pb "auction-system/pkg/api"
     dto "auction-system/internal/application/dto/bid"
@@ -50,13 +53,25 @@ func (m *mockListBidsUC) Execute(ctx context.Context, req *dto.ListBidsRequest)
     return args.Get(0).(*dto.ListBidsResponse), args.Error(1)
 }
 
+type mockSetMaxBidUC struct {
+    mock.Mock
+}
+
+func (m *mockSetMaxBidUC) Execute(ctx context.Context, req *dto.SetMaxBidRequest) (*dto.MaxBidResponse, error) {
+    args := m.Called(ctx, req)
+    if args.Get(0) == nil {
+        return nil, args.Error(1)
+    }
+    return args.Get(0).(*dto.MaxBidResponse), args.Error(1)
+}
+
 func createTestBidResponse() *dto.BidResponse {
     now := time.Now()
     return &dto.BidResponse{
         ID:        1,
         AuctionID: 1,
         UserID:    1,
-        Amount:    150.0,
+        Amount:    usd(150),
         CreatedAt: now,
         UpdatedAt: now,
     }
@@ -65,6 +80,7 @@ func createTestBidResponse() *dto.BidResponse {
 var _ bidUC.PlaceBidUseCaseInterface = (*mockPlaceBidUC)(nil)
 var _ bidUC.GetBidUseCaseInterface = (*mockGetBidUC)(nil)
 var _ bidUC.ListBidsUseCaseInterface = (*mockListBidsUC)(nil)
+var _ bidUC.SetMaxBidUseCaseInterface = (*mockSetMaxBidUC)(nil)
 
 func TestPlaceBid(t *testing.T) {
     mockUC := new(mockPlaceBidUC)
@@ -76,12 +92,12 @@ func TestPlaceBid(t *testing.T) {
     req := &pb.PlaceBidRequest{
         AuctionId: 1,
         UserId:    1,
-        Amount:    150.0,
+        Amount:    pbUSD(150),
     }
 
     expectedResp := createTestBidResponse()
     mockUC.On("Execute", ctx, mock.MatchedBy(func(req *dto.PlaceBidRequest) bool {
-        return req.AuctionID == 1 && req.UserID == 1 && req.Amount == 150.0
+        return req.AuctionID == 1 && req.UserID == 1 && req.Amount.Cmp(usd(150)) == 0
     })).Return(expectedResp, nil)
 
     resp, err := h.PlaceBid(ctx, req)
@@ -91,10 +107,28 @@ func TestPlaceBid(t *testing.T) {
     assert.Equal(t, expectedResp.ID, resp.Bid.Id)
     assert.Equal(t, expectedResp.AuctionID, resp.Bid.AuctionId)
     assert.Equal(t, expectedResp.UserID, resp.Bid.UserId)
-    assert.Equal(t, expectedResp.Amount, resp.Bid.Amount)
+    assert.Equal(t, expectedResp.Amount, fromPB(resp.Bid.Amount))
     mockUC.AssertExpectations(t)
 }
 
+func TestPlaceBidRefusesInvalidMoney(t *testing.T) {
+    mockUC := new(mockPlaceBidUC)
+    h := &handler.BidHandler{
+        PlaceBidUseCase: mockUC,
+    }
+
+    for name, amount := range map[string]*pb.Money{
+        "nanos out of range": {Units: 1, Nanos: 1_000_000_000, CurrencyCode: "USD"},
+        "mixed signs":        {Units: 1, Nanos: -5_000_000, CurrencyCode: "USD"},
+        "overflow":           {Units: math.MaxInt64, CurrencyCode: "USD"},
+        "unknown currency":   {Units: 1, CurrencyCode: "usd"},
+    } {
+        _, err := h.PlaceBid(context.Background(), &pb.PlaceBidRequest{AuctionId: 1, UserId: 1, Amount: amount})
+        assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+    }
+    mockUC.AssertNotCalled(t, "Execute", mock.Anything, mock.Anything)
+}
+
 func TestGetBid(t *testing.T) {
     mockUC := new(mockGetBidUC)
     h := &handler.BidHandler{
@@ -114,7 +148,7 @@ func TestGetBid(t *testing.T) {
     assert.Equal(t, expectedResp.ID, resp.Bid.Id)
     assert.Equal(t, expectedResp.AuctionID, resp.Bid.AuctionId)
     assert.Equal(t, expectedResp.UserID, resp.Bid.UserId)
-    assert.Equal(t, expectedResp.Amount, resp.Bid.Amount)
+    assert.Equal(t, expectedResp.Amount, fromPB(resp.Bid.Amount))
     mockUC.AssertExpectations(t)
 }
 
@@ -150,6 +184,45 @@ func TestListBids(t *testing.T) {
     assert.Equal(t, expectedBids[0].ID, resp.Bids[0].Id)
     assert.Equal(t, expectedBids[0].AuctionID, resp.Bids[0].AuctionId)
     assert.Equal(t, expectedBids[0].UserID, resp.Bids[0].UserId)
-    assert.Equal(t, expectedBids[0].Amount, resp.Bids[0].Amount)
+    assert.Equal(t, expectedBids[0].Amount, fromPB(resp.Bids[0].Amount))
+    mockUC.AssertExpectations(t)
+}
+
+func TestSetMaxBid(t *testing.T) {
+    mockUC := new(mockSetMaxBidUC)
+    h := &handler.BidHandler{
+        SetMaxBidUseCase: mockUC,
+    }
+
+    ctx := context.Background()
+    req := &pb.SetMaxBidRequest{
+        AuctionId: 1,
+        UserId:    1,
+        MaxAmount: pbUSD(500),
+    }
+
+    now := time.Now()
+    expectedResp := &dto.MaxBidResponse{
+        ID:           1,
+        AuctionID:    1,
+        UserID:       1,
+        MaxAmount:    usd(500),
+        CurrentPrice: usd(110),
+        IsLeading:    true,
+        CreatedAt:    now,
+        UpdatedAt:    now,
+    }
+    mockUC.On("Execute", ctx, mock.MatchedBy(func(req *dto.SetMaxBidRequest) bool {
+        return req.AuctionID == 1 && req.UserID == 1 && req.MaxAmount.Cmp(usd(500)) == 0
+    })).Return(expectedResp, nil)
+
+    resp, err := h.SetMaxBid(ctx, req)
+
+    assert.NoError(t, err)
+    assert.NotNil(t, resp)
+    assert.Equal(t, expectedResp.ID, resp.MaxBid.Id)
+    assert.Equal(t, expectedResp.MaxAmount, fromPB(resp.MaxBid.MaxAmount))
+    assert.Equal(t, expectedResp.CurrentPrice, fromPB(resp.CurrentPrice))
+    assert.True(t, resp.IsLeading)
     mockUC.AssertExpectations(t)
 }
@@ -0,0 +1,118 @@
+package tests
+
+import (
+    "context"
+    "fmt"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/domain/entity"
+)
+
+// newTestDispatcher sends notifications nobody looks at.
+func newTestDispatcher() *notifier.Dispatcher {
+    return notifier.NewDispatcher(&recordingNotifier{}, 64, 1, time.Second)
+}
+
+func englishAuction() *entity.Auction {
+    return &entity.Auction{
+        ID:           1,
+        LotID:        1,
+        StartPrice:   usd(100),
+        MinStep:      usd(10),
+        CurrentPrice: usd(100),
+        Status:       entity.AuctionStatusActive,
+        EndTime:      time.Now().Add(time.Hour),
+    }
+}
+
+func TestPlaceBidNotifiesOutbidLeaderAndSeller(t *testing.T) {
+    f := newBidding(newBidders(), englishAuction())
+
+    first := f.place(t, 1, 110, 1)
+    second := f.place(t, 2, 130, 1)
+    third := f.place(t, 2, 150, 1)
+    _, err := f.placeBid.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 3, Amount: usd(155)})
+    require.Error(t, err)
+
+    outbid, newBids := f.sent()
+    assert.Equal(t, []bidNotification{{userID: 1, bidID: second.ID}}, outbid, "raising your own bid outbids nobody")
+    assert.Equal(t, []bidNotification{{9, first.ID}, {9, second.ID}, {9, third.ID}}, newBids, "rejected bids notify nobody")
+}
+
+func TestMaxBidsOutbidBidders(t *testing.T) {
+    f := newBidding(newBidders(), englishAuction())
+
+    _, err := f.setMaxBid.Execute(context.Background(), &dto.SetMaxBidRequest{AuctionID: 1, UserID: 1, MaxAmount: usd(300)})
+    require.NoError(t, err)
+    challenger := f.place(t, 2, 150, 1)
+
+    outbid, newBids := f.sent()
+    require.Len(t, outbid, 1)
+    assert.Equal(t, int64(2), outbid[0].userID, "the maximum bid answered at once")
+    assert.NotEqual(t, challenger.ID, outbid[0].bidID)
+    require.Len(t, newBids, 2)
+    assert.Equal(t, challenger.ID, newBids[1].bidID)
+}
+
+func TestMultiUnitBidNotifiesDisplacedWinners(t *testing.T) {
+    f := newBidding(newBidders(), multiUnitAuction(entity.MultiUnitPricingUniform))
+
+    f.place(t, 1, 120, 2)
+    f.place(t, 2, 150, 1)
+    top := f.place(t, 3, 200, 2)
+
+    outbid, newBids := f.sent()
+    assert.Equal(t, []bidNotification{{userID: 1, bidID: top.ID}}, outbid, "user 2 keeps their unit")
+    assert.Len(t, newBids, 3)
+}
+
+func TestSealedBidsNotifyNobody(t *testing.T) {
+    auction := englishAuction()
+    auction.Type = entity.AuctionTypeSealedFirstPrice
+    f := newBidding(newBidders(), auction)
+
+    f.place(t, 1, 200, 1)
+    f.place(t, 2, 300, 1)
+
+    outbid, newBids := f.sent()
+    assert.Empty(t, outbid)
+    assert.Empty(t, newBids)
+}
+
+// blockingNotifier fails new-bid notifications after waiting for release.
+type blockingNotifier struct {
+    recordingNotifier
+    release chan struct{}
+}
+
+func (n *blockingNotifier) NotifyNewBid(ctx context.Context, auction *entity.Auction, sellerID int64, bid *entity.Bid) error {
+    <-n.release
+    return fmt.Errorf("mail server unavailable")
+}
+
+func TestSlowNotificationsDoNotHoldUpBids(t *testing.T) {
+    blocking := &blockingNotifier{release: make(chan struct{})}
+    f := newBiddingThrough(newBidders(), blocking, 1, englishAuction())
+
+    done := make(chan struct{})
+    go func() {
+        defer close(done)
+        for amount := int64(110); amount <= 150; amount += 10 {
+            f.place(t, 1, amount, 1)
+        }
+    }()
+    select {
+    case <-done:
+    case <-time.After(time.Second):
+        t.Fatal("bids waited for notifications")
+    }
+
+    close(blocking.release)
+    f.dispatcher.Close()
+}
@@ -0,0 +1,151 @@
+package tests
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+
+    auctionDto "auction-system/internal/application/dto/auction"
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/settlement"
+    auctionUC "auction-system/internal/application/usecase/auction"
+    "auction-system/internal/application/eventbus"
+    "auction-system/internal/application/notifier"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+type mockBuyNowUC struct {
+    mock.Mock
+}
+
+func (m *mockBuyNowUC) Execute(ctx context.Context, req *auctionDto.BuyNowRequest) (*auctionDto.AuctionResponse, error) {
+    args := m.Called(ctx, req)
+    if args.Get(0) == nil {
+        return nil, args.Error(1)
+    }
+    return args.Get(0).(*auctionDto.AuctionResponse), args.Error(1)
+}
+
+type buyNowFixture struct {
+    *market
+    auctionRepo *memoryAuctionRepo
+    buyNow      *auctionUC.BuyNowUseCase
+    placeBid    *bidUC.PlaceBidUseCase
+    payments    *settlement.PaymentProcessor
+    notifier    *recordingNotifier
+    dispatcher  *notifier.Dispatcher
+}
+
+func newBuyNowFixture() *buyNowFixture {
+    f := &buyNowFixture{
+        market: newMarket(
+            userWithBalance(1, usd(0)),
+            userWithBalance(2, usd(5000)),
+        ),
+        auctionRepo: newMemoryAuctionRepo(&entity.Auction{
+            ID:           1,
+            LotID:        1,
+            StartPrice:   usd(100),
+            MinStep:      usd(10),
+            CurrentPrice: usd(100),
+            BuyNowPrice:  usd(1000),
+            Status:       entity.AuctionStatusActive,
+            EndTime:      time.Now().Add(time.Hour),
+        }),
+    }
+    lotRepo := newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1})
+    txManager := &memoryTxManager{}
+    f.notifier = &recordingNotifier{}
+    f.dispatcher = notifier.NewDispatcher(f.notifier, 16, 1, time.Second)
+    f.buyNow = auctionUC.NewBuyNowUseCase(f.auctionRepo, f.bidRepo, lotRepo, txManager, f.escrow,
+        f.settlement(lotRepo, entity.FeeSchedule{}, newInvoiceIssuer(f.userRepo)), eventbus.NewAuctionBus(16, 0), f.dispatcher)
+    f.placeBid = bidUC.NewPlaceBidUseCase(f.bidRepo, f.auctionRepo, &memoryMaxBidRepo{}, lotRepo, txManager, f.escrow, eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+    f.payments = f.paymentProcessor(f.auctionRepo, lotRepo, &fakeGateway{}, &recordingNotifier{})
+    return f
+}
+
+func TestBuyNowClosesAuction(t *testing.T) {
+    f := newBuyNowFixture()
+
+    resp, err := f.buyNow.Execute(context.Background(), &auctionDto.BuyNowRequest{AuctionID: 1, UserID: 2})
+    require.NoError(t, err)
+    assert.Equal(t, entity.AuctionStatusEnded, resp.Status)
+
+    auction, err := f.auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, entity.AuctionStatusEnded, auction.Status)
+    assert.Equal(t, usd(1000), auction.CurrentPrice)
+    require.NotNil(t, auction.WinnerID)
+    require.NotNil(t, auction.WinnerBidID)
+    assert.Equal(t, int64(2), *auction.WinnerID)
+    assert.False(t, auction.BuyNowAvailable())
+
+    bid, err := f.bidRepo.GetByID(context.Background(), *auction.WinnerBidID)
+    require.NoError(t, err)
+    assert.Equal(t, usd(1000), bid.Amount)
+
+    require.NoError(t, f.payments.ProcessDue(context.Background(), time.Now()))
+    assert.Equal(t, usd(4000), f.balance(t, 2))
+    assert.Equal(t, usd(1000), f.balance(t, 1))
+
+    _, err = f.buyNow.Execute(context.Background(), &auctionDto.BuyNowRequest{AuctionID: 1, UserID: 2})
+    assert.Error(t, err, "auction is already closed")
+
+    f.dispatcher.Close()
+    assert.Equal(t, []auctionResult{{winner: 2, participants: []int64{2, 1}}}, f.notifier.results,
+        "the buyer and the seller hear once the purchase has committed")
+}
+
+func TestBuyNowRejectsSeller(t *testing.T) {
+    f := newBuyNowFixture()
+
+    _, err := f.buyNow.Execute(context.Background(), &auctionDto.BuyNowRequest{AuctionID: 1, UserID: 1})
+    assert.Error(t, err)
+}
+
+func TestBidAboveThresholdDisablesBuyNow(t *testing.T) {
+    f := newBuyNowFixture()
+
+    _, err := f.placeBid.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(400)})
+    require.NoError(t, err)
+    auction, err := f.auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.True(t, auction.BuyNowAvailable(), "bid below the threshold")
+
+    _, err = f.placeBid.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(600)})
+    require.NoError(t, err)
+    auction, err = f.auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.False(t, auction.BuyNowAvailable())
+
+    _, err = f.buyNow.Execute(context.Background(), &auctionDto.BuyNowRequest{AuctionID: 1, UserID: 2})
+    assert.Error(t, err)
+}
+
+func TestBuyNowHandler(t *testing.T) {
+    mockUC := new(mockBuyNowUC)
+    h := &handler.AuctionHandler{
+        BuyNowUC: mockUC,
+    }
+
+    ctx := context.Background()
+    expectedResp := createTestAuctionResponse()
+    expectedResp.Status = entity.AuctionStatusEnded
+    expectedResp.BuyNowPrice = usd(500)
+    mockUC.On("Execute", ctx, &auctionDto.BuyNowRequest{AuctionID: 1, UserID: 2}).Return(expectedResp, nil)
+
+    resp, err := h.BuyNow(ctx, &pb.BuyNowRequest{AuctionId: 1, UserId: 2})
+
+    assert.NoError(t, err)
+    assert.Equal(t, "ENDED", resp.Auction.Status)
+    assert.Equal(t, usd(500), fromPB(resp.Auction.BuyNowPrice))
+    assert.False(t, resp.Auction.BuyNowAvailable)
+    mockUC.AssertExpectations(t)
+}
@@ -0,0 +1,436 @@
+package tests
+
+import (
+    "context"
+    "sort"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    "auction-system/internal/application/digest"
+    auctionDTO "auction-system/internal/application/dto/auction"
+    notificationDTO "auction-system/internal/application/dto/notification"
+    "auction-system/internal/application/notifier"
+    auctionUC "auction-system/internal/application/usecase/auction"
+    notificationUC "auction-system/internal/application/usecase/notification"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/notification"
+)
+
+// digestMonday is 09:00 UTC on a Monday.
+var digestMonday = time.Date(2026, time.March, 9, 9, 0, 0, 0, time.UTC)
+
+type digestFixture struct {
+    *notificationFixture
+    auctions    *memoryAuctionRepo
+    bids        *memoryBidRepo
+    lots        *memoryLotRepo
+    watchlist   *memoryWatchlistRepo
+    digests     *memoryDigestRepo
+    notifier    *recordingNotifier
+}
+
+// newDigestFixture sends digests at 08:00 in each user's time zone.
+func newDigestFixture(auctions ...*entity.Auction) *digestFixture {
+    f := &digestFixture{
+        notificationFixture: newNotificationFixture(),
+        auctions:            newMemoryAuctionRepo(auctions...),
+        bids:                &memoryBidRepo{},
+        lots:                newMemoryLotRepo(),
+        notifier:            &recordingNotifier{},
+    }
+    f.watchlist = newMemoryWatchlistRepo(f.auctions)
+    f.digests = newMemoryDigestRepo(f.auctions, f.bids, f.lots)
+    return f
+}
+
+// run sends the digests due at now through notifier and waits until they
+// have been dispatched.
+func (f *digestFixture) run(t *testing.T, service notification.NotificationService, now time.Time) {
+    dispatcher := notifier.NewDispatcher(service, 64, 1, time.Second)
+    digests := digest.NewService(f.preferences, f.watchlist, f.digests, f.bids, dispatcher, &memoryTxManager{}, 8)
+    assert.NoError(t, digests.SendDue(context.Background(), now))
+    dispatcher.Close()
+}
+
+func (f *digestFixture) subscribe(t *testing.T, userID int64, frequency notification.DigestFrequency, timeZone string, categories ...string) {
+    p := notification.DefaultPreferences(userID)
+    p.Digest = frequency
+    p.TimeZone = timeZone
+    p.FollowedCategories = categories
+    require.NoError(t, f.preferences.Save(context.Background(), p))
+}
+
+func (f *digestFixture) watch(t *testing.T, userID int64, auctionIDs ...int64) {
+    for _, id := range auctionIDs {
+        require.NoError(t, f.watchlist.Add(context.Background(), userID, id))
+    }
+}
+
+func (f *digestFixture) bid(t *testing.T, auctionID, userID int64, amount entity.Money) {
+    require.NoError(t, f.bids.Create(context.Background(), &entity.Bid{AuctionID: auctionID, UserID: userID, Amount: amount, Quantity: 1}))
+}
+
+func (f *digestFixture) sendDue(t *testing.T, now time.Time) []*notification.DigestReport {
+    f.run(t, f.notifier, now)
+    f.notifier.mu.Lock()
+    defer f.notifier.mu.Unlock()
+    return append([]*notification.DigestReport(nil), f.notifier.digests...)
+}
+
+func digestAuction(id int64, status entity.AuctionStatus, endTime time.Time) *entity.Auction {
+    return &entity.Auction{ID: id, Type: entity.AuctionTypeEnglish, Status: status, CurrentPrice: usd(100), EndTime: endTime, Quantity: 1}
+}
+
+func auctionIDs(auctions []*entity.Auction) []int64 {
+    var ids []int64
+    for _, a := range auctions {
+        ids = append(ids, a.ID)
+    }
+    return ids
+}
+
+func TestDailyDigestIsSentOncePerDay(t *testing.T) {
+    // The watched auction ends at 03:00 on Thursday.
+    f := newDigestFixture(digestAuction(1, entity.AuctionStatusActive, digestMonday.Add(66*time.Hour)))
+    f.subscribe(t, 1, notification.DigestDaily, "UTC")
+    f.watch(t, 1, 1)
+
+    tuesday := time.Date(2026, time.March, 10, 7, 59, 0, 0, time.UTC)
+    assert.Empty(t, f.sendDue(t, tuesday), "nothing ends within a day, and it is before 08:00")
+    assert.Empty(t, f.sendDue(t, tuesday.Add(time.Minute)), "the watched auction still ends in more than a day")
+
+    wednesday := time.Date(2026, time.March, 11, 8, 0, 0, 0, time.UTC)
+    digests := f.sendDue(t, wednesday)
+    require.Len(t, digests, 1)
+    assert.Equal(t, notification.DigestDaily, digests[0].Frequency)
+    assert.Equal(t, time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC), digests[0].PeriodStart)
+    assert.Equal(t, []int64{1}, auctionIDs(digests[0].EndingSoon))
+
+    assert.Len(t, f.sendDue(t, wednesday.Add(6*time.Hour)), 1, "the day's digest was already sent")
+    assert.Len(t, f.sendDue(t, wednesday.Add(24*time.Hour)), 1, "the auction has ended by the next digest")
+}
+
+func TestDigestIsNotSentTwiceByConcurrentRuns(t *testing.T) {
+    f := newDigestFixture(digestAuction(1, entity.AuctionStatusActive, digestMonday.Add(time.Hour)))
+    f.subscribe(t, 1, notification.DigestDaily, "UTC")
+    f.watch(t, 1, 1)
+
+    // A second instance shares the record of sent digests.
+    done := make(chan struct{})
+    for range 2 {
+        go func() {
+            defer func() { done <- struct{}{} }()
+            f.run(t, f.notifier, digestMonday)
+        }()
+    }
+    <-done
+    <-done
+
+    assert.Len(t, f.notifier.digests, 1)
+}
+
+func TestDigestFollowsUserTimeZone(t *testing.T) {
+    // 22:30 UTC on Monday is 07:30 on Tuesday in Tokyo.
+    evening := time.Date(2026, time.March, 9, 22, 30, 0, 0, time.UTC)
+    f := newDigestFixture(digestAuction(1, entity.AuctionStatusActive, evening.Add(3*time.Hour)))
+    f.subscribe(t, 1, notification.DigestDaily, "Asia/Tokyo")
+    f.watch(t, 1, 1)
+
+    assert.Empty(t, f.sendDue(t, evening))
+
+    digests := f.sendDue(t, evening.Add(30*time.Minute))
+    require.Len(t, digests, 1)
+    tokyo, err := time.LoadLocation("Asia/Tokyo")
+    require.NoError(t, err)
+    assert.Equal(t, time.Date(2026, time.March, 10, 0, 0, 0, 0, tokyo), digests[0].PeriodStart)
+    require.Len(t, digests[0].EndingSoon, 1)
+    assert.Equal(t, tokyo, digests[0].EndingSoon[0].EndTime.Location(), "end times are shown in the user's time zone")
+    assert.Equal(t, 10, digests[0].EndingSoon[0].EndTime.Hour())
+}
+
+func TestWeeklyDigestIsSentOnMondays(t *testing.T) {
+    f := newDigestFixture(digestAuction(1, entity.AuctionStatusActive, digestMonday.Add(30*24*time.Hour)))
+    f.subscribe(t, 1, notification.DigestWeekly, "UTC")
+    f.bid(t, 1, 1, usd(100))
+
+    wednesday := digestMonday.Add(48 * time.Hour)
+    digests := f.sendDue(t, wednesday)
+    require.Len(t, digests, 1, "a digest missed on Monday is sent late")
+    assert.Equal(t, time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC), digests[0].PeriodStart)
+    assert.Equal(t, []int64{1}, auctionIDs(digests[0].Leading))
+
+    assert.Len(t, f.sendDue(t, wednesday.Add(4*24*time.Hour)), 1, "the next week starts on Monday")
+    assert.Len(t, f.sendDue(t, digestMonday.Add(7*24*time.Hour)), 2)
+}
+
+func TestDigestContents(t *testing.T) {
+    now := digestMonday
+    sealed := digestAuction(5, entity.AuctionStatusActive, now.Add(time.Hour))
+    sealed.Type = entity.AuctionTypeSealedFirstPrice
+    f := newDigestFixture(
+        digestAuction(1, entity.AuctionStatusActive, now.Add(20*time.Hour)),
+        digestAuction(2, entity.AuctionStatusActive, now.Add(48*time.Hour)),
+        digestAuction(3, entity.AuctionStatusEnded, now.Add(-time.Hour)),
+        digestAuction(4, entity.AuctionStatusActive, now.Add(5*time.Hour)),
+        sealed,
+        digestAuction(6, entity.AuctionStatusActive, now.Add(10*time.Hour)),
+    )
+    f.subscribe(t, 1, notification.DigestDaily, "UTC", "art", "books")
+    f.watch(t, 1, 1, 2, 3)
+
+    f.bid(t, 4, 1, usd(150))
+    f.bid(t, 4, 2, usd(140))
+    f.bid(t, 6, 1, usd(110))
+    f.bid(t, 6, 2, usd(120))
+    f.bid(t, 5, 1, usd(200))
+
+    for _, lot := range []*entity.Lot{
+        {ID: 1, Title: "Painting", Category: "art", CreatorID: 2, CreatedAt: now.Add(-time.Hour)},
+        {ID: 2, Title: "Own sketch", Category: "art", CreatorID: 1, CreatedAt: now.Add(-time.Hour)},
+        {ID: 3, Title: "Old atlas", Category: "books", CreatorID: 2, CreatedAt: now.Add(-48 * time.Hour)},
+        {ID: 4, Title: "Vase", Category: "pottery", CreatorID: 2, CreatedAt: now.Add(-time.Hour)},
+        {ID: 5, Title: "Novel", Category: "books", CreatorID: 3, CreatedAt: now.Add(-2 * time.Hour)},
+    } {
+        require.NoError(t, f.lots.Create(context.Background(), lot))
+    }
+
+    digests := f.sendDue(t, now)
+    require.Len(t, digests, 1)
+    report := digests[0]
+    assert.Equal(t, int64(1), report.UserID)
+    assert.Equal(t, []int64{1}, auctionIDs(report.EndingSoon), "only watched running auctions ending within the day")
+    assert.Equal(t, []int64{4}, auctionIDs(report.Leading))
+    assert.Equal(t, []int64{6}, auctionIDs(report.Outbid), "bids on sealed auctions are not compared")
+
+    var titles []string
+    for _, lot := range report.NewLots {
+        titles = append(titles, lot.Title)
+    }
+    assert.Equal(t, []string{"Painting", "Novel"}, titles, "new lots in followed categories by others, newest first")
+}
+
+func TestEmptyDigestIsNotSent(t *testing.T) {
+    f := newDigestFixture(digestAuction(1, entity.AuctionStatusActive, digestMonday.Add(3*time.Hour)))
+    f.subscribe(t, 1, notification.DigestDaily, "UTC")
+
+    assert.Empty(t, f.sendDue(t, digestMonday))
+
+    f.watch(t, 1, 1)
+    assert.Empty(t, f.sendDue(t, digestMonday.Add(time.Hour)), "the day's digest counts as sent")
+}
+
+func TestUsersWithoutDigestGetNone(t *testing.T) {
+    f := newDigestFixture(digestAuction(1, entity.AuctionStatusActive, digestMonday.Add(3*time.Hour)))
+    f.subscribe(t, 1, notification.DigestNone, "UTC")
+    f.watch(t, 1, 1)
+
+    assert.Empty(t, f.sendDue(t, digestMonday))
+}
+
+func TestDigestIsDeliveredThroughPreferredChannels(t *testing.T) {
+    f := newDigestFixture(digestAuction(7, entity.AuctionStatusActive, digestMonday.Add(4*time.Hour)))
+    f.route(t)
+    f.subscribe(t, 1, notification.DigestDaily, "UTC")
+    f.setChannels(t, 1, notification.Digest, notification.ChannelInApp, notification.ChannelEmail)
+    f.watch(t, 1, 7)
+    f.run(t, f.router, digestMonday)
+
+    assert.Equal(t, []notification.NotificationType{notification.Digest}, f.inboxTypes(t, 1))
+    emails := f.sink.emails()
+    require.Len(t, emails, 1)
+    assert.Equal(t, []string{"alice@auction.test"}, emails[0].to)
+    assert.Equal(t, "Your daily auction digest", emails[0].subject)
+    assert.Contains(t, emails[0].text, "Watched auctions ending soon:")
+    assert.Contains(t, emails[0].text, "- Auction #7 at 100.00 USD, ends 2026-03-09 13:00 UTC")
+    assert.NotContains(t, emails[0].text, "You are leading:")
+    assert.Empty(t, f.webhookPayloads(t))
+}
+
+func TestWatchlist(t *testing.T) {
+    auctions := newMemoryAuctionRepo(
+        digestAuction(1, entity.AuctionStatusActive, digestMonday.Add(48*time.Hour)),
+        digestAuction(2, entity.AuctionStatusPending, digestMonday.Add(24*time.Hour)),
+        digestAuction(3, entity.AuctionStatusEnded, digestMonday),
+    )
+    users := newMemoryUserRepo(userWithBalance(1, usd(0)))
+    watchlist := newMemoryWatchlistRepo(auctions)
+    add := auctionUC.NewAddToWatchlistUseCase(users, auctions, watchlist)
+    remove := auctionUC.NewRemoveFromWatchlistUseCase(watchlist)
+    get := auctionUC.NewGetWatchlistUseCase(users, watchlist)
+    ctx := context.Background()
+
+    _, err := add.Execute(ctx, &auctionDTO.WatchlistRequest{UserID: 1, AuctionID: 1})
+    require.NoError(t, err)
+    _, err = add.Execute(ctx, &auctionDTO.WatchlistRequest{UserID: 1, AuctionID: 2})
+    require.NoError(t, err)
+    resp, err := add.Execute(ctx, &auctionDTO.WatchlistRequest{UserID: 1, AuctionID: 1})
+    require.NoError(t, err, "adding twice is harmless")
+    require.Len(t, resp.Auctions, 2)
+    assert.Equal(t, int64(2), resp.Auctions[0].ID, "ending soonest first")
+
+    _, err = add.Execute(ctx, &auctionDTO.WatchlistRequest{UserID: 1, AuctionID: 3})
+    assert.Error(t, err, "ended auctions cannot be watched")
+    _, err = add.Execute(ctx, &auctionDTO.WatchlistRequest{UserID: 1, AuctionID: 9})
+    assert.Error(t, err, "unknown auction")
+    _, err = add.Execute(ctx, &auctionDTO.WatchlistRequest{UserID: 42, AuctionID: 1})
+    assert.Error(t, err, "unknown user")
+
+    resp, err = remove.Execute(ctx, &auctionDTO.WatchlistRequest{UserID: 1, AuctionID: 2})
+    require.NoError(t, err)
+    require.Len(t, resp.Auctions, 1)
+    _, err = remove.Execute(ctx, &auctionDTO.WatchlistRequest{UserID: 1, AuctionID: 2})
+    assert.Error(t, err, "not on the watchlist")
+
+    resp, err = get.Execute(ctx, 1)
+    require.NoError(t, err)
+    require.Len(t, resp.Auctions, 1)
+    assert.Equal(t, int64(1), resp.Auctions[0].ID)
+}
+
+func TestFollowedCategories(t *testing.T) {
+    preferences := &memoryNotificationPreferenceRepo{}
+    users := newMemoryUserRepo(userWithBalance(1, usd(0)))
+    update := notificationUC.NewUpdatePreferencesUseCase(users, preferences)
+    ctx := context.Background()
+
+    resp, err := update.Execute(ctx, &notificationDTO.UpdatePreferencesRequest{
+        UserID:             1,
+        Digest:             "WEEKLY",
+        FollowedCategories: []string{" art ", "books", "art"},
+    })
+    require.NoError(t, err)
+    assert.Equal(t, []string{"art", "books"}, resp.FollowedCategories)
+
+    _, err = update.Execute(ctx, &notificationDTO.UpdatePreferencesRequest{UserID: 1, FollowedCategories: []string{" "}})
+    assert.Error(t, err)
+
+    subscribed, err := preferences.GetWithDigest(ctx)
+    require.NoError(t, err)
+    require.Len(t, subscribed, 1)
+    assert.Equal(t, notification.DigestWeekly, subscribed[0].Digest)
+}
+
+type memoryWatchlistRepo struct {
+    mu       sync.Mutex
+    auctions *memoryAuctionRepo
+    watched  map[int64][]int64
+}
+
+func newMemoryWatchlistRepo(auctions *memoryAuctionRepo) *memoryWatchlistRepo {
+    return &memoryWatchlistRepo{auctions: auctions, watched: make(map[int64][]int64)}
+}
+
+func (r *memoryWatchlistRepo) Add(ctx context.Context, userID, auctionID int64) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, id := range r.watched[userID] {
+        if id == auctionID {
+            return nil
+        }
+    }
+    r.watched[userID] = append(r.watched[userID], auctionID)
+    return nil
+}
+
+func (r *memoryWatchlistRepo) Remove(ctx context.Context, userID, auctionID int64) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for i, id := range r.watched[userID] {
+        if id == auctionID {
+            r.watched[userID] = append(r.watched[userID][:i], r.watched[userID][i+1:]...)
+            return nil
+        }
+    }
+    return errors.NewNotFoundError("auction is not on the watchlist")
+}
+
+func (r *memoryWatchlistRepo) GetByUserID(ctx context.Context, userID int64) ([]*entity.Auction, error) {
+    r.mu.Lock()
+    ids := append([]int64{}, r.watched[userID]...)
+    r.mu.Unlock()
+
+    var result []*entity.Auction
+    for _, id := range ids {
+        a, err := r.auctions.GetByID(ctx, id)
+        if err != nil {
+            return nil, err
+        }
+        result = append(result, a)
+    }
+    sort.SliceStable(result, func(i, j int) bool { return result[i].EndTime.Before(result[j].EndTime) })
+    return result, nil
+}
+
+type memoryDigestKey struct {
+    userID      int64
+    frequency   notification.DigestFrequency
+    periodStart string
+}
+
+type memoryDigestRepo struct {
+    mu       sync.Mutex
+    auctions *memoryAuctionRepo
+    bids     *memoryBidRepo
+    lots     *memoryLotRepo
+    claimed  map[memoryDigestKey]bool
+}
+
+func newMemoryDigestRepo(auctions *memoryAuctionRepo, bids *memoryBidRepo, lots *memoryLotRepo) *memoryDigestRepo {
+    return &memoryDigestRepo{auctions: auctions, bids: bids, lots: lots, claimed: make(map[memoryDigestKey]bool)}
+}
+
+func (r *memoryDigestRepo) Claim(ctx context.Context, userID int64, frequency notification.DigestFrequency, periodStart time.Time) (bool, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    key := memoryDigestKey{userID: userID, frequency: frequency, periodStart: periodStart.Format("2006-01-02")}
+    if r.claimed[key] {
+        return false, nil
+    }
+    r.claimed[key] = true
+    return true, nil
+}
+
+func (r *memoryDigestRepo) GetBidAuctions(ctx context.Context, userID int64) ([]*entity.Auction, error) {
+    r.bids.mu.Lock()
+    bidOn := make(map[int64]bool)
+    for _, b := range r.bids.bids {
+        if b.UserID == userID {
+            bidOn[b.AuctionID] = true
+        }
+    }
+    r.bids.mu.Unlock()
+
+    r.auctions.mu.Lock()
+    defer r.auctions.mu.Unlock()
+    var result []*entity.Auction
+    for id := range bidOn {
+        a, ok := r.auctions.auctions[id]
+        if ok && a.Status == entity.AuctionStatusActive && !a.IsSealed() && !a.IsMultiUnit() {
+            copied := *a
+            result = append(result, &copied)
+        }
+    }
+    sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+    return result, nil
+}
+
+func (r *memoryDigestRepo) GetNewLots(ctx context.Context, categories []string, since time.Time) ([]*entity.Lot, error) {
+    r.lots.mu.Lock()
+    defer r.lots.mu.Unlock()
+    var result []*entity.Lot
+    for _, l := range r.lots.lots {
+        for _, category := range categories {
+            if l.Category == category && l.CreatedAt.After(since) {
+                copied := *l
+                result = append(result, &copied)
+            }
+        }
+    }
+    sort.Slice(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+    return result, nil
+}
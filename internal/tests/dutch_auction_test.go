@@ -0,0 +1,140 @@
+package tests
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    auctionDto "auction-system/internal/application/dto/auction"
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/settlement"
+    auctionUC "auction-system/internal/application/usecase/auction"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/worker"
+)
+
+func dutchAuction(startTime time.Time) *entity.Auction {
+    return &entity.Auction{
+        ID:                1,
+        LotID:             1,
+        Type:              entity.AuctionTypeDutch,
+        StartPrice:        usd(1000),
+        CurrentPrice:      usd(1000),
+        PriceDecrement:    usd(100),
+        DecrementInterval: 10 * time.Minute,
+        FloorPrice:        usd(500),
+        Status:            entity.AuctionStatusActive,
+        StartTime:         startTime,
+        EndTime:           startTime.Add(24 * time.Hour),
+    }
+}
+
+func TestDutchPriceAt(t *testing.T) {
+    start := time.Date(2024, 3, 25, 10, 0, 0, 0, time.UTC)
+    a := dutchAuction(start)
+
+    assert.Equal(t, usd(1000), a.DutchPriceAt(start.Add(-time.Minute)))
+    assert.Equal(t, usd(1000), a.DutchPriceAt(start.Add(9*time.Minute)))
+    assert.Equal(t, usd(900), a.DutchPriceAt(start.Add(10*time.Minute)))
+    assert.Equal(t, usd(700), a.DutchPriceAt(start.Add(35*time.Minute)))
+    assert.Equal(t, usd(500), a.DutchPriceAt(start.Add(10*time.Hour)), "price stops at the floor")
+}
+
+func TestDutchPriceWorkerLowersPrice(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(dutchAuction(time.Now().Add(-25 * time.Minute)))
+
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+    go worker.NewDutchPriceWorker(auctionRepo, &memoryTxManager{}, eventbus.NewAuctionBus(16, 0), 10*time.Millisecond).Start(ctx)
+
+    require.Eventually(t, func() bool {
+        auction, err := auctionRepo.GetByID(context.Background(), 1)
+        return err == nil && auction.CurrentPrice.Cmp(usd(800)) == 0
+    }, time.Second, 10*time.Millisecond)
+}
+
+func TestAcceptPriceFirstAcceptorWins(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(dutchAuction(time.Now().Add(-25 * time.Minute)))
+    userRepo := newMemoryUserRepo(
+        userWithBalance(1, usd(0)),
+        userWithBalance(2, usd(5000)),
+        userWithBalance(3, usd(5000)),
+    )
+    lotRepo := newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1})
+    bidRepo := &memoryBidRepo{}
+    holdRepo := &memoryHoldRepo{}
+    paymentRepo := &memoryPaymentRepo{}
+    uc := auctionUC.NewAcceptPriceUseCase(auctionRepo, bidRepo, lotRepo, &memoryTxManager{},
+        escrow.NewService(holdRepo, bidRepo, userRepo, userRepo),
+        settlement.NewService(lotRepo, &memoryWinnerRepo{}, holdRepo, paymentRepo, escrow.NewService(holdRepo, bidRepo, userRepo, userRepo), entity.FeeSchedule{}, newInvoiceIssuer(userRepo)), eventbus.NewAuctionBus(16, 0), newTestDispatcher())
+
+    resp, err := uc.Execute(context.Background(), &auctionDto.AcceptPriceRequest{AuctionID: 1, UserID: 2})
+    require.NoError(t, err)
+    assert.Equal(t, entity.AuctionStatusEnded, resp.Status)
+    assert.Equal(t, usd(800), resp.CurrentPrice, "stored price lags, buyer pays the schedule")
+
+    _, err = uc.Execute(context.Background(), &auctionDto.AcceptPriceRequest{AuctionID: 1, UserID: 3})
+    assert.Error(t, err)
+
+    auction, err := auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    require.NotNil(t, auction.WinnerID)
+    assert.Equal(t, int64(2), *auction.WinnerID)
+
+    payments := newPaymentProcessor(paymentRepo, auctionRepo, lotRepo, holdRepo, userRepo, &fakeGateway{}, &recordingNotifier{})
+    require.NoError(t, payments.ProcessDue(context.Background(), time.Now()))
+    buyer, err := userRepo.GetByID(context.Background(), 2)
+    require.NoError(t, err)
+    assert.Equal(t, usd(4200), buyer.Wallet("USD").Balance)
+    seller, err := userRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, usd(800), seller.Wallet("USD").Balance)
+}
+
+func TestPlaceBidRejectsDutchAuction(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(dutchAuction(time.Now()))
+    userRepo := newMemoryUserRepo(userWithBalance(2, usd(5000)))
+    bidRepo := &memoryBidRepo{}
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+
+    _, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(1100)})
+    assert.Error(t, err)
+}
+
+func TestCreateDutchAuctionValidation(t *testing.T) {
+    uc := auctionUC.NewCreateAuctionUseCase(newMemoryAuctionRepo(), newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1}))
+    now := time.Now()
+    newRequest := func() *auctionDto.CreateAuctionRequest {
+        return &auctionDto.CreateAuctionRequest{
+            LotID:             1,
+            Type:              entity.AuctionTypeDutch,
+            StartPrice:        usd(1000),
+            MinStep:           usd(10),
+            StartTime:         now,
+            EndTime:           now.Add(time.Hour),
+            PriceDecrement:    usd(50),
+            DecrementInterval: time.Minute,
+            FloorPrice:        usd(400),
+        }
+    }
+
+    resp, err := uc.Execute(context.Background(), newRequest())
+    require.NoError(t, err)
+    assert.Equal(t, entity.AuctionTypeDutch, resp.Type)
+
+    req := newRequest()
+    req.FloorPrice = usd(1000)
+    _, err = uc.Execute(context.Background(), req)
+    assert.Error(t, err)
+
+    req = newRequest()
+    req.DecrementInterval = 0
+    _, err = uc.Execute(context.Background(), req)
+    assert.Error(t, err)
+}
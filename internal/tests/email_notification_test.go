@@ -0,0 +1,324 @@
+package tests
+
+import (
+    "bufio"
+    "context"
+    "io"
+    "mime"
+    "mime/multipart"
+    "net"
+    "net/mail"
+    "os"
+    "path/filepath"
+    "strings"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    notificationInfra "auction-system/internal/infrastructure/notification"
+)
+
+// sentEmail is a message received by smtpSink.
+type sentEmail struct {
+    auth    string
+    from    string
+    to      []string
+    subject string
+    text    string
+    html    string
+}
+
+// smtpSink is a local SMTP server that keeps the messages it receives. It
+// rejects recipients in reject.
+type smtpSink struct {
+    listener net.Listener
+    reject   map[string]bool
+
+    mu       sync.Mutex
+    received []sentEmail
+}
+
+func newSMTPSink(t *testing.T, reject ...string) *smtpSink {
+    listener, err := net.Listen("tcp", "127.0.0.1:0")
+    require.NoError(t, err)
+    s := &smtpSink{listener: listener, reject: make(map[string]bool)}
+    for _, address := range reject {
+        s.reject[address] = true
+    }
+    t.Cleanup(func() { listener.Close() })
+
+    go func() {
+        for {
+            conn, err := listener.Accept()
+            if err != nil {
+                return
+            }
+            go s.serve(t, conn)
+        }
+    }()
+    return s
+}
+
+func (s *smtpSink) config() notificationInfra.SMTPConfig {
+    addr := s.listener.Addr().(*net.TCPAddr)
+    return notificationInfra.SMTPConfig{
+        Host:     "127.0.0.1",
+        Port:     addr.Port,
+        Username: "mailer",
+        Password: "secret",
+        From:     "Auctions <noreply@auction.test>",
+        TLS:      notificationInfra.SMTPTLSNone,
+    }
+}
+
+func (s *smtpSink) serve(t *testing.T, conn net.Conn) {
+    defer conn.Close()
+    r := bufio.NewReader(conn)
+    reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+    reply("220 sink ready")
+    var current sentEmail
+    for {
+        line, err := r.ReadString('\n')
+        if err != nil {
+            return
+        }
+        command := strings.TrimSpace(line)
+        verb := strings.ToUpper(strings.SplitN(command, " ", 2)[0])
+        switch {
+        case verb == "EHLO":
+            reply("250-sink")
+            reply("250 AUTH PLAIN")
+        case verb == "AUTH":
+            current.auth = command
+            reply("235 authenticated")
+        case strings.HasPrefix(strings.ToUpper(command), "MAIL FROM:"):
+            current.from = strings.Trim(command[len("MAIL FROM:"):], "<>")
+            reply("250 ok")
+        case strings.HasPrefix(strings.ToUpper(command), "RCPT TO:"):
+            to := strings.Trim(command[len("RCPT TO:"):], "<>")
+            if s.reject[to] {
+                reply("550 no such mailbox")
+                continue
+            }
+            current.to = append(current.to, to)
+            reply("250 ok")
+        case verb == "DATA":
+            reply("354 go ahead")
+            var data strings.Builder
+            for {
+                line, err := r.ReadString('\n')
+                if err != nil {
+                    return
+                }
+                if line == ".\r\n" {
+                    break
+                }
+                data.WriteString(strings.TrimPrefix(line, "."))
+            }
+            parseSentEmail(t, &current, data.String())
+            s.mu.Lock()
+            s.received = append(s.received, current)
+            s.mu.Unlock()
+            current = sentEmail{auth: current.auth}
+            reply("250 queued")
+        case verb == "RSET":
+            current = sentEmail{auth: current.auth}
+            reply("250 ok")
+        case verb == "QUIT":
+            reply("221 bye")
+            return
+        default:
+            reply("502 not implemented")
+        }
+    }
+}
+
+func parseSentEmail(t *testing.T, email *sentEmail, data string) {
+    message, err := mail.ReadMessage(strings.NewReader(data))
+    require.NoError(t, err)
+    email.subject, err = new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+    require.NoError(t, err)
+
+    _, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+    require.NoError(t, err)
+    parts := multipart.NewReader(message.Body, params["boundary"])
+    for {
+        part, err := parts.NextPart()
+        if err == io.EOF {
+            return
+        }
+        require.NoError(t, err)
+        content, err := io.ReadAll(part)
+        require.NoError(t, err)
+        if strings.HasPrefix(part.Header.Get("Content-Type"), "text/html") {
+            email.html = string(content)
+        } else {
+            email.text = string(content)
+        }
+    }
+}
+
+func (s *smtpSink) emails() []sentEmail {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    return append([]sentEmail(nil), s.received...)
+}
+
+func emailUser(id int64, name, email string) *entity.User {
+    return &entity.User{ID: id, Username: name, Email: email}
+}
+
+func newEmailNotifier(t *testing.T, sink *smtpSink, locale string) notification.NotificationService {
+    templates, err := notificationInfra.NewEmailTemplates(locale)
+    require.NoError(t, err)
+    mailer, err := notificationInfra.NewSMTPMailer(sink.config())
+    require.NoError(t, err)
+    users := newMemoryUserRepo(
+        emailUser(1, "alice", "alice@auction.test"),
+        emailUser(2, "bob", "bob@auction.test"),
+        emailUser(3, "carol", ""),
+        emailUser(4, "dave", "dave@auction.test"),
+    )
+    return notificationInfra.NewEmailNotificationAdapter(users, templates, mailer)
+}
+
+func TestEmailAuctionResults(t *testing.T) {
+    sink := newSMTPSink(t)
+    notifier := newEmailNotifier(t, sink, "en")
+
+    auction := &entity.Auction{ID: 7, CurrentPrice: usd(250), Winners: []*entity.AuctionWinner{{UserID: 1, Quantity: 1, UnitPrice: usd(250)}}}
+    require.NoError(t, notifier.NotifyAuctionResults(context.Background(), auction, 1, []int64{1, 2, 3}))
+
+    emails := sink.emails()
+    require.Len(t, emails, 2, "carol has no email address")
+    won, lost := emails[0], emails[1]
+
+    assert.Equal(t, "noreply@auction.test", won.from)
+    assert.Equal(t, []string{"alice@auction.test"}, won.to)
+    assert.Contains(t, won.auth, "PLAIN")
+    assert.Equal(t, "You won auction #7", won.subject)
+    assert.Contains(t, won.text, "Hello, alice!")
+    assert.Contains(t, won.text, "for 250.00 USD")
+    assert.Contains(t, won.html, "<p>Hello, alice!</p>")
+    assert.Contains(t, won.html, "for 250.00 USD")
+
+    assert.Equal(t, []string{"bob@auction.test"}, lost.to)
+    assert.Equal(t, "Auction #7 has ended", lost.subject)
+}
+
+func TestEmailAuctionWonCountsUnits(t *testing.T) {
+    sink := newSMTPSink(t)
+    notifier := newEmailNotifier(t, sink, "en")
+
+    auction := &entity.Auction{ID: 8, Quantity: 5, CurrentPrice: usd(120), Winners: []*entity.AuctionWinner{
+        {UserID: 2, Quantity: 2, UnitPrice: usd(120)},
+        {UserID: 1, Quantity: 3, UnitPrice: usd(120)},
+    }}
+    require.NoError(t, notifier.NotifyAuctionResults(context.Background(), auction, 2, []int64{2}))
+
+    emails := sink.emails()
+    require.Len(t, emails, 1)
+    assert.Contains(t, emails[0].text, "you won 2 units in auction #8 for 240.00 USD")
+}
+
+func TestEmailTemplatesAreLocalized(t *testing.T) {
+    sink := newSMTPSink(t)
+    notifier := newEmailNotifier(t, sink, "ru")
+
+    require.NoError(t, notifier.NotifyTransactionStatus(context.Background(), 2, usd(40), false))
+
+    emails := sink.emails()
+    require.Len(t, emails, 1)
+    assert.Equal(t, "Операция на 40.00 USD не выполнена", emails[0].subject)
+    assert.Contains(t, emails[0].text, "Здравствуйте, bob!")
+    assert.Contains(t, emails[0].html, "Проверьте баланс кошелька")
+}
+
+func TestEmailHTMLIsEscaped(t *testing.T) {
+    templates, err := notificationInfra.NewEmailTemplates("en")
+    require.NoError(t, err)
+
+    email, err := templates.Render(notification.Outbid, &notificationInfra.EmailData{
+        User:    emailUser(1, "<b>eve</b>", "eve@auction.test"),
+        Auction: &entity.Auction{ID: 3},
+        Bid:     &entity.Bid{Amount: usd(120)},
+    })
+    require.NoError(t, err)
+    assert.Equal(t, "eve@auction.test", email.To)
+    assert.Contains(t, email.HTML, "&lt;b&gt;eve&lt;/b&gt;")
+    assert.Contains(t, email.Text, "<b>eve</b>")
+}
+
+func TestEmailFailureDoesNotStopOtherRecipients(t *testing.T) {
+    sink := newSMTPSink(t, "alice@auction.test")
+    notifier := newEmailNotifier(t, sink, "en")
+
+    auction := &entity.Auction{ID: 7, StartPrice: usd(100), EndTime: time.Now().Add(time.Hour)}
+    err := notifier.NotifyAuctionStarted(context.Background(), auction, []int64{1, 2})
+    require.Error(t, err)
+    assert.Contains(t, err.Error(), "user 1")
+
+    emails := sink.emails()
+    require.Len(t, emails, 1)
+    assert.Equal(t, []string{"bob@auction.test"}, emails[0].to)
+    assert.Equal(t, "Auction #7 has started", emails[0].subject)
+}
+
+func TestSMTPMailerGivesUpWithContext(t *testing.T) {
+    listener, err := net.Listen("tcp", "127.0.0.1:0")
+    require.NoError(t, err)
+    defer listener.Close()
+    go func() {
+        // Accepts but never greets.
+        conn, err := listener.Accept()
+        if err == nil {
+            defer conn.Close()
+            io.Copy(io.Discard, conn)
+        }
+    }()
+
+    mailer, err := notificationInfra.NewSMTPMailer(notificationInfra.SMTPConfig{
+        Host: "127.0.0.1",
+        Port: listener.Addr().(*net.TCPAddr).Port,
+        From: "noreply@auction.test",
+        TLS:  notificationInfra.SMTPTLSNone,
+    })
+    require.NoError(t, err)
+
+    ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+    defer cancel()
+    err = mailer.Send(ctx, &notificationInfra.Email{To: "alice@auction.test", Subject: "Hi", Text: "Hi"})
+    assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestLoadEmailTemplatesFromDirectory(t *testing.T) {
+    dir := t.TempDir()
+    _, err := notificationInfra.LoadEmailTemplates(dir, "de")
+    assert.Error(t, err, "no templates for the locale")
+
+    require.NoError(t, os.MkdirAll(filepath.Join(dir, "de"), 0o755))
+    require.NoError(t, os.WriteFile(filepath.Join(dir, "de", "layout.html.tmpl"), []byte(`{{template "content" .}}`), 0o644))
+    for _, name := range []string{"auction_started", "auction_closed", "auction_won", "new_bid", "outbid", "transaction_complete", "transaction_failed", "digest"} {
+        text := `{{define "subject"}}Auktion {{.Auction.ID}}{{end}}Hallo {{.User.Username}}`
+        require.NoError(t, os.WriteFile(filepath.Join(dir, "de", name+".txt.tmpl"), []byte(text), 0o644))
+        require.NoError(t, os.WriteFile(filepath.Join(dir, "de", name+".html.tmpl"), []byte(`{{define "content"}}<p>Hallo</p>{{end}}`), 0o644))
+    }
+
+    templates, err := notificationInfra.LoadEmailTemplates(dir, "de")
+    require.NoError(t, err)
+    email, err := templates.Render(notification.AuctionWon, &notificationInfra.EmailData{User: emailUser(1, "alice", "alice@auction.test"), Auction: &entity.Auction{ID: 4}})
+    require.NoError(t, err)
+    assert.Equal(t, "Auktion 4", email.Subject)
+    assert.Equal(t, "Hallo alice\n", email.Text)
+    assert.Equal(t, "<p>Hallo</p>", email.HTML)
+
+    require.NoError(t, os.Remove(filepath.Join(dir, "de", "outbid.html.tmpl")))
+    _, err = notificationInfra.LoadEmailTemplates(dir, "de")
+    assert.Error(t, err, "every type needs its templates")
+}
@@ -0,0 +1,199 @@
+package tests
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/bid"
+    withdrawalDTO "auction-system/internal/application/dto/withdrawal"
+    userUC "auction-system/internal/application/usecase/user"
+    withdrawalUC "auction-system/internal/application/usecase/withdrawal"
+    "auction-system/internal/domain/entity"
+)
+
+// escrowBidders is a market where user 2 has 500 USD to bid with and user
+// 3 has 5000.
+func escrowBidders() *market {
+    return newMarket(
+        userWithBalance(2, usd(500)),
+        userWithBalance(3, usd(5000)),
+    )
+}
+
+func activeAuction(id int64) *entity.Auction {
+    return &entity.Auction{
+        ID:           id,
+        LotID:        1,
+        StartPrice:   usd(100),
+        MinStep:      usd(10),
+        CurrentPrice: usd(100),
+        Status:       entity.AuctionStatusActive,
+        EndTime:      time.Now().Add(time.Hour),
+    }
+}
+
+func TestHoldFollowsLeadingBid(t *testing.T) {
+    f := newBidding(escrowBidders(), activeAuction(1))
+    ctx := context.Background()
+
+    _, err := f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(200)})
+    require.NoError(t, err)
+    assert.Equal(t, usd(200), f.held(t, 2))
+
+    _, err = f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 1, UserID: 3, Amount: usd(300)})
+    require.NoError(t, err)
+    assert.Equal(t, usd(0), f.held(t, 2), "outbid user gets the funds back")
+    assert.Equal(t, usd(300), f.held(t, 3))
+
+    _, err = f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 1, UserID: 3, Amount: usd(400)})
+    require.NoError(t, err)
+    assert.Equal(t, usd(400), f.held(t, 3), "raising own bid replaces the hold")
+}
+
+func TestHoldsPreventOvercommit(t *testing.T) {
+    f := newBidding(escrowBidders(), activeAuction(1), activeAuction(2))
+    ctx := context.Background()
+
+    _, err := f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(300)})
+    require.NoError(t, err)
+
+    _, err = f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 2, UserID: 2, Amount: usd(300)})
+    assert.Error(t, err, "only 200 is still available")
+
+    _, err = f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(450)})
+    assert.NoError(t, err, "the hold on the same auction counts towards the new bid")
+}
+
+func TestProxyStopsAtAvailableFunds(t *testing.T) {
+    f := newBidding(escrowBidders(), activeAuction(1), activeAuction(2))
+    ctx := context.Background()
+
+    _, err := f.setMaxBid.Execute(ctx, &dto.SetMaxBidRequest{AuctionID: 1, UserID: 2, MaxAmount: usd(500)})
+    require.NoError(t, err)
+    assert.Equal(t, usd(110), f.held(t, 2))
+
+    // The same balance now also backs a bid on another auction.
+    _, err = f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 2, UserID: 2, Amount: usd(300)})
+    require.NoError(t, err)
+    assert.Equal(t, usd(410), f.held(t, 2))
+
+    _, err = f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 1, UserID: 3, Amount: usd(150)})
+    require.NoError(t, err)
+    assert.Equal(t, usd(460), f.held(t, 2), "the proxy still has 200 to bid with")
+
+    _, err = f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 1, UserID: 3, Amount: usd(250)})
+    require.NoError(t, err)
+    assert.Equal(t, int64(3), f.leader(t).UserID, "the proxy cannot go past the funds left")
+    assert.Equal(t, usd(300), f.held(t, 2))
+}
+
+func TestGetUserShowsAvailableBalance(t *testing.T) {
+    f := newBidding(escrowBidders(), activeAuction(1))
+    _, err := f.placeBid.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(300)})
+    require.NoError(t, err)
+
+    user, err := userUC.NewGetUserUseCase(f.userRepo, f.userRepo, f.holdRepo).Execute(context.Background(), 2)
+    require.NoError(t, err)
+    require.Len(t, user.Wallets, 1)
+    assert.Equal(t, "USD", user.Wallets[0].Currency)
+    assert.Equal(t, usd(500), user.Wallets[0].Balance)
+    assert.Equal(t, usd(300), user.Wallets[0].HeldBalance)
+    assert.Equal(t, usd(200), user.Wallets[0].AvailableBalance)
+
+    request := withdrawalUC.NewRequestWithdrawalUseCase(f.userRepo, f.userRepo, f.holdRepo, &memoryWithdrawalRepo{}, &memoryTxManager{}, newMemoryLedger(f.userRepo))
+    _, err = request.Execute(context.Background(), &withdrawalDTO.RequestWithdrawalRequest{UserID: 2, Amount: usd(300), Destination: "DE89370400440532013000"})
+    assert.Error(t, err, "held funds cannot be withdrawn")
+    _, err = request.Execute(context.Background(), &withdrawalDTO.RequestWithdrawalRequest{UserID: 2, Amount: usd(200), Destination: "DE89370400440532013000"})
+    assert.NoError(t, err)
+}
+
+func TestCloseAuctionReleasesHolds(t *testing.T) {
+    for _, reserve := range []entity.Money{{}, usd(500)} {
+        f := newCloserFixture(endedAuction(reserve), &entity.Bid{AuctionID: 1, UserID: 2, Amount: usd(300)})
+        require.NoError(t, f.holdRepo.Upsert(context.Background(), &entity.FundHold{AuctionID: 1, UserID: 2, Amount: usd(300)}))
+
+        f.run(t, 1)
+        f.collect(t)
+
+        assert.Equal(t, usd(0), f.held(t, 2))
+    }
+}
+
+type memoryHoldRepo struct {
+    mu    sync.Mutex
+    holds []*entity.FundHold
+}
+
+func (r *memoryHoldRepo) Upsert(ctx context.Context, hold *entity.FundHold) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, h := range r.holds {
+        if h.AuctionID == hold.AuctionID && h.UserID == hold.UserID {
+            h.Amount = hold.Amount
+            h.UpdatedAt = time.Now()
+            hold.ID = h.ID
+            return nil
+        }
+    }
+    hold.ID = int64(len(r.holds) + 1)
+    hold.CreatedAt = time.Now()
+    hold.UpdatedAt = hold.CreatedAt
+    copied := *hold
+    r.holds = append(r.holds, &copied)
+    return nil
+}
+
+func (r *memoryHoldRepo) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.FundHold, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.FundHold
+    for _, h := range r.holds {
+        if h.AuctionID == auctionID {
+            copied := *h
+            result = append(result, &copied)
+        }
+    }
+    return result, nil
+}
+
+func (r *memoryHoldRepo) GetTotalByUserID(ctx context.Context, userID int64, currency string) (entity.Money, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    total := entity.NewMoney(0, currency)
+    for _, h := range r.holds {
+        if h.UserID == userID && h.Amount.Currency == currency {
+            total = total.Add(h.Amount)
+        }
+    }
+    return total, nil
+}
+
+func (r *memoryHoldRepo) Delete(ctx context.Context, auctionID, userID int64) error {
+    return r.deleteWhere(func(h *entity.FundHold) bool {
+        return h.AuctionID == auctionID && h.UserID == userID
+    })
+}
+
+func (r *memoryHoldRepo) DeleteByAuctionID(ctx context.Context, auctionID int64) error {
+    return r.deleteWhere(func(h *entity.FundHold) bool {
+        return h.AuctionID == auctionID
+    })
+}
+
+func (r *memoryHoldRepo) deleteWhere(match func(h *entity.FundHold) bool) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    kept := r.holds[:0]
+    for _, h := range r.holds {
+        if !match(h) {
+            kept = append(kept, h)
+        }
+    }
+    r.holds = kept
+    return nil
+}
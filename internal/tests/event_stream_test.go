@@ -0,0 +1,306 @@
+package tests
+
+import (
+    "bufio"
+    "context"
+    "encoding/json"
+    "io"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+    "time"
+
+    "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+    "golang.org/x/net/websocket"
+
+    "auction-system/internal/application/eventbus"
+    auctionUC "auction-system/internal/application/usecase/auction"
+    notificationUC "auction-system/internal/application/usecase/notification"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    "auction-system/internal/interfaces/grpc/handler"
+)
+
+func TestEventBusResumesAfterLastEventID(t *testing.T) {
+    ctx := context.Background()
+    bus := eventbus.NewAuctionBus(4, 3)
+    for i := 0; i < 5; i++ {
+        require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventPriceChanged}))
+    }
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 2, Type: entity.AuctionEventStarted}))
+
+    // Events 4 to 6 are retained, so everything after 3 can be replayed.
+    sub := bus.Resume(1, 3)
+    assert.False(t, sub.Gap())
+    events := drain(sub)
+    require.Len(t, events, 2)
+    assert.Equal(t, int64(4), events[0].ID)
+    assert.Equal(t, int64(5), events[1].ID)
+
+    // Live events follow the replayed ones.
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventEnded}))
+    events = drain(sub)
+    require.Len(t, events, 1)
+    assert.Equal(t, int64(7), events[0].ID)
+
+    // Events 5 to 7 are retained now.
+    assert.False(t, bus.Resume(1, 4).Gap())
+    stale := bus.Resume(1, 3)
+    assert.True(t, stale.Gap(), "event 4 was evicted")
+    assert.Len(t, drain(stale), 2)
+
+    assert.False(t, bus.Subscribe(1).Gap())
+    assert.True(t, eventbus.NewAuctionBus(4, 3).Resume(1, 10).Gap(), "a restarted bus cannot replay anything")
+}
+
+// newEventStreamServer serves users' notifications only when notifications
+// is given.
+func newEventStreamServer(t *testing.T, heartbeat time.Duration, auctions *eventbus.AuctionBus, notifications *eventbus.NotificationBus) *httptest.Server {
+    h := handler.NewEventStreamHandler(
+        auctionUC.NewWatchAuctionUseCase(newMemoryAuctionRepo(
+            &entity.Auction{ID: 1, Status: entity.AuctionStatusActive},
+            &entity.Auction{ID: 2, Status: entity.AuctionStatusCompleted},
+        ), auctions),
+        notificationUC.NewWatchNotificationsUseCase(newMemoryUserRepo(userWithBalance(7, usd(0))), notifications),
+        heartbeat,
+        []string{"https://shop.example.com"},
+        notifications != nil,
+    )
+    mux := runtime.NewServeMux()
+    require.NoError(t, h.Register(mux))
+
+    server := httptest.NewServer(mux)
+    t.Cleanup(server.Close)
+    return server
+}
+
+// sseEvent is one message of a Server-Sent Events stream.
+type sseEvent struct {
+    id, event, data, comment string
+}
+
+func readSSE(t *testing.T, r *bufio.Reader) sseEvent {
+    var e sseEvent
+    for {
+        line, err := r.ReadString('\n')
+        require.NoError(t, err)
+        line = strings.TrimSuffix(line, "\n")
+        switch {
+        case line == "":
+            return e
+        case strings.HasPrefix(line, ": "):
+            e.comment = strings.TrimPrefix(line, ": ")
+        case strings.HasPrefix(line, "id: "):
+            e.id = strings.TrimPrefix(line, "id: ")
+        case strings.HasPrefix(line, "event: "):
+            e.event = strings.TrimPrefix(line, "event: ")
+        case strings.HasPrefix(line, "data: "):
+            e.data = strings.TrimPrefix(line, "data: ")
+        }
+    }
+}
+
+func TestAuctionEventsSSE(t *testing.T) {
+    ctx := context.Background()
+    bus := eventbus.NewAuctionBus(16, 100)
+    server := newEventStreamServer(t, 20*time.Millisecond, bus, nil)
+
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventStarted}))
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{
+        AuctionID: 1, Type: entity.AuctionEventBidPlaced, CurrentPrice: usd(150),
+        Bid: &entity.Bid{ID: 9, UserID: 7, Amount: usd(150), Quantity: 1},
+    }))
+
+    req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v1/auctions/1/events", nil)
+    require.NoError(t, err)
+    req.Header.Set("Last-Event-ID", "1")
+    resp, err := http.DefaultClient.Do(req)
+    require.NoError(t, err)
+    defer resp.Body.Close()
+
+    require.Equal(t, http.StatusOK, resp.StatusCode)
+    assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+    body := bufio.NewReader(resp.Body)
+
+    replayed := readSSE(t, body)
+    assert.Equal(t, "2", replayed.id)
+    var payload map[string]interface{}
+    require.NoError(t, json.Unmarshal([]byte(replayed.data), &payload))
+    assert.Equal(t, "BID_PLACED", payload["type"])
+    assert.Equal(t, "9", payload["bid"].(map[string]interface{})["id"])
+
+    assert.Equal(t, "ping", readSSE(t, body).comment)
+
+    require.NoError(t, bus.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventEnded, Status: entity.AuctionStatusEnded}))
+    var ended sseEvent
+    for ended.id == "" {
+        ended = readSSE(t, body)
+    }
+    assert.Equal(t, "3", ended.id)
+    assert.Contains(t, ended.data, `"ENDED"`)
+
+    _, err = io.ReadAll(body)
+    assert.NoError(t, err, "the stream ends after the auction ends")
+    assert.Equal(t, 0, bus.Subscribers(1))
+}
+
+func TestAuctionEventsSSERequestsResync(t *testing.T) {
+    bus := eventbus.NewAuctionBus(16, 100)
+    server := newEventStreamServer(t, time.Hour, bus, nil)
+
+    resp, err := http.Get(server.URL + "/api/v1/auctions/1/events?last_event_id=40")
+    require.NoError(t, err)
+    defer resp.Body.Close()
+
+    require.Equal(t, http.StatusOK, resp.StatusCode)
+    assert.Equal(t, "resync", readSSE(t, bufio.NewReader(resp.Body)).event)
+}
+
+func TestAuctionEventsSSERejectsBadRequests(t *testing.T) {
+    server := newEventStreamServer(t, time.Hour, eventbus.NewAuctionBus(16, 100), nil)
+
+    for path, code := range map[string]int{
+        "/api/v1/auctions/abc/events":                http.StatusBadRequest,
+        "/api/v1/auctions/1/events?last_event_id=-3": http.StatusBadRequest,
+        "/api/v1/auctions/2/events":                  http.StatusBadRequest,
+        "/api/v1/auctions/99/events":                 http.StatusNotFound,
+    } {
+        resp, err := http.Get(server.URL + path)
+        require.NoError(t, err)
+        resp.Body.Close()
+        assert.Equal(t, code, resp.StatusCode, path)
+    }
+}
+
+func TestWebSocketChecksOrigin(t *testing.T) {
+    server := newEventStreamServer(t, time.Hour, eventbus.NewAuctionBus(16, 100), nil)
+    url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v1/ws"
+
+    _, err := websocket.Dial(url, "", "https://evil.example.net")
+    assert.Error(t, err, "a page on another site cannot open the socket")
+
+    for _, origin := range []string{server.URL, "https://shop.example.com"} {
+        conn, err := websocket.Dial(url, "", origin)
+        require.NoError(t, err, origin)
+        conn.Close()
+    }
+}
+
+// wsMessage is what the WebSocket endpoint sends.
+type wsMessage struct {
+    Type    string          `json:"type"`
+    Channel string          `json:"channel"`
+    ID      int64           `json:"id"`
+    EventID int64           `json:"event_id"`
+    Data    json.RawMessage `json:"data"`
+    Message string          `json:"message"`
+}
+
+func dialEvents(t *testing.T, server *httptest.Server) *websocket.Conn {
+    conn, err := websocket.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/api/v1/ws", "", server.URL)
+    require.NoError(t, err)
+    t.Cleanup(func() { conn.Close() })
+    return conn
+}
+
+func wsCommand(t *testing.T, conn *websocket.Conn, action, channel string, id, lastEventID int64) {
+    require.NoError(t, websocket.JSON.Send(conn, map[string]interface{}{
+        "action": action, "channel": channel, "id": id, "last_event_id": lastEventID,
+    }))
+}
+
+func wsReceive(t *testing.T, conn *websocket.Conn) wsMessage {
+    require.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+    var msg wsMessage
+    require.NoError(t, websocket.JSON.Receive(conn, &msg))
+    return msg
+}
+
+func TestWebSocketMultiplexesSubscriptions(t *testing.T) {
+    ctx := context.Background()
+    auctions := eventbus.NewAuctionBus(16, 100)
+    notifications := eventbus.NewNotificationBus(16, 100)
+    server := newEventStreamServer(t, time.Hour, auctions, notifications)
+    conn := dialEvents(t, server)
+
+    require.NoError(t, notifications.Publish(ctx, &notification.Notification{UserID: 7, Type: notification.AuctionStarted, Message: "missed"}))
+
+    wsCommand(t, conn, "subscribe", "auction", 1, 0)
+    assert.Equal(t, wsMessage{Type: "subscribed", Channel: "auction", ID: 1}, wsReceive(t, conn))
+    wsCommand(t, conn, "subscribe", "notifications", 7, 0)
+    assert.Equal(t, wsMessage{Type: "subscribed", Channel: "notifications", ID: 7}, wsReceive(t, conn))
+
+    require.NoError(t, auctions.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventPriceChanged, CurrentPrice: usd(90)}))
+    msg := wsReceive(t, conn)
+    assert.Equal(t, "event", msg.Type)
+    assert.Equal(t, "auction", msg.Channel)
+    assert.Equal(t, int64(1), msg.EventID)
+    assert.Contains(t, string(msg.Data), `"PRICE_CHANGED"`)
+
+    require.NoError(t, notifications.Publish(ctx, &notification.Notification{UserID: 7, Type: notification.AuctionWon, Message: "You won"}))
+    msg = wsReceive(t, conn)
+    assert.Equal(t, "notifications", msg.Channel)
+    assert.Equal(t, int64(2), msg.EventID)
+    assert.Contains(t, string(msg.Data), `"You won"`)
+
+    wsCommand(t, conn, "unsubscribe", "notifications", 7, 0)
+    assert.Equal(t, wsMessage{Type: "unsubscribed", Channel: "notifications", ID: 7}, wsReceive(t, conn))
+    assert.Equal(t, 0, notifications.Subscribers(7))
+
+    require.NoError(t, auctions.Publish(ctx, &entity.AuctionEvent{AuctionID: 1, Type: entity.AuctionEventEnded}))
+    assert.Equal(t, "event", wsReceive(t, conn).Type)
+    msg = wsReceive(t, conn)
+    assert.Equal(t, "unsubscribed", msg.Type)
+    assert.Equal(t, int64(1), msg.ID)
+    assert.Equal(t, 0, auctions.Subscribers(1))
+}
+
+func TestWebSocketRefusesNotificationsByDefault(t *testing.T) {
+    server := newEventStreamServer(t, time.Hour, eventbus.NewAuctionBus(16, 100), nil)
+    conn := dialEvents(t, server)
+
+    wsCommand(t, conn, "subscribe", "notifications", 7, 0)
+    msg := wsReceive(t, conn)
+    assert.Equal(t, "error", msg.Type)
+    assert.Contains(t, msg.Message, "authenticated", "requests carry no identity to check the user ID against")
+
+    wsCommand(t, conn, "subscribe", "auction", 1, 0)
+    assert.Equal(t, "subscribed", wsReceive(t, conn).Type, "auction events are public")
+}
+
+func TestWebSocketResumesAndReportsErrors(t *testing.T) {
+    ctx := context.Background()
+    notifications := eventbus.NewNotificationBus(16, 100)
+    server := newEventStreamServer(t, 20*time.Millisecond, eventbus.NewAuctionBus(16, 100), notifications)
+    conn := dialEvents(t, server)
+
+    for _, message := range []string{"first", "second"} {
+        require.NoError(t, notifications.Publish(ctx, &notification.Notification{UserID: 7, Type: notification.NewBid, Message: message}))
+    }
+
+    wsCommand(t, conn, "subscribe", "notifications", 7, 1)
+    assert.Equal(t, "subscribed", wsReceive(t, conn).Type)
+    msg := wsReceive(t, conn)
+    assert.Equal(t, int64(2), msg.EventID)
+    assert.Contains(t, string(msg.Data), `"second"`)
+
+    wsCommand(t, conn, "subscribe", "notifications", 7, 0)
+    assert.Equal(t, "already subscribed", wsReceive(t, conn).Message)
+    wsCommand(t, conn, "subscribe", "notifications", 8, 0)
+    assert.Equal(t, "error", wsReceive(t, conn).Type)
+    wsCommand(t, conn, "subscribe", "auction", 2, 0)
+    assert.Equal(t, "error", wsReceive(t, conn).Type, "the auction has ended")
+    wsCommand(t, conn, "subscribe", "lots", 1, 0)
+    assert.Equal(t, "error", wsReceive(t, conn).Type)
+    require.NoError(t, websocket.Message.Send(conn, "not json"))
+    assert.Equal(t, "error", wsReceive(t, conn).Type)
+
+    assert.Equal(t, "ping", wsReceive(t, conn).Type)
+
+    conn.Close()
+    require.Eventually(t, func() bool { return notifications.Subscribers(7) == 0 }, time.Second, time.Millisecond,
+        "subscriptions end with the connection")
+}
@@ -0,0 +1,244 @@
+package tests
+
+import (
+    "context"
+    "os"
+    "path/filepath"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+
+    auctionDto "auction-system/internal/application/dto/auction"
+    "auction-system/internal/application/ledger"
+    "auction-system/internal/application/settlement"
+    auctionUC "auction-system/internal/application/usecase/auction"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/infrastructure/fee"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+// testFees charges sellers 10% and buyers a 5% premium, except for art,
+// where the commission is a flat 20.
+var testFees = entity.FeeSchedule{
+    Commission:   entity.FeeRule{Percent: 10},
+    BuyerPremium: entity.FeeRule{Percent: 5},
+    Categories: map[string]entity.CategoryFees{
+        "art": {Commission: &entity.FeeRule{Flat: entity.NewMoney(2000, "")}},
+    },
+}
+
+func TestFeeRuleCharge(t *testing.T) {
+    flatAndPercent := entity.FeeRule{Flat: entity.NewMoney(50, ""), Percent: 2.5}
+    assert.Equal(t, usd(26), flatAndPercent.Charge(usd(1020)), "0.50 plus 2.5% of 1020")
+
+    tiered := entity.FeeRule{Tiers: []entity.FeeTier{
+        {From: entity.NewMoney(0, ""), Percent: 25},
+        {From: entity.NewMoney(100000, ""), Percent: 20},
+        {From: entity.NewMoney(500000, ""), Percent: 10},
+    }}
+    assert.Equal(t, usd(200), tiered.Charge(usd(800)))
+    assert.Equal(t, usd(250), tiered.Charge(usd(1000)))
+    assert.Equal(t, usd(1050), tiered.Charge(usd(5000)))
+    assert.Equal(t, usd(1150), tiered.Charge(usd(6000)), "each band is charged at its own rate")
+    assert.Equal(t, eur(250), tiered.Charge(eur(1000)), "amounts are read in the sale's currency")
+}
+
+func TestFeeScheduleEvaluate(t *testing.T) {
+    fees := testFees.Evaluate("", usd(300))
+    assert.Equal(t, usd(30), fees.Commission)
+    assert.Equal(t, usd(15), fees.BuyerPremium)
+    assert.Equal(t, usd(315), fees.BuyerTotal())
+    assert.Equal(t, usd(270), fees.SellerProceeds())
+    assert.Equal(t, usd(45), fees.PlatformTotal())
+
+    art := testFees.Evaluate("art", usd(300))
+    assert.Equal(t, usd(20), art.Commission, "category overrides the commission")
+    assert.Equal(t, usd(15), art.BuyerPremium, "and keeps the default premium")
+
+    cheap := testFees.Evaluate("art", usd(5))
+    assert.Equal(t, usd(5), cheap.Commission, "commission never exceeds the hammer price")
+    assert.Equal(t, usd(0), cheap.SellerProceeds())
+
+    assert.Equal(t, usd(0), entity.FeeSchedule{}.Evaluate("", usd(300)).PlatformTotal())
+}
+
+type feeFixture struct {
+    *market
+    auctionRepo *memoryAuctionRepo
+    ledgerRepo  *memoryLedgerRepo
+    lotRepo     *memoryLotRepo
+    gateway     *fakeGateway
+    processor   *settlement.PaymentProcessor
+}
+
+// newFeeFixture sells lot 1 of seller 1 for 300 to user 2 under testFees.
+func newFeeFixture(t *testing.T, buyerBalance entity.Money) *feeFixture {
+    f := &feeFixture{
+        market:      newMarket(userWithBalance(1, usd(0)), userWithBalance(2, buyerBalance)),
+        auctionRepo: newMemoryAuctionRepo(&entity.Auction{ID: 1, LotID: 1, Status: entity.AuctionStatusActive}),
+        ledgerRepo:  newMemoryLedgerRepo(),
+        lotRepo:     newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1, Title: "Vase"}),
+        gateway:     &fakeGateway{},
+    }
+    // The platform's account is checked, so the ledger is kept here.
+    ledgerService := ledger.NewService(f.ledgerRepo, f.userRepo, &memoryTxManager{})
+    f.processor = settlement.NewPaymentProcessor(f.paymentRepo, f.auctionRepo, f.lotRepo, f.holdRepo, f.userRepo, ledgerService,
+        f.gateway, &recordingNotifier{}, &memoryTxManager{}, testRetryPolicy)
+
+    auction := f.settle(t, f.settlement(f.lotRepo, testFees, newInvoiceIssuer(f.userRepo)), f.auctionRepo,
+        &entity.AuctionWinner{AuctionID: 1, UserID: 2, BidID: 1, Quantity: 1, UnitPrice: usd(300)})
+    require.NotNil(t, auction.Fees)
+    assert.Equal(t, usd(45), auction.Fees.PlatformTotal())
+    return f
+}
+
+func (f *feeFixture) platformBalance(t *testing.T) entity.Money {
+    account, err := f.ledgerRepo.GetPlatformAccount(context.Background(), "USD")
+    require.NoError(t, err)
+    balance, err := f.ledgerRepo.GetBalance(context.Background(), account.ID)
+    require.NoError(t, err)
+    return balance
+}
+
+func TestCaptureCreditsFeesToPlatform(t *testing.T) {
+    f := newFeeFixture(t, usd(1000))
+
+    require.NoError(t, f.processor.ProcessDue(context.Background(), time.Now()))
+
+    payments, err := f.paymentRepo.GetByAuctionID(context.Background(), 1)
+    require.NoError(t, err)
+    require.Len(t, payments, 1)
+    assert.Equal(t, entity.PaymentStatusCaptured, payments[0].Status)
+    assert.Equal(t, usd(315), payments[0].Amount)
+    assert.Equal(t, usd(685), f.balance(t, 2))
+    assert.Equal(t, usd(270), f.balance(t, 1))
+    assert.Equal(t, usd(45), f.platformBalance(t))
+
+    seller, err := f.ledgerRepo.GetUserAccount(context.Background(), 1, "USD")
+    require.NoError(t, err)
+    lines, _, err := f.ledgerRepo.ListStatement(context.Background(), seller.ID, 0, 10)
+    require.NoError(t, err)
+    require.Len(t, lines, 2)
+    assert.Equal(t, entity.TransactionKindFee, lines[0].Kind)
+    assert.Equal(t, usd(-30), lines[0].Amount)
+    assert.Equal(t, entity.TransactionKindSettlement, lines[1].Kind)
+    assert.Equal(t, usd(300), lines[1].Amount)
+}
+
+func TestBuyerPremiumBeyondBalanceFailsPayment(t *testing.T) {
+    f := newFeeFixture(t, usd(310))
+
+    require.NoError(t, f.processor.ProcessDue(context.Background(), time.Now()))
+
+    payments, err := f.paymentRepo.GetByAuctionID(context.Background(), 1)
+    require.NoError(t, err)
+    require.Len(t, payments, 1)
+    assert.Equal(t, entity.PaymentStatusFailed, payments[0].Status, "the hold cannot grow to cover the premium")
+    assert.Equal(t, 0, payments[0].Attempts)
+    assert.Equal(t, "insufficient funds", payments[0].LastError)
+    assert.Empty(t, f.gateway.charged)
+    assert.Equal(t, usd(310), f.balance(t, 2))
+    assert.True(t, f.platformBalance(t).IsZero())
+
+    auction, err := f.auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, entity.AuctionStatusPaymentFailed, auction.Status)
+}
+
+func TestGetSettlementShowsSellerBreakdown(t *testing.T) {
+    f := newFeeFixture(t, usd(1000))
+    uc := auctionUC.NewGetSettlementUseCase(f.auctionRepo, f.lotRepo, f.paymentRepo)
+    ctx := context.Background()
+
+    before, err := uc.Execute(ctx, &auctionDto.GetSettlementRequest{AuctionID: 1, SellerID: 1})
+    require.NoError(t, err)
+    assert.Equal(t, usd(0), before.PaidOut)
+
+    require.NoError(t, f.processor.ProcessDue(ctx, time.Now()))
+
+    resp, err := uc.Execute(ctx, &auctionDto.GetSettlementRequest{AuctionID: 1, SellerID: 1})
+    require.NoError(t, err)
+    assert.Equal(t, entity.AuctionStatusCompleted, resp.Status)
+    assert.Equal(t, usd(300), resp.Totals.Hammer)
+    assert.Equal(t, usd(30), resp.Totals.Commission)
+    assert.Equal(t, usd(15), resp.Totals.BuyerPremium)
+    assert.Equal(t, usd(270), resp.Totals.SellerProceeds)
+    assert.Equal(t, usd(270), resp.PaidOut)
+    require.Len(t, resp.Payments, 1)
+    assert.Equal(t, int64(2), resp.Payments[0].BuyerID)
+    assert.Equal(t, usd(315), resp.Payments[0].Fees.BuyerTotal)
+
+    _, err = uc.Execute(ctx, &auctionDto.GetSettlementRequest{AuctionID: 1, SellerID: 2})
+    assert.Error(t, err, "only the seller sees the settlement")
+
+    auction, err := auctionUC.NewGetAuctionUseCase(f.auctionRepo, &memoryWinnerRepo{}, f.paymentRepo).Execute(ctx, 1)
+    require.NoError(t, err)
+    require.NotNil(t, auction.Fees)
+    assert.Equal(t, usd(30), auction.Fees.Commission)
+}
+
+func TestLoadFeeSchedule(t *testing.T) {
+    path := filepath.Join(t.TempDir(), "fees.json")
+    require.NoError(t, os.WriteFile(path, []byte(`{
+        "commission": {"percent": 10, "flat": "0.50"},
+        "buyer_premium": {"tiers": [{"from": "0", "percent": 25}, {"from": "1000", "percent": 20}]},
+        "categories": {"art": {"commission": {"percent": 5}}}
+    }`), 0o600))
+
+    schedule, err := fee.LoadSchedule(path)
+    require.NoError(t, err)
+    fees := schedule.Evaluate("art", usd(2000))
+    assert.Equal(t, usd(100), fees.Commission)
+    assert.Equal(t, usd(450), fees.BuyerPremium)
+    assert.Equal(t, usd(200).Add(entity.NewMoney(50, "USD")), schedule.Evaluate("", usd(2000)).Commission)
+
+    require.NoError(t, os.WriteFile(path, []byte(`{"buyer_premium": {"tiers": [{"from": "100", "percent": 25}]}}`), 0o600))
+    _, err = fee.LoadSchedule(path)
+    assert.Error(t, err, "tiers must start at zero")
+}
+
+type mockGetSettlementUC struct {
+    mock.Mock
+}
+
+func (m *mockGetSettlementUC) Execute(ctx context.Context, req *auctionDto.GetSettlementRequest) (*auctionDto.SettlementResponse, error) {
+    args := m.Called(ctx, req)
+    if args.Get(0) == nil {
+        return nil, args.Error(1)
+    }
+    return args.Get(0).(*auctionDto.SettlementResponse), args.Error(1)
+}
+
+func TestGetSettlementHandler(t *testing.T) {
+    mockUC := new(mockGetSettlementUC)
+    h := &handler.AuctionHandler{
+        GetSettlementUC: mockUC,
+    }
+
+    ctx := context.Background()
+    fees := auctionDto.FromFees(testFees.Evaluate("", usd(300)))
+    mockUC.On("Execute", ctx, &auctionDto.GetSettlementRequest{AuctionID: 1, SellerID: 1}).Return(&auctionDto.SettlementResponse{
+        AuctionID: 1,
+        SellerID:  1,
+        Status:    entity.AuctionStatusCompleted,
+        Currency:  "USD",
+        Totals:    fees,
+        PaidOut:   usd(270),
+        Payments:  []auctionDto.SettlementPaymentResponse{{ID: 1, BuyerID: 2, Status: entity.PaymentStatusCaptured, Fees: fees}},
+    }, nil)
+
+    resp, err := h.GetSettlement(ctx, &pb.GetSettlementRequest{AuctionId: 1, SellerId: 1})
+
+    require.NoError(t, err)
+    assert.Equal(t, "COMPLETED", resp.Settlement.Status)
+    assert.Equal(t, usd(30), fromPB(resp.Settlement.Totals.Commission))
+    assert.Equal(t, usd(270), fromPB(resp.Settlement.PaidOut))
+    require.Len(t, resp.Settlement.Payments, 1)
+    assert.Equal(t, "CAPTURED", resp.Settlement.Payments[0].Status)
+    assert.Equal(t, usd(315), fromPB(resp.Settlement.Payments[0].Fees.BuyerTotal))
+    mockUC.AssertExpectations(t)
+}
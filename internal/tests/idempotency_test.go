@@ -0,0 +1,284 @@
+package tests
+
+import (
+    "context"
+    "errors"
+    "strings"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/metadata"
+    "google.golang.org/grpc/status"
+    "google.golang.org/protobuf/proto"
+
+    "auction-system/internal/application/idempotency"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+func TestIdempotencyServiceBegin(t *testing.T) {
+    ctx := context.Background()
+    repo := newMemoryIdempotencyRepo()
+    keys := idempotency.NewService(repo, time.Hour)
+    method := pb.UserService_UpdateBalance_FullMethodName
+
+    stored, err := keys.Begin(ctx, 1, "k1", method, "hash-a")
+    require.NoError(t, err)
+    assert.Nil(t, stored, "a new key is claimed")
+
+    _, err = keys.Begin(ctx, 1, "k1", method, "hash-a")
+    assert.Equal(t, idempotency.ErrInProgress, err)
+    _, err = keys.Begin(ctx, 1, "k1", method, "hash-b")
+    assert.Equal(t, idempotency.ErrKeyReused, err)
+
+    stored, err = keys.Begin(ctx, 1, "k1", pb.BidService_PlaceBid_FullMethodName, "hash-b")
+    require.NoError(t, err)
+    assert.Nil(t, stored, "keys are scoped to a method")
+
+    stored, err = keys.Begin(ctx, 2, "k1", method, "hash-b")
+    require.NoError(t, err)
+    assert.Nil(t, stored, "keys are scoped to a user")
+
+    require.NoError(t, keys.Complete(ctx, 1, "k1", method, []byte("response")))
+    stored, err = keys.Begin(ctx, 1, "k1", method, "hash-a")
+    require.NoError(t, err)
+    assert.Equal(t, []byte("response"), stored)
+
+    _, err = keys.Begin(ctx, 1, "k2", method, "hash-a")
+    require.NoError(t, err)
+    require.NoError(t, keys.Release(ctx, 1, "k2", method))
+    stored, err = keys.Begin(ctx, 1, "k2", method, "hash-b")
+    require.NoError(t, err)
+    assert.Nil(t, stored, "a released key can be used again")
+
+    for _, key := range []string{"", "  ", strings.Repeat("k", idempotency.MaxKeyLength+1)} {
+        _, err := keys.Begin(ctx, 1, key, method, "hash-a")
+        assert.Equal(t, idempotency.ErrInvalidKey, err)
+    }
+}
+
+func TestIdempotencyKeysExpire(t *testing.T) {
+    ctx := context.Background()
+    repo := newMemoryIdempotencyRepo()
+    keys := idempotency.NewService(repo, time.Hour)
+    method := pb.UserService_UpdateBalance_FullMethodName
+
+    _, err := keys.Begin(ctx, 1, "k1", method, "hash-a")
+    require.NoError(t, err)
+    require.NoError(t, keys.Complete(ctx, 1, "k1", method, []byte("response")))
+    _, err = keys.Begin(ctx, 1, "k2", method, "hash-a")
+    require.NoError(t, err)
+    repo.records[memoryIdempotencyID{1, "k1", method}].CreatedAt = time.Now().Add(-2 * time.Hour)
+
+    stored, err := keys.Begin(ctx, 1, "k1", method, "hash-b")
+    require.NoError(t, err)
+    assert.Nil(t, stored, "an expired key is claimed afresh")
+
+    purged, err := keys.PurgeExpired(ctx, time.Now().Add(90*time.Minute))
+    require.NoError(t, err)
+    assert.Equal(t, int64(2), purged)
+    assert.Empty(t, repo.records)
+}
+
+// idempotentBidServer runs PlaceBid on h behind the idempotency interceptor,
+// the way the gRPC server does.
+func idempotentBidServer(h *handler.BidHandler) func(ctx context.Context, req *pb.PlaceBidRequest) (*pb.PlaceBidResponse, error) {
+    return idempotentBidServerWith(h, newMemoryIdempotencyRepo())
+}
+
+func idempotentBidServerWith(h *handler.BidHandler, repo *memoryIdempotencyRepo) func(ctx context.Context, req *pb.PlaceBidRequest) (*pb.PlaceBidResponse, error) {
+    interceptor := handler.NewIdempotencyInterceptor(
+        idempotency.NewService(repo, time.Hour),
+        pb.BidService_PlaceBid_FullMethodName,
+    ).Unary()
+    info := &grpc.UnaryServerInfo{FullMethod: pb.BidService_PlaceBid_FullMethodName}
+
+    return func(ctx context.Context, req *pb.PlaceBidRequest) (*pb.PlaceBidResponse, error) {
+        resp, err := interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+            return h.PlaceBid(ctx, req.(*pb.PlaceBidRequest))
+        })
+        if err != nil {
+            return nil, err
+        }
+        return resp.(*pb.PlaceBidResponse), nil
+    }
+}
+
+func withIdempotencyKey(key string) context.Context {
+    return metadata.NewIncomingContext(context.Background(), metadata.Pairs("idempotency-key", key))
+}
+
+func TestIdempotencyInterceptorReplaysPlaceBid(t *testing.T) {
+    mockUC := new(mockPlaceBidUC)
+    placeBid := idempotentBidServer(&handler.BidHandler{PlaceBidUseCase: mockUC})
+    mockUC.On("Execute", mock.Anything, mock.Anything).Return(createTestBidResponse(), nil).Once()
+
+    req := &pb.PlaceBidRequest{AuctionId: 1, UserId: 1, Amount: pbUSD(150)}
+    first, err := placeBid(withIdempotencyKey("retry-1"), req)
+    require.NoError(t, err)
+    retried, err := placeBid(withIdempotencyKey("retry-1"), proto.Clone(req).(*pb.PlaceBidRequest))
+    require.NoError(t, err)
+    assert.True(t, proto.Equal(first, retried), "the retry gets the original response")
+    mockUC.AssertNumberOfCalls(t, "Execute", 1)
+
+    _, err = placeBid(withIdempotencyKey("retry-1"), &pb.PlaceBidRequest{AuctionId: 1, UserId: 1, Amount: pbUSD(200)})
+    assert.Equal(t, codes.FailedPrecondition, status.Code(err), "the key belongs to another request")
+    mockUC.AssertNumberOfCalls(t, "Execute", 1)
+}
+
+func TestIdempotencyKeysDoNotCrossUsers(t *testing.T) {
+    mockUC := new(mockPlaceBidUC)
+    placeBid := idempotentBidServer(&handler.BidHandler{PlaceBidUseCase: mockUC})
+    mockUC.On("Execute", mock.Anything, mock.Anything).Return(createTestBidResponse(), nil).Twice()
+
+    _, err := placeBid(withIdempotencyKey("shared"), &pb.PlaceBidRequest{AuctionId: 1, UserId: 1, Amount: pbUSD(150)})
+    require.NoError(t, err)
+    _, err = placeBid(withIdempotencyKey("shared"), &pb.PlaceBidRequest{AuctionId: 1, UserId: 2, Amount: pbUSD(160)})
+    require.NoError(t, err, "another user's key neither conflicts nor replays")
+    mockUC.AssertNumberOfCalls(t, "Execute", 2)
+}
+
+func TestIdempotencyInterceptorSettlesUnsavedResponses(t *testing.T) {
+    mockUC := new(mockPlaceBidUC)
+    repo := newMemoryIdempotencyRepo()
+    placeBid := idempotentBidServerWith(&handler.BidHandler{PlaceBidUseCase: mockUC}, repo)
+    req := &pb.PlaceBidRequest{AuctionId: 1, UserId: 1, Amount: pbUSD(150)}
+    mockUC.On("Execute", mock.Anything, mock.Anything).Return(createTestBidResponse(), nil)
+
+    repo.completeFailures = 1
+    _, err := placeBid(withIdempotencyKey("lost-1"), req)
+    require.NoError(t, err)
+    _, err = placeBid(withIdempotencyKey("lost-1"), req)
+    assert.Equal(t, codes.DataLoss, status.Code(err), "the retry learns the response was lost")
+    mockUC.AssertNumberOfCalls(t, "Execute", 1)
+
+    repo.completeFailures = 2
+    _, err = placeBid(withIdempotencyKey("lost-2"), req)
+    require.NoError(t, err)
+    _, err = placeBid(withIdempotencyKey("lost-2"), req)
+    assert.NoError(t, err, "a key that cannot be settled is released rather than left in progress")
+    mockUC.AssertNumberOfCalls(t, "Execute", 3)
+}
+
+func TestIdempotencyInterceptorPassesThrough(t *testing.T) {
+    mockUC := new(mockPlaceBidUC)
+    placeBid := idempotentBidServer(&handler.BidHandler{PlaceBidUseCase: mockUC})
+    req := &pb.PlaceBidRequest{AuctionId: 1, UserId: 1, Amount: pbUSD(150)}
+
+    mockUC.On("Execute", mock.Anything, mock.Anything).Return(createTestBidResponse(), nil).Twice()
+    for range 2 {
+        _, err := placeBid(context.Background(), req)
+        require.NoError(t, err)
+    }
+    mockUC.AssertNumberOfCalls(t, "Execute", 2)
+
+    _, err := placeBid(withIdempotencyKey(strings.Repeat("k", 300)), req)
+    assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIdempotencyInterceptorReleasesFailedRequests(t *testing.T) {
+    mockUC := new(mockPlaceBidUC)
+    placeBid := idempotentBidServer(&handler.BidHandler{PlaceBidUseCase: mockUC})
+    req := &pb.PlaceBidRequest{AuctionId: 1, UserId: 1, Amount: pbUSD(150)}
+
+    mockUC.On("Execute", mock.Anything, mock.Anything).Return(nil, errors.New("auction is not active")).Once()
+    _, err := placeBid(withIdempotencyKey("retry-2"), req)
+    assert.Error(t, err)
+
+    mockUC.On("Execute", mock.Anything, mock.Anything).Return(createTestBidResponse(), nil).Once()
+    _, err = placeBid(withIdempotencyKey("retry-2"), req)
+    assert.NoError(t, err, "a failed request may be retried with the same key")
+    mockUC.AssertNumberOfCalls(t, "Execute", 2)
+}
+
+func TestIdempotencyInterceptorIgnoresOtherMethods(t *testing.T) {
+    interceptor := handler.NewIdempotencyInterceptor(
+        idempotency.NewService(newMemoryIdempotencyRepo(), time.Hour),
+        pb.BidService_PlaceBid_FullMethodName,
+    ).Unary()
+    info := &grpc.UnaryServerInfo{FullMethod: pb.BidService_GetBid_FullMethodName}
+
+    calls := 0
+    for range 2 {
+        _, err := interceptor(withIdempotencyKey("k"), &pb.GetBidRequest{Id: 1}, info, func(ctx context.Context, req any) (any, error) {
+            calls++
+            return &pb.GetBidResponse{}, nil
+        })
+        require.NoError(t, err)
+    }
+    assert.Equal(t, 2, calls)
+}
+
+type memoryIdempotencyID struct {
+    userID int64
+    key    string
+    method string
+}
+
+type memoryIdempotencyRepo struct {
+    mu      sync.Mutex
+    records map[memoryIdempotencyID]*entity.IdempotencyRecord
+    // completeFailures makes that many calls to Complete fail.
+    completeFailures int
+}
+
+func newMemoryIdempotencyRepo() *memoryIdempotencyRepo {
+    return &memoryIdempotencyRepo{records: make(map[memoryIdempotencyID]*entity.IdempotencyRecord)}
+}
+
+func (r *memoryIdempotencyRepo) Claim(ctx context.Context, record *entity.IdempotencyRecord, staleBefore time.Time) (*entity.IdempotencyRecord, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    id := memoryIdempotencyID{record.UserID, record.Key, record.Method}
+    if existing, ok := r.records[id]; ok && !existing.CreatedAt.Before(staleBefore) {
+        copied := *existing
+        return &copied, nil
+    }
+    record.CreatedAt = time.Now()
+    copied := *record
+    r.records[id] = &copied
+    return nil, nil
+}
+
+func (r *memoryIdempotencyRepo) Complete(ctx context.Context, userID int64, key, method string, response []byte) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    if r.completeFailures > 0 {
+        r.completeFailures--
+        return errors.New("failed to save idempotent response")
+    }
+    if record, ok := r.records[memoryIdempotencyID{userID, key, method}]; ok {
+        record.Response = response
+    }
+    return nil
+}
+
+func (r *memoryIdempotencyRepo) Release(ctx context.Context, userID int64, key, method string) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    id := memoryIdempotencyID{userID, key, method}
+    if record, ok := r.records[id]; ok && !record.Completed() {
+        delete(r.records, id)
+    }
+    return nil
+}
+
+func (r *memoryIdempotencyRepo) DeleteCreatedBefore(ctx context.Context, t time.Time) (int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var deleted int64
+    for id, record := range r.records {
+        if record.CreatedAt.Before(t) {
+            delete(r.records, id)
+            deleted++
+        }
+    }
+    return deleted, nil
+}
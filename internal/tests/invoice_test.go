@@ -0,0 +1,307 @@
+package tests
+
+import (
+    "bytes"
+    "context"
+    "os"
+    "path/filepath"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/invoice"
+    "auction-system/internal/application/settlement"
+    invoiceUC "auction-system/internal/application/usecase/invoice"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    domainInvoice "auction-system/internal/domain/invoice"
+    invoiceInfra "auction-system/internal/infrastructure/invoice"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+func TestIncludedTax(t *testing.T) {
+    assert.Equal(t, usd(20), entity.IncludedTax(usd(120), 20))
+    assert.Equal(t, entity.NewMoney(1667, "USD"), entity.IncludedTax(usd(100), 20), "rounded to the cent")
+    assert.True(t, entity.IncludedTax(usd(100), 0).IsZero())
+}
+
+type invoiceFixture struct {
+    *market
+    invoiceRepo *memoryInvoiceRepo
+}
+
+// newInvoiceFixture sells two units of seller 1's lot, one each to users 2
+// and 3, under testFees with 20% tax included.
+func newInvoiceFixture(t *testing.T) *invoiceFixture {
+    f := &invoiceFixture{
+        market: newMarket(
+            &entity.User{ID: 1, Username: "seller", Email: "seller@example.com"},
+            &entity.User{ID: 2, Username: "alice", Email: "alice@example.com"},
+            &entity.User{ID: 3, Username: "bob", Email: "bob@example.com"},
+        ),
+        invoiceRepo: &memoryInvoiceRepo{},
+    }
+    // The winners can cover what they owe, fees included.
+    require.NoError(t, f.userRepo.Adjust(context.Background(), 2, usd(1000)))
+    require.NoError(t, f.userRepo.Adjust(context.Background(), 3, usd(1000)))
+
+    lotRepo := newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1, Title: "Vase <Ming>"})
+    f.settle(t, f.settlement(lotRepo, testFees, settlement.NewInvoiceIssuer(f.invoiceRepo, f.userRepo, 20)),
+        newMemoryAuctionRepo(&entity.Auction{ID: 1, LotID: 1, Quantity: 2, Status: entity.AuctionStatusActive}),
+        &entity.AuctionWinner{AuctionID: 1, UserID: 2, BidID: 1, Quantity: 1, UnitPrice: usd(400)},
+        &entity.AuctionWinner{AuctionID: 1, UserID: 3, BidID: 2, Quantity: 1, UnitPrice: usd(300)},
+    )
+    return f
+}
+
+func TestSettleIssuesInvoicePerWinner(t *testing.T) {
+    f := newInvoiceFixture(t)
+
+    first, err := f.invoiceRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, "INV-000001", first.Number)
+    assert.Equal(t, "Vase <Ming>", first.LotTitle)
+    assert.Equal(t, "alice", first.BuyerName)
+    assert.Equal(t, "seller@example.com", first.SellerEmail)
+    assert.Equal(t, usd(400), first.Hammer)
+    assert.Equal(t, usd(20), first.BuyerPremium)
+    assert.Equal(t, usd(40), first.Commission)
+    assert.Equal(t, usd(420), first.Total)
+    assert.Equal(t, usd(70), first.Tax)
+    assert.Equal(t, usd(350), first.Net())
+
+    second, err := f.invoiceRepo.GetByID(context.Background(), 2)
+    require.NoError(t, err)
+    assert.Equal(t, "INV-000002", second.Number)
+    assert.Equal(t, int64(3), second.BuyerID)
+    assert.Equal(t, usd(315), second.Total)
+}
+
+func TestRenderInvoice(t *testing.T) {
+    f := newInvoiceFixture(t)
+    invoice, err := f.invoiceRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    renderer := invoiceInfra.NewTemplateRenderer()
+
+    text, err := renderer.Render(invoice, domainInvoice.FormatText)
+    require.NoError(t, err)
+    assert.Equal(t, "INV-000001.txt", text.Filename)
+    assert.Contains(t, string(text.Content), "INVOICE INV-000001")
+    assert.Contains(t, string(text.Content), "Vase <Ming>")
+    assert.Contains(t, string(text.Content), "420.00 USD")
+    assert.Contains(t, string(text.Content), "Incl. tax 20%    70.00 USD")
+
+    html, err := renderer.Render(invoice, domainInvoice.FormatHTML)
+    require.NoError(t, err)
+    assert.Equal(t, "text/html; charset=utf-8", html.ContentType)
+    assert.Contains(t, string(html.Content), "Vase &lt;Ming&gt;", "lot titles are escaped")
+
+    pdf, err := renderer.Render(invoice, domainInvoice.FormatPDF)
+    require.NoError(t, err)
+    assert.Equal(t, "application/pdf", pdf.ContentType)
+    assert.Equal(t, "INV-000001.pdf", pdf.Filename)
+    assert.True(t, bytes.HasPrefix(pdf.Content, []byte("%PDF-")))
+    again, err := renderer.Render(invoice, domainInvoice.FormatPDF)
+    require.NoError(t, err)
+    assert.Equal(t, pdf.Content, again.Content, "the same invoice renders the same PDF")
+}
+
+func TestLoadTemplateRenderer(t *testing.T) {
+    dir := t.TempDir()
+    require.NoError(t, os.WriteFile(filepath.Join(dir, "invoice.txt.tmpl"), []byte(`{{.Number}} due {{money .Total}}`), 0o600))
+
+    _, err := invoiceInfra.LoadTemplateRenderer(dir, "")
+    assert.Error(t, err, "the HTML template is missing")
+
+    require.NoError(t, os.WriteFile(filepath.Join(dir, "invoice.html.tmpl"), []byte(`<p>{{.Number}}</p>`), 0o600))
+    renderer, err := invoiceInfra.LoadTemplateRenderer(dir, "")
+    require.NoError(t, err)
+    doc, err := renderer.Render(&entity.Invoice{Number: "INV-000007", Total: usd(5)}, domainInvoice.FormatText)
+    require.NoError(t, err)
+    assert.Equal(t, "INV-000007 due 5.00 USD", string(doc.Content))
+
+    _, err = invoiceInfra.LoadTemplateRenderer(dir, filepath.Join(dir, "missing.ttf"))
+    assert.Error(t, err)
+}
+
+func TestGetInvoiceIsLimitedToBuyerAndSeller(t *testing.T) {
+    f := newInvoiceFixture(t)
+    uc := invoiceUC.NewGetInvoiceUseCase(f.invoiceRepo)
+    ctx := context.Background()
+
+    buyer, err := uc.Execute(ctx, &dto.GetInvoiceRequest{InvoiceID: 1, UserID: 2})
+    require.NoError(t, err)
+    assert.Equal(t, usd(420), buyer.Total)
+    assert.True(t, buyer.Commission.IsZero(), "the buyer does not see the commission")
+
+    seller, err := uc.Execute(ctx, &dto.GetInvoiceRequest{InvoiceID: 1, UserID: 1})
+    require.NoError(t, err)
+    assert.Equal(t, usd(40), seller.Commission)
+
+    _, err = uc.Execute(ctx, &dto.GetInvoiceRequest{InvoiceID: 1, UserID: 3})
+    assert.Error(t, err, "another winner's invoice is private")
+}
+
+func TestListInvoices(t *testing.T) {
+    f := newInvoiceFixture(t)
+    uc := invoiceUC.NewListInvoicesUseCase(f.userRepo, f.invoiceRepo)
+    ctx := context.Background()
+
+    seller, err := uc.Execute(ctx, &dto.ListInvoicesRequest{UserID: 1, PageSize: 1, PageNumber: 1})
+    require.NoError(t, err)
+    assert.Equal(t, int64(2), seller.TotalCount)
+    require.Len(t, seller.Invoices, 1)
+    assert.Equal(t, "INV-000002", seller.Invoices[0].Number, "newest first")
+
+    buyer, err := uc.Execute(ctx, &dto.ListInvoicesRequest{UserID: 3, PageSize: 10, PageNumber: 1})
+    require.NoError(t, err)
+    require.Len(t, buyer.Invoices, 1)
+    assert.True(t, buyer.Invoices[0].Commission.IsZero())
+
+    _, err = uc.Execute(ctx, &dto.ListInvoicesRequest{UserID: 1, PageSize: 10, PageNumber: 0})
+    assert.Error(t, err)
+}
+
+func TestDownloadInvoice(t *testing.T) {
+    f := newInvoiceFixture(t)
+    uc := invoiceUC.NewDownloadInvoiceUseCase(f.invoiceRepo, invoiceInfra.NewTemplateRenderer())
+    ctx := context.Background()
+
+    doc, err := uc.Execute(ctx, &dto.DownloadInvoiceRequest{InvoiceID: 2, UserID: 3})
+    require.NoError(t, err)
+    assert.Equal(t, "application/pdf", doc.ContentType, "PDF by default")
+
+    doc, err = uc.Execute(ctx, &dto.DownloadInvoiceRequest{InvoiceID: 2, UserID: 3, Format: "text"})
+    require.NoError(t, err)
+    assert.Equal(t, "INV-000002.txt", doc.Filename)
+
+    _, err = uc.Execute(ctx, &dto.DownloadInvoiceRequest{InvoiceID: 2, UserID: 3, Format: "docx"})
+    assert.Error(t, err)
+    _, err = uc.Execute(ctx, &dto.DownloadInvoiceRequest{InvoiceID: 2, UserID: 2})
+    assert.Error(t, err)
+}
+
+type mockDownloadInvoiceUC struct {
+    mock.Mock
+}
+
+func (m *mockDownloadInvoiceUC) Execute(ctx context.Context, req *dto.DownloadInvoiceRequest) (*dto.DocumentResponse, error) {
+    args := m.Called(ctx, req)
+    if args.Get(0) == nil {
+        return nil, args.Error(1)
+    }
+    return args.Get(0).(*dto.DocumentResponse), args.Error(1)
+}
+
+type mockGetInvoiceUC struct {
+    mock.Mock
+}
+
+func (m *mockGetInvoiceUC) Execute(ctx context.Context, req *dto.GetInvoiceRequest) (*dto.InvoiceResponse, error) {
+    args := m.Called(ctx, req)
+    if args.Get(0) == nil {
+        return nil, args.Error(1)
+    }
+    return args.Get(0).(*dto.InvoiceResponse), args.Error(1)
+}
+
+var _ invoiceUC.DownloadInvoiceUseCaseInterface = (*mockDownloadInvoiceUC)(nil)
+var _ invoiceUC.GetInvoiceUseCaseInterface = (*mockGetInvoiceUC)(nil)
+
+func TestInvoiceHandler(t *testing.T) {
+    getUC := new(mockGetInvoiceUC)
+    downloadUC := new(mockDownloadInvoiceUC)
+    h := &handler.InvoiceHandler{
+        GetInvoiceUC:      getUC,
+        DownloadInvoiceUC: downloadUC,
+    }
+    ctx := context.Background()
+
+    issuedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+    getUC.On("Execute", ctx, &dto.GetInvoiceRequest{InvoiceID: 1, UserID: 2}).Return(&dto.InvoiceResponse{
+        ID:       1,
+        Number:   "INV-000001",
+        BuyerID:  2,
+        Currency: "USD",
+        Hammer:   usd(400),
+        Total:    usd(420),
+        IssuedAt: issuedAt,
+    }, nil)
+    resp, err := h.GetInvoice(ctx, &pb.GetInvoiceRequest{InvoiceId: 1, UserId: 2})
+    require.NoError(t, err)
+    assert.Equal(t, "INV-000001", resp.Invoice.Number)
+    assert.Equal(t, usd(420), fromPB(resp.Invoice.Total))
+    assert.Equal(t, issuedAt, resp.Invoice.IssuedAt.AsTime())
+
+    downloadUC.On("Execute", ctx, &dto.DownloadInvoiceRequest{InvoiceID: 1, UserID: 2, Format: "html"}).Return(&dto.DocumentResponse{
+        ContentType: "text/html; charset=utf-8",
+        Filename:    "INV-000001.html",
+        Content:     []byte("<p>INV-000001</p>"),
+    }, nil)
+    body, err := h.DownloadInvoice(ctx, &pb.DownloadInvoiceRequest{InvoiceId: 1, UserId: 2, Format: "html"})
+    require.NoError(t, err)
+    assert.Equal(t, "text/html; charset=utf-8", body.ContentType)
+    assert.Equal(t, "<p>INV-000001</p>", string(body.Data))
+
+    getUC.AssertExpectations(t)
+    downloadUC.AssertExpectations(t)
+}
+
+type memoryInvoiceRepo struct {
+    mu       sync.Mutex
+    invoices []*entity.Invoice
+}
+
+// newInvoiceIssuer invoices tax-free sales into a fresh repository.
+func newInvoiceIssuer(userRepo *memoryUserRepo) *settlement.InvoiceIssuer {
+    return settlement.NewInvoiceIssuer(&memoryInvoiceRepo{}, userRepo, 0)
+}
+
+func (r *memoryInvoiceRepo) Create(ctx context.Context, invoice *entity.Invoice) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    invoice.ID = int64(len(r.invoices) + 1)
+    invoice.Number = entity.InvoiceNumber(invoice.ID)
+    invoice.IssuedAt = time.Now()
+    copied := *invoice
+    r.invoices = append(r.invoices, &copied)
+    return nil
+}
+
+func (r *memoryInvoiceRepo) GetByID(ctx context.Context, id int64) (*entity.Invoice, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, i := range r.invoices {
+        if i.ID == id {
+            copied := *i
+            return &copied, nil
+        }
+    }
+    return nil, errors.NewNotFoundError("invoice not found")
+}
+
+func (r *memoryInvoiceRepo) GetByUserID(ctx context.Context, userID int64, offset, limit int) ([]*entity.Invoice, int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var matched []*entity.Invoice
+    for n := len(r.invoices) - 1; n >= 0; n-- {
+        if r.invoices[n].Involves(userID) {
+            copied := *r.invoices[n]
+            matched = append(matched, &copied)
+        }
+    }
+    total := int64(len(matched))
+    if offset >= len(matched) {
+        return nil, total, nil
+    }
+    matched = matched[offset:]
+    if len(matched) > limit {
+        matched = matched[:limit]
+    }
+    return matched, total, nil
+}
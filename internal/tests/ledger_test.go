@@ -0,0 +1,277 @@
+package tests
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/user"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/ledger"
+    "auction-system/internal/application/settlement"
+    userUC "auction-system/internal/application/usecase/user"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+type mockListTransactionsUC struct {
+    mock.Mock
+}
+
+func (m *mockListTransactionsUC) Execute(ctx context.Context, req *dto.ListTransactionsRequest) (*dto.TransactionListResponse, error) {
+    args := m.Called(ctx, req)
+    if args.Get(0) == nil {
+        return nil, args.Error(1)
+    }
+    return args.Get(0).(*dto.TransactionListResponse), args.Error(1)
+}
+
+func TestLedgerTransactionIsBalanced(t *testing.T) {
+    entry := func(direction entity.EntryDirection, amount int64) *entity.LedgerEntry {
+        return &entity.LedgerEntry{Direction: direction, Amount: usd(amount)}
+    }
+
+    balanced := &entity.LedgerTransaction{Entries: []*entity.LedgerEntry{
+        entry(entity.EntryDirectionDebit, 100),
+        entry(entity.EntryDirectionCredit, 60),
+        entry(entity.EntryDirectionCredit, 40),
+    }}
+    assert.True(t, balanced.IsBalanced())
+
+    unbalanced := &entity.LedgerTransaction{Entries: []*entity.LedgerEntry{
+        entry(entity.EntryDirectionDebit, 100),
+        entry(entity.EntryDirectionCredit, 90),
+    }}
+    assert.False(t, unbalanced.IsBalanced())
+
+    single := &entity.LedgerTransaction{Entries: []*entity.LedgerEntry{entry(entity.EntryDirectionCredit, 100)}}
+    assert.False(t, single.IsBalanced())
+}
+
+func TestBalanceUpdatesArePostedToLedger(t *testing.T) {
+    ctx := context.Background()
+    userRepo := newMemoryUserRepo(&entity.User{ID: 1})
+    ledgerRepo := newMemoryLedgerRepo()
+    txManager := &memoryTxManager{}
+    update := userUC.NewUpdateBalanceUseCase(userRepo, txManager, ledger.NewService(ledgerRepo, userRepo, txManager))
+
+    require.NoError(t, update.Execute(ctx, userUC.UpdateBalanceInput{UserID: 1, Amount: usd(500)}))
+    require.NoError(t, update.Execute(ctx, userUC.UpdateBalanceInput{UserID: 1, Amount: usd(250)}))
+    assert.Error(t, update.Execute(ctx, userUC.UpdateBalanceInput{UserID: 1, Amount: usd(-200)}), "withdrawals are reviewed")
+    assert.Error(t, update.Execute(ctx, userUC.UpdateBalanceInput{UserID: 1, Amount: usd(0)}))
+
+    user, err := userRepo.GetByID(ctx, 1)
+    require.NoError(t, err)
+    assert.Equal(t, usd(750), user.Wallet("USD").Balance)
+
+    statement, err := userUC.NewListTransactionsUseCase(userRepo, ledgerRepo).Execute(ctx,
+        &dto.ListTransactionsRequest{UserID: 1, PageSize: 10, PageNumber: 1})
+    require.NoError(t, err)
+    assert.Equal(t, int64(2), statement.TotalCount)
+    assert.Equal(t, usd(750), statement.Balance, "ledger agrees with the cached balance")
+    require.Len(t, statement.Transactions, 2)
+    assert.Equal(t, string(entity.TransactionKindDeposit), statement.Transactions[0].Kind)
+    assert.Equal(t, usd(250), statement.Transactions[0].Amount)
+    assert.Equal(t, usd(750), statement.Transactions[0].BalanceAfter)
+    assert.Equal(t, string(entity.TransactionKindDeposit), statement.Transactions[1].Kind)
+    assert.Equal(t, usd(500), statement.Transactions[1].BalanceAfter)
+}
+
+func TestSettlementPostsTransfers(t *testing.T) {
+    ctx := context.Background()
+    userRepo := newMemoryUserRepo(&entity.User{ID: 1}, &entity.User{ID: 2}, &entity.User{ID: 3})
+    ledgerRepo := newMemoryLedgerRepo()
+    ledgerService := ledger.NewService(ledgerRepo, userRepo, &memoryTxManager{})
+    require.NoError(t, ledgerService.Deposit(ctx, 2, usd(1000), "top-up"))
+    require.NoError(t, ledgerService.Deposit(ctx, 3, usd(1000), "top-up"))
+
+    auction := &entity.Auction{ID: 7, LotID: 1, Quantity: 3, Pricing: entity.MultiUnitPricingDiscriminatory}
+    auctionRepo := newMemoryAuctionRepo(auction)
+    lotRepo := newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1})
+    holdRepo := &memoryHoldRepo{}
+    paymentRepo := &memoryPaymentRepo{}
+    winners := []*entity.AuctionWinner{
+        {AuctionID: 7, UserID: 2, BidID: 1, Quantity: 2, UnitPrice: usd(300)},
+        {AuctionID: 7, UserID: 3, BidID: 2, Quantity: 1, UnitPrice: usd(250)},
+    }
+    service := settlement.NewService(lotRepo, &memoryWinnerRepo{}, holdRepo, paymentRepo, escrow.NewService(holdRepo, &memoryBidRepo{}, userRepo, userRepo), entity.FeeSchedule{}, newInvoiceIssuer(userRepo))
+    require.NoError(t, service.Settle(ctx, auction, winners))
+    _, err := auctionRepo.Update(ctx, auction.ID, auction)
+    require.NoError(t, err)
+
+    payments := settlement.NewPaymentProcessor(paymentRepo, auctionRepo, lotRepo, holdRepo, userRepo, ledgerService, &fakeGateway{}, &recordingNotifier{}, &memoryTxManager{}, testRetryPolicy)
+    require.NoError(t, payments.ProcessDue(ctx, time.Now()))
+
+    for userID, expected := range map[int64]entity.Money{1: usd(850), 2: usd(400), 3: usd(750)} {
+        balance, err := ledgerService.Balance(ctx, userID, "USD")
+        require.NoError(t, err)
+        assert.Equal(t, expected, balance)
+
+        user, err := userRepo.GetByID(ctx, userID)
+        require.NoError(t, err)
+        assert.Equal(t, expected, user.Wallet("USD").Balance)
+    }
+
+    seller, err := ledgerRepo.GetUserAccount(ctx, 1, "USD")
+    require.NoError(t, err)
+    lines, _, err := ledgerRepo.ListStatement(ctx, seller.ID, 0, 10)
+    require.NoError(t, err)
+    require.Len(t, lines, 2)
+    for _, line := range lines {
+        assert.Equal(t, entity.TransactionKindSettlement, line.Kind)
+        require.NotNil(t, line.AuctionID)
+        assert.Equal(t, int64(7), *line.AuctionID)
+    }
+}
+
+func TestListTransactionsHandler(t *testing.T) {
+    mockUC := new(mockListTransactionsUC)
+    h := &handler.UserHandler{
+        ListTransactionsUC: mockUC,
+    }
+
+    ctx := context.Background()
+    auctionID := int64(3)
+    mockUC.On("Execute", ctx, &dto.ListTransactionsRequest{UserID: 1, PageSize: 10, PageNumber: 1}).Return(&dto.TransactionListResponse{
+        Transactions: []dto.TransactionResponse{
+            {ID: 2, Kind: "SETTLEMENT", AuctionID: &auctionID, Amount: usd(-300), BalanceAfter: usd(200)},
+            {ID: 1, Kind: "DEPOSIT", Amount: usd(500), BalanceAfter: usd(500)},
+        },
+        TotalCount: 2,
+        Balance:    usd(200),
+    }, nil)
+
+    resp, err := h.ListTransactions(ctx, &pb.ListTransactionsRequest{UserId: 1, PageSize: 10, PageNumber: 1})
+
+    assert.NoError(t, err)
+    assert.Equal(t, int64(2), resp.TotalCount)
+    assert.Equal(t, usd(200), fromPB(resp.Balance))
+    require.Len(t, resp.Transactions, 2)
+    assert.Equal(t, int64(3), resp.Transactions[0].AuctionId)
+    assert.Equal(t, usd(-300), fromPB(resp.Transactions[0].Amount))
+    assert.Zero(t, resp.Transactions[1].AuctionId)
+    mockUC.AssertExpectations(t)
+}
+
+type memoryLedgerRepo struct {
+    mu           sync.Mutex
+    accounts     []*entity.Account
+    transactions []*entity.LedgerTransaction
+}
+
+func newMemoryLedgerRepo() *memoryLedgerRepo {
+    return &memoryLedgerRepo{
+        accounts: []*entity.Account{{ID: 1, Type: entity.AccountTypeExternal, Currency: entity.DefaultCurrency}},
+    }
+}
+
+// newMemoryLedger returns a ledger service over a fresh ledger that keeps
+// the wallets in userRepo in sync.
+func newMemoryLedger(userRepo *memoryUserRepo) *ledger.Service {
+    return ledger.NewService(newMemoryLedgerRepo(), userRepo, &memoryTxManager{})
+}
+
+func (r *memoryLedgerRepo) GetUserAccount(ctx context.Context, userID int64, currency string) (*entity.Account, error) {
+    return r.account(entity.AccountTypeUser, &userID, currency), nil
+}
+
+func (r *memoryLedgerRepo) GetExternalAccount(ctx context.Context, currency string) (*entity.Account, error) {
+    return r.account(entity.AccountTypeExternal, nil, currency), nil
+}
+
+// account returns the matching account, opening it on first use.
+func (r *memoryLedgerRepo) account(accountType entity.AccountType, userID *int64, currency string) *entity.Account {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, a := range r.accounts {
+        sameUser := (a.UserID == nil && userID == nil) || (a.UserID != nil && userID != nil && *a.UserID == *userID)
+        if a.Type == accountType && sameUser && a.Currency == currency {
+            return a
+        }
+    }
+    account := &entity.Account{
+        ID:        int64(len(r.accounts) + 1),
+        Type:      accountType,
+        UserID:    userID,
+        Currency:  currency,
+        CreatedAt: time.Now(),
+    }
+    r.accounts = append(r.accounts, account)
+    return account
+}
+
+func (r *memoryLedgerRepo) GetPlatformAccount(ctx context.Context, currency string) (*entity.Account, error) {
+    return r.account(entity.AccountTypePlatform, nil, currency), nil
+}
+
+func (r *memoryLedgerRepo) GetPayoutAccount(ctx context.Context, currency string) (*entity.Account, error) {
+    return r.account(entity.AccountTypePayout, nil, currency), nil
+}
+
+func (r *memoryLedgerRepo) CreateTransaction(ctx context.Context, tx *entity.LedgerTransaction) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    tx.ID = int64(len(r.transactions) + 1)
+    tx.CreatedAt = time.Now()
+    for i, e := range tx.Entries {
+        e.ID = int64(i + 1)
+        e.TransactionID = tx.ID
+    }
+    r.transactions = append(r.transactions, tx)
+    return nil
+}
+
+func (r *memoryLedgerRepo) GetBalance(ctx context.Context, accountID int64) (entity.Money, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var balance entity.Money
+    for _, tx := range r.transactions {
+        for _, e := range tx.Entries {
+            if e.AccountID == accountID {
+                balance = balance.Add(e.Signed())
+            }
+        }
+    }
+    return balance, nil
+}
+
+func (r *memoryLedgerRepo) ListStatement(ctx context.Context, accountID int64, offset, limit int) ([]*entity.StatementLine, int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var lines []*entity.StatementLine
+    var balance entity.Money
+    for _, tx := range r.transactions {
+        var amount entity.Money
+        touched := false
+        for _, e := range tx.Entries {
+            if e.AccountID == accountID {
+                amount = amount.Add(e.Signed())
+                touched = true
+            }
+        }
+        if !touched {
+            continue
+        }
+        balance = balance.Add(amount)
+        lines = append([]*entity.StatementLine{{
+            TransactionID: tx.ID,
+            Kind:          tx.Kind,
+            AuctionID:     tx.AuctionID,
+            Description:   tx.Description,
+            Amount:        amount,
+            BalanceAfter:  balance,
+            CreatedAt:     tx.CreatedAt,
+        }}, lines...)
+    }
+    total := int64(len(lines))
+    if offset >= len(lines) {
+        return nil, total, nil
+    }
+    return lines[offset:min(offset+limit, len(lines))], total, nil
+}
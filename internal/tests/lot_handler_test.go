@@ -77,7 +77,7 @@ func createTestLotResponse() *dto.LotResponse {
         ID:          1,
         Title:       "Test Lot",
         Description: "Test Description",
-        StartPrice:  100.0,
+        StartPrice:  usd(100),
         CreatorID:   1,
         CreatedAt:   now,
     }
@@ -99,7 +99,7 @@ func TestCreateLot(t *testing.T) {
     req := &pb.CreateLotRequest{
         Title:       "Test Lot",
         Description: "Test Description",
-        StartPrice:  100.0,
+        StartPrice:  pbUSD(100),
         CreatorId:   1,
     }
 
@@ -107,7 +107,7 @@ func TestCreateLot(t *testing.T) {
     mockUC.On("Execute", ctx, mock.MatchedBy(func(req *dto.CreateLotRequest) bool {
         return req.Title == "Test Lot" && 
                req.Description == "Test Description" && 
-               req.StartPrice == 100.0 &&
+               req.StartPrice.Cmp(usd(100)) == 0 &&
                req.CreatorID == 1
     })).Return(expectedResp, nil)
 
@@ -150,18 +150,18 @@ func TestUpdateLot(t *testing.T) {
         Id:          1,
         Title:       "Updated Lot",
         Description: "Updated Description",
-        StartPrice:  150.0,
+        StartPrice:  pbUSD(150),
     }
 
     expectedResp := createTestLotResponse()
     expectedResp.Title = "Updated Lot"
     expectedResp.Description = "Updated Description"
-    expectedResp.StartPrice = 150.0
+    expectedResp.StartPrice = usd(150)
 
     mockUC.On("Execute", ctx, int64(1), mock.MatchedBy(func(req *dto.UpdateLotRequest) bool {
         return req.Title == "Updated Lot" && 
                req.Description == "Updated Description" && 
-               req.StartPrice == 150.0
+               req.StartPrice.Cmp(usd(150)) == 0
     })).Return(expectedResp, nil)
 
     resp, err := h.UpdateLot(ctx, req)
@@ -171,7 +171,7 @@ func TestUpdateLot(t *testing.T) {
     assert.Equal(t, expectedResp.ID, resp.Lot.Id)
     assert.Equal(t, expectedResp.Title, resp.Lot.Title)
     assert.Equal(t, expectedResp.Description, resp.Lot.Description)
-    assert.Equal(t, expectedResp.StartPrice, resp.Lot.StartPrice)
+    assert.Equal(t, expectedResp.StartPrice, fromPB(resp.Lot.StartPrice))
     mockUC.AssertExpectations(t)
 }
 
@@ -0,0 +1,142 @@
+package tests
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/application/settlement"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+)
+
+// market is what the bidding, settlement and payment fixtures share: users
+// with USD wallets, their bids and held funds, the winners and payments of
+// settled auctions, and the escrow service over them.
+type market struct {
+    userRepo    *memoryUserRepo
+    bidRepo     *memoryBidRepo
+    holdRepo    *memoryHoldRepo
+    winnerRepo  *memoryWinnerRepo
+    paymentRepo *memoryPaymentRepo
+    escrow      *escrow.Service
+}
+
+func newMarket(users ...*entity.User) *market {
+    m := &market{
+        userRepo:    newMemoryUserRepo(users...),
+        bidRepo:     &memoryBidRepo{},
+        holdRepo:    &memoryHoldRepo{},
+        winnerRepo:  &memoryWinnerRepo{},
+        paymentRepo: &memoryPaymentRepo{},
+    }
+    m.escrow = escrow.NewService(m.holdRepo, m.bidRepo, m.userRepo, m.userRepo)
+    return m
+}
+
+// settlement settles auctions of the lots in lotRepo under fees.
+func (m *market) settlement(lotRepo *memoryLotRepo, fees entity.FeeSchedule, invoices *settlement.InvoiceIssuer) *settlement.Service {
+    return settlement.NewService(lotRepo, m.winnerRepo, m.holdRepo, m.paymentRepo, m.escrow, fees, invoices)
+}
+
+// settle settles the auction in auctionRepo that winners won and saves it.
+func (m *market) settle(t *testing.T, service *settlement.Service, auctionRepo *memoryAuctionRepo, winners ...*entity.AuctionWinner) *entity.Auction {
+    ctx := context.Background()
+    auction, err := auctionRepo.GetByID(ctx, winners[0].AuctionID)
+    require.NoError(t, err)
+    require.NoError(t, service.Settle(ctx, auction, winners))
+    _, err = auctionRepo.Update(ctx, auction.ID, auction)
+    require.NoError(t, err)
+    return auction
+}
+
+// paymentProcessor collects the market's payments through gateway.
+func (m *market) paymentProcessor(auctionRepo *memoryAuctionRepo, lotRepo *memoryLotRepo, gateway *fakeGateway, notifier *recordingNotifier) *settlement.PaymentProcessor {
+    return newPaymentProcessor(m.paymentRepo, auctionRepo, lotRepo, m.holdRepo, m.userRepo, gateway, notifier)
+}
+
+func (m *market) balance(t *testing.T, userID int64) entity.Money {
+    user, err := m.userRepo.GetByID(context.Background(), userID)
+    require.NoError(t, err)
+    return user.Wallet("USD").Balance
+}
+
+func (m *market) held(t *testing.T, userID int64) entity.Money {
+    total, err := m.holdRepo.GetTotalByUserID(context.Background(), userID, "USD")
+    require.NoError(t, err)
+    return total
+}
+
+// newBidders is a market of users 1 to 3 with 10000 USD each.
+func newBidders() *market {
+    return newMarket(
+        userWithBalance(1, usd(10000)),
+        userWithBalance(2, usd(10000)),
+        userWithBalance(3, usd(10000)),
+    )
+}
+
+// bidding is what the bidding fixtures share: the users of a market bid
+// in auctions of lot 1, sold by user 9, and what their bids notify is
+// recorded in notifier.
+type bidding struct {
+    *market
+    auctionRepo *memoryAuctionRepo
+    notifier    *recordingNotifier
+    dispatcher  *notifier.Dispatcher
+    placeBid    *bidUC.PlaceBidUseCase
+    setMaxBid   *bidUC.SetMaxBidUseCase
+}
+
+func newBidding(m *market, auctions ...*entity.Auction) *bidding {
+    recording := &recordingNotifier{}
+    b := newBiddingThrough(m, recording, 64, auctions...)
+    b.notifier = recording
+    return b
+}
+
+// newBiddingThrough sends the notifications through service, with room
+// for queue of them, and records none.
+func newBiddingThrough(m *market, service notification.NotificationService, queue int, auctions ...*entity.Auction) *bidding {
+    b := &bidding{
+        market:      m,
+        auctionRepo: newMemoryAuctionRepo(auctions...),
+        dispatcher:  notifier.NewDispatcher(service, queue, 1, time.Second),
+    }
+    lotRepo, maxBidRepo, txManager := newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 9}), &memoryMaxBidRepo{}, &memoryTxManager{}
+    b.placeBid = bidUC.NewPlaceBidUseCase(m.bidRepo, b.auctionRepo, maxBidRepo, lotRepo, txManager,
+        m.escrow, eventbus.NewAuctionBus(16, 0), b.dispatcher, 50)
+    b.setMaxBid = bidUC.NewSetMaxBidUseCase(m.bidRepo, maxBidRepo, b.auctionRepo, lotRepo, txManager,
+        m.escrow, eventbus.NewAuctionBus(16, 0), b.dispatcher, 50)
+    return b
+}
+
+// place bids amount for quantity units of auction 1.
+func (b *bidding) place(t *testing.T, userID, amount int64, quantity int) *dto.BidResponse {
+    resp, err := b.placeBid.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: userID, Amount: usd(amount), Quantity: quantity})
+    require.NoError(t, err)
+    return resp
+}
+
+// leader is the leading bid of auction 1.
+func (b *bidding) leader(t *testing.T) *entity.Bid {
+    leading, err := b.bidRepo.GetHighestByAuctionID(context.Background(), 1)
+    require.NoError(t, err)
+    require.NotNil(t, leading)
+    return leading
+}
+
+// sent waits for the dispatched notifications and returns them.
+func (b *bidding) sent() (outbid, newBids []bidNotification) {
+    b.dispatcher.Close()
+    b.notifier.mu.Lock()
+    defer b.notifier.mu.Unlock()
+    return b.notifier.outbid, b.notifier.newBids
+}
@@ -0,0 +1,509 @@
+package tests
+
+import (
+    "context"
+    "runtime"
+    "sort"
+    "sync"
+    "time"
+
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+)
+
+// memoryTxManager runs units of work as transactions that hold the row
+// locks taken by the memory repositories' ForUpdate methods until they end.
+// Transactions that lock no common row run concurrently. Nothing is rolled
+// back.
+type memoryTxManager struct{}
+
+type memoryTxKey struct{}
+
+// memoryTx is a running transaction of a memoryTxManager.
+type memoryTx struct {
+    held    map[memoryRowKey]bool
+    unlocks []func()
+}
+
+type memoryRowKey struct {
+    locks *memoryRowLocks
+    id    int64
+}
+
+func (m *memoryTxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+    if ctx.Value(memoryTxKey{}) != nil {
+        return fn(ctx)
+    }
+    tx := &memoryTx{held: make(map[memoryRowKey]bool)}
+    defer func() {
+        for _, unlock := range tx.unlocks {
+            unlock()
+        }
+    }()
+    return fn(context.WithValue(ctx, memoryTxKey{}, tx))
+}
+
+// memoryRowLocks models SELECT ... FOR UPDATE on one table: a row locked
+// inside a transaction stays locked until the transaction ends, and other
+// transactions locking it wait until then. Outside a transaction the lock
+// is released at once, as it would be at the end of the statement.
+type memoryRowLocks struct {
+    mu   sync.Mutex
+    rows map[int64]*sync.Mutex
+}
+
+func (l *memoryRowLocks) lock(ctx context.Context, id int64) {
+    tx, ok := ctx.Value(memoryTxKey{}).(*memoryTx)
+    if !ok {
+        return
+    }
+    key := memoryRowKey{locks: l, id: id}
+    if tx.held[key] {
+        return
+    }
+
+    l.mu.Lock()
+    if l.rows == nil {
+        l.rows = make(map[int64]*sync.Mutex)
+    }
+    row, ok := l.rows[id]
+    if !ok {
+        row = &sync.Mutex{}
+        l.rows[id] = row
+    }
+    l.mu.Unlock()
+
+    row.Lock()
+    tx.held[key] = true
+    tx.unlocks = append(tx.unlocks, row.Unlock)
+}
+
+type memoryAuctionRepo struct {
+    mu       sync.Mutex
+    locks    memoryRowLocks
+    auctions map[int64]*entity.Auction
+}
+
+func newMemoryAuctionRepo(auctions ...*entity.Auction) *memoryAuctionRepo {
+    repo := &memoryAuctionRepo{auctions: make(map[int64]*entity.Auction)}
+    for _, a := range auctions {
+        defaultAuctionCurrency(a)
+        repo.auctions[a.ID] = a
+    }
+    return repo
+}
+
+// defaultAuctionCurrency mirrors the column default: fixtures that do not
+// name a currency trade in USD.
+func defaultAuctionCurrency(a *entity.Auction) {
+    if a.Currency == "" {
+        a.SetCurrency(entity.DefaultCurrency)
+    }
+}
+
+func (r *memoryAuctionRepo) Create(ctx context.Context, auction *entity.Auction) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    auction.ID = int64(len(r.auctions) + 1)
+    defaultAuctionCurrency(auction)
+    r.auctions[auction.ID] = auction
+    return nil
+}
+
+// GetByID yields before returning what it read, as a round trip to the
+// database would, so that concurrent transactions interleave between a read
+// and what they write next even on a single CPU.
+func (r *memoryAuctionRepo) GetByID(ctx context.Context, id int64) (*entity.Auction, error) {
+    defer runtime.Gosched()
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    a, ok := r.auctions[id]
+    if !ok {
+        return nil, errors.NewNotFoundError("auction not found")
+    }
+    copied := *a
+    return &copied, nil
+}
+
+func (r *memoryAuctionRepo) GetByIDForUpdate(ctx context.Context, id int64) (*entity.Auction, error) {
+    r.locks.lock(ctx, id)
+    return r.GetByID(ctx, id)
+}
+
+// Update mirrors the postgres repository: only non-zero fields are written.
+func (r *memoryAuctionRepo) Update(ctx context.Context, id int64, auction *entity.Auction) (*entity.Auction, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    stored, ok := r.auctions[id]
+    if !ok {
+        return nil, errors.NewNotFoundError("auction not found")
+    }
+    if auction.StartPrice.IsPositive() {
+        stored.StartPrice = auction.StartPrice
+    }
+    if auction.MinStep.IsPositive() {
+        stored.MinStep = auction.MinStep
+    }
+    if auction.CurrentPrice.IsPositive() {
+        stored.CurrentPrice = auction.CurrentPrice
+    }
+    if !auction.StartTime.IsZero() {
+        stored.StartTime = auction.StartTime
+    }
+    if !auction.EndTime.IsZero() {
+        stored.EndTime = auction.EndTime
+    }
+    if auction.Status != "" {
+        stored.Status = auction.Status
+    }
+    if auction.WinnerID != nil {
+        stored.WinnerID = auction.WinnerID
+    }
+    if auction.WinnerBidID != nil {
+        stored.WinnerBidID = auction.WinnerBidID
+    }
+    if auction.ReservePrice.IsPositive() {
+        stored.ReservePrice = auction.ReservePrice
+    }
+    if auction.TotalExtension > 0 {
+        stored.TotalExtension = auction.TotalExtension
+    }
+    if auction.BuyNowPrice.IsPositive() {
+        stored.BuyNowPrice = auction.BuyNowPrice
+    }
+    if auction.BuyNowDisabled {
+        stored.BuyNowDisabled = true
+    }
+    stored.UpdatedAt = time.Now()
+    auction.ID = id
+    return auction, nil
+}
+
+func (r *memoryAuctionRepo) List(ctx context.Context, offset, limit int, status *entity.AuctionStatus) ([]*entity.Auction, int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.Auction
+    for _, a := range r.auctions {
+        if status == nil || a.Status == *status {
+            copied := *a
+            result = append(result, &copied)
+        }
+    }
+    sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+    total := int64(len(result))
+    if offset >= len(result) {
+        return nil, total, nil
+    }
+    return result[offset:min(offset+limit, len(result))], total, nil
+}
+
+func (r *memoryAuctionRepo) GetByLotID(ctx context.Context, lotID int64) (*entity.Auction, error) {
+    return nil, nil
+}
+
+func (r *memoryAuctionRepo) UpdateStatus(ctx context.Context, id int64, status entity.AuctionStatus) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    a, ok := r.auctions[id]
+    if !ok {
+        return errors.NewNotFoundError("auction not found")
+    }
+    a.Status = status
+    return nil
+}
+
+func (r *memoryAuctionRepo) GetActiveAuctions(ctx context.Context) ([]*entity.Auction, error) {
+    return nil, nil
+}
+
+func (r *memoryAuctionRepo) GetPendingAuctionsToStart(ctx context.Context) ([]*entity.Auction, error) {
+    return nil, nil
+}
+
+func (r *memoryAuctionRepo) GetEndedAuctions(ctx context.Context) ([]*entity.Auction, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.Auction
+    for _, a := range r.auctions {
+        if a.Status == entity.AuctionStatusActive && a.HasEnded(time.Now()) {
+            copied := *a
+            result = append(result, &copied)
+        }
+    }
+    return result, nil
+}
+
+func (r *memoryAuctionRepo) GetActiveDutchAuctions(ctx context.Context) ([]*entity.Auction, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.Auction
+    for _, a := range r.auctions {
+        if a.IsDutch() && a.Status == entity.AuctionStatusActive {
+            copied := *a
+            result = append(result, &copied)
+        }
+    }
+    return result, nil
+}
+
+type memoryBidRepo struct {
+    mu   sync.Mutex
+    bids []*entity.Bid
+}
+
+func (r *memoryBidRepo) Create(ctx context.Context, bid *entity.Bid) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    bid.ID = int64(len(r.bids) + 1)
+    bid.CreatedAt = time.Now()
+    bid.UpdatedAt = bid.CreatedAt
+    copied := *bid
+    r.bids = append(r.bids, &copied)
+    return nil
+}
+
+func (r *memoryBidRepo) GetByID(ctx context.Context, id int64) (*entity.Bid, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, b := range r.bids {
+        if b.ID == id {
+            copied := *b
+            return &copied, nil
+        }
+    }
+    return nil, errors.NewNotFoundError("bid not found")
+}
+
+func (r *memoryBidRepo) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.Bid, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.Bid
+    for _, b := range r.bids {
+        if b.AuctionID == auctionID {
+            copied := *b
+            result = append(result, &copied)
+        }
+    }
+    // Ranked like the postgres repository.
+    sort.Slice(result, func(i, j int) bool {
+        if c := result[i].Amount.Cmp(result[j].Amount); c != 0 {
+            return c > 0
+        }
+        if !result[i].UpdatedAt.Equal(result[j].UpdatedAt) {
+            return result[i].UpdatedAt.Before(result[j].UpdatedAt)
+        }
+        return result[i].ID < result[j].ID
+    })
+    return result, nil
+}
+
+func (r *memoryBidRepo) GetByAuctionAndUser(ctx context.Context, auctionID, userID int64) (*entity.Bid, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, b := range r.bids {
+        if b.AuctionID == auctionID && b.UserID == userID {
+            copied := *b
+            return &copied, nil
+        }
+    }
+    return nil, nil
+}
+
+func (r *memoryBidRepo) UpdateAmount(ctx context.Context, id int64, amount entity.Money) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, b := range r.bids {
+        if b.ID == id {
+            b.Amount = amount
+            b.UpdatedAt = time.Now()
+            return nil
+        }
+    }
+    return errors.NewNotFoundError("bid not found")
+}
+
+func (r *memoryBidRepo) GetHighestByAuctionID(ctx context.Context, auctionID int64) (*entity.Bid, error) {
+    bids, _ := r.GetByAuctionID(ctx, auctionID)
+    if len(bids) == 0 {
+        return nil, nil
+    }
+    return bids[0], nil
+}
+
+func (r *memoryBidRepo) List(ctx context.Context, auctionID int64, offset, limit int) ([]*entity.Bid, int64, error) {
+    bids, _ := r.GetByAuctionID(ctx, auctionID)
+    return bids, int64(len(bids)), nil
+}
+
+func (r *memoryBidRepo) GetUniqueParticipantsByAuctionID(ctx context.Context, auctionID int64) ([]int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []int64
+    seen := make(map[int64]bool)
+    for _, b := range r.bids {
+        if b.AuctionID == auctionID && !seen[b.UserID] {
+            seen[b.UserID] = true
+            result = append(result, b.UserID)
+        }
+    }
+    return result, nil
+}
+
+type memoryLotRepo struct {
+    mu   sync.Mutex
+    lots map[int64]*entity.Lot
+}
+
+func newMemoryLotRepo(lots ...*entity.Lot) *memoryLotRepo {
+    repo := &memoryLotRepo{lots: make(map[int64]*entity.Lot)}
+    for _, l := range lots {
+        if l.Currency == "" {
+            l.Currency = entity.DefaultCurrency
+        }
+        repo.lots[l.ID] = l
+    }
+    return repo
+}
+
+func (r *memoryLotRepo) Create(ctx context.Context, lot *entity.Lot) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    lot.ID = int64(len(r.lots) + 1)
+    r.lots[lot.ID] = lot
+    return nil
+}
+
+func (r *memoryLotRepo) GetByID(ctx context.Context, id int64) (*entity.Lot, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    l, ok := r.lots[id]
+    if !ok {
+        return nil, errors.NewNotFoundError("lot not found")
+    }
+    copied := *l
+    return &copied, nil
+}
+
+func (r *memoryLotRepo) Update(ctx context.Context, id int64, lot *entity.Lot) (*entity.Lot, error) {
+    return lot, nil
+}
+
+func (r *memoryLotRepo) Delete(ctx context.Context, id int64) error {
+    return nil
+}
+
+func (r *memoryLotRepo) List(ctx context.Context, offset, limit int) ([]*entity.Lot, int64, error) {
+    return nil, 0, nil
+}
+
+type memoryUserRepo struct {
+    mu    sync.Mutex
+    locks memoryRowLocks
+    users map[int64]*entity.User
+}
+
+func newMemoryUserRepo(users ...*entity.User) *memoryUserRepo {
+    repo := &memoryUserRepo{users: make(map[int64]*entity.User)}
+    for _, u := range users {
+        repo.users[u.ID] = u
+    }
+    return repo
+}
+
+func (r *memoryUserRepo) GetAll(ctx context.Context) ([]*entity.User, error) {
+    return nil, nil
+}
+
+func (r *memoryUserRepo) Create(ctx context.Context, user *entity.User) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    user.ID = int64(len(r.users) + 1)
+    r.users[user.ID] = user
+    return nil
+}
+
+func (r *memoryUserRepo) GetByID(ctx context.Context, id int64) (*entity.User, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    u, ok := r.users[id]
+    if !ok {
+        return nil, errors.NewNotFoundError("user not found")
+    }
+    copied := *u
+    return &copied, nil
+}
+
+func (r *memoryUserRepo) GetByIDForUpdate(ctx context.Context, id int64) (*entity.User, error) {
+    r.locks.lock(ctx, id)
+    return r.GetByID(ctx, id)
+}
+
+func (r *memoryUserRepo) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+    return nil, nil
+}
+
+// memoryUserRepo also serves as the wallet repository, keeping each user's
+// wallets on the stored user.
+func (r *memoryUserRepo) GetByUserID(ctx context.Context, userID int64) ([]*entity.Wallet, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    u, ok := r.users[userID]
+    if !ok {
+        return nil, nil
+    }
+    var result []*entity.Wallet
+    for _, w := range u.Wallets {
+        copied := *w
+        result = append(result, &copied)
+    }
+    return result, nil
+}
+
+func (r *memoryUserRepo) Get(ctx context.Context, userID int64, currency string) (*entity.Wallet, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    u, ok := r.users[userID]
+    if !ok {
+        return entity.NewWallet(userID, currency), nil
+    }
+    copied := *u.Wallet(currency)
+    return &copied, nil
+}
+
+func (r *memoryUserRepo) Adjust(ctx context.Context, userID int64, delta entity.Money) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    u, ok := r.users[userID]
+    if !ok {
+        return errors.NewNotFoundError("user not found")
+    }
+    for _, w := range u.Wallets {
+        if w.Currency == delta.Currency {
+            w.Balance = w.Balance.Add(delta)
+            return nil
+        }
+    }
+    wallet := entity.NewWallet(userID, delta.Currency)
+    wallet.Balance = delta
+    u.Wallets = append(u.Wallets, wallet)
+    return nil
+}
+
+// userWithBalance returns a user holding balance in a single wallet.
+func userWithBalance(id int64, balance entity.Money) *entity.User {
+    wallet := entity.NewWallet(id, balance.Currency)
+    wallet.Balance = balance
+    return &entity.User{ID: id, Wallets: []*entity.Wallet{wallet}}
+}
+
+func (r *memoryUserRepo) List(ctx context.Context, offset, limit int) ([]*entity.User, int64, error) {
+    return nil, 0, nil
+}
+
+func (r *memoryUserRepo) Update(ctx context.Context, id int64, user *entity.User) (*entity.User, error) {
+    return user, nil
+}
+
+func (r *memoryUserRepo) Delete(ctx context.Context, id int64) error {
+    return nil
+}
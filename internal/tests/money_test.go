@@ -0,0 +1,118 @@
+package tests
+
+import (
+    "math"
+    "testing"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    "auction-system/internal/domain/entity"
+    pb "auction-system/pkg/api"
+)
+
+// usd is a whole-dollar amount, which is all most tests need.
+func usd(units int64) entity.Money {
+    return entity.NewMoney(units*100, entity.DefaultCurrency)
+}
+
+func pbUSD(units int64) *pb.Money {
+    return &pb.Money{CurrencyCode: entity.DefaultCurrency, Units: units}
+}
+
+// fromPB reads an amount from a response, which the handlers only ever fill
+// with valid money.
+func fromPB(m *pb.Money) entity.Money {
+    money, err := entity.MoneyFromUnits(m.GetUnits(), m.GetNanos(), m.GetCurrencyCode())
+    if err != nil {
+        panic(err)
+    }
+    return money
+}
+
+func TestParseMoney(t *testing.T) {
+    for input, minor := range map[string]int64{
+        "150000.50": 15000050,
+        "0.1":       10,
+        "42":        4200,
+        "-7.05":     -705,
+        "3.10":      310,
+    } {
+        m, err := entity.ParseMoney(input, "USD")
+        require.NoError(t, err, input)
+        assert.Equal(t, minor, m.Minor, input)
+    }
+
+    for _, input := range []string{"", "1.005", "abc", "1.2.3", "1.-5", "+5", "--5", "92233720368547758.08"} {
+        _, err := entity.ParseMoney(input, "USD")
+        assert.Error(t, err, input)
+    }
+}
+
+func TestMoneyString(t *testing.T) {
+    assert.Equal(t, "150000.50", entity.NewMoney(15000050, "USD").String())
+    assert.Equal(t, "0.05", entity.NewMoney(5, "USD").String())
+    assert.Equal(t, "-7.05", entity.NewMoney(-705, "USD").String())
+}
+
+func TestMoneyUnitsRoundTrip(t *testing.T) {
+    for _, minor := range []int64{0, 1, 99, 100, 15000050, -705} {
+        m := entity.NewMoney(minor, "EUR")
+        units, nanos := m.Units()
+        assert.Equal(t, m, fromPB(&pb.Money{Units: units, Nanos: nanos, CurrencyCode: "EUR"}))
+    }
+
+    assert.Equal(t, int64(101), fromPB(&pb.Money{Units: 1, Nanos: 5_000_000, CurrencyCode: "USD"}).Minor, "half a cent rounds away from zero")
+    assert.Equal(t, int64(-101), fromPB(&pb.Money{Units: -1, Nanos: -5_000_000, CurrencyCode: "USD"}).Minor)
+    assert.Equal(t, int64(-50), fromPB(&pb.Money{Nanos: -500_000_000, CurrencyCode: "USD"}).Minor, "nanos carry the sign of zero units")
+    assert.Equal(t, int64(92233720368547756*100+100), fromPB(&pb.Money{Units: 92233720368547756, Nanos: 999_999_999, CurrencyCode: "USD"}).Minor)
+}
+
+func TestMoneyFromUnitsRefusesInvalidAmounts(t *testing.T) {
+    for name, m := range map[string]*pb.Money{
+        "nanos of a whole unit":      {Units: 1, Nanos: 1_000_000_000, CurrencyCode: "USD"},
+        "negative nanos of a unit":   {Units: -1, Nanos: -1_000_000_000, CurrencyCode: "USD"},
+        "negative nanos":             {Units: 1, Nanos: -5_000_000, CurrencyCode: "USD"},
+        "positive nanos":             {Units: -1, Nanos: 5_000_000, CurrencyCode: "USD"},
+        "too many units":             {Units: math.MaxInt64, CurrencyCode: "USD"},
+        "too few units":              {Units: math.MinInt64, CurrencyCode: "USD"},
+        "units just past the limit":  {Units: 92233720368547758, CurrencyCode: "USD"},
+        "lower-case currency":        {Units: 1, CurrencyCode: "usd"},
+        "unknown currency":           {Units: 1, CurrencyCode: "DOLLARS"},
+        "missing currency":           {Units: 1},
+    } {
+        _, err := entity.MoneyFromUnits(m.Units, m.Nanos, m.CurrencyCode)
+        assert.Error(t, err, name)
+    }
+}
+
+func TestMoneyArithmeticIsExact(t *testing.T) {
+    // 0.1 + 0.2 is not 0.3 in floating point.
+    sum := entity.NewMoney(10, "USD").Add(entity.NewMoney(20, "USD"))
+    assert.Equal(t, 0, sum.Cmp(entity.NewMoney(30, "USD")))
+
+    price := usd(1000).Add(entity.NewMoney(1, "USD"))
+    assert.True(t, price.GreaterThan(usd(1000)))
+    assert.Equal(t, int64(300003), price.Mul(3).Minor)
+    assert.Equal(t, entity.NewMoney(12345, "USD"), entity.NewMoney(24690, "USD").Percent(50))
+    assert.Equal(t, entity.NewMoney(2, "USD"), entity.NewMoney(3, "USD").Percent(50), "rounds half away from zero")
+    assert.Equal(t, "USD", entity.Money{}.Add(usd(1)).Currency, "zero value adopts the other currency")
+}
+
+func TestMoneyRefusesMixedCurrencies(t *testing.T) {
+    assert.Panics(t, func() { usd(1).Add(entity.NewMoney(100, "EUR")) })
+    assert.Panics(t, func() { usd(1).LessThan(entity.NewMoney(100, "EUR")) })
+    assert.NotPanics(t, func() { usd(1).Cmp(entity.Money{}) })
+}
+
+func TestMoneyMulCheckedRefusesOverflow(t *testing.T) {
+    _, err := usd(1).MulChecked(math.MaxInt64)
+    assert.Error(t, err)
+    _, err = entity.NewMoney(math.MinInt64, "USD").MulChecked(-1)
+    assert.Error(t, err)
+    assert.Panics(t, func() { usd(1).Mul(math.MaxInt64) })
+
+    product, err := usd(3).MulChecked(4)
+    require.NoError(t, err)
+    assert.Equal(t, usd(12), product)
+}
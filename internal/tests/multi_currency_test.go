@@ -0,0 +1,119 @@
+package tests
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    auctionDto "auction-system/internal/application/dto/auction"
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    auctionUC "auction-system/internal/application/usecase/auction"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/infrastructure/exchange"
+)
+
+func eur(units int64) entity.Money {
+    return entity.NewMoney(units*100, "EUR")
+}
+
+func eurAuction(id int64) *entity.Auction {
+    auction := activeAuction(id)
+    auction.SetCurrency("EUR")
+    return auction
+}
+
+func TestCreateAuctionTakesLotCurrency(t *testing.T) {
+    lotRepo := newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1, Currency: "EUR"})
+    uc := auctionUC.NewCreateAuctionUseCase(newMemoryAuctionRepo(), lotRepo)
+    now := time.Now()
+    newRequest := func(start, step entity.Money) *auctionDto.CreateAuctionRequest {
+        return &auctionDto.CreateAuctionRequest{
+            LotID:      1,
+            StartPrice: start,
+            MinStep:    step,
+            StartTime:  now,
+            EndTime:    now.Add(time.Hour),
+        }
+    }
+
+    _, err := uc.Execute(context.Background(), newRequest(usd(100), usd(10)))
+    assert.Error(t, err, "prices must be in the lot currency")
+
+    mixed := newRequest(eur(100), eur(10))
+    mixed.ReservePrice = usd(200)
+    _, err = uc.Execute(context.Background(), mixed)
+    assert.Error(t, err, "prices in different currencies are refused rather than compared")
+
+    resp, err := uc.Execute(context.Background(), newRequest(eur(100), eur(10)))
+    require.NoError(t, err)
+    assert.Equal(t, "EUR", resp.Currency)
+    assert.Equal(t, eur(100), resp.CurrentPrice)
+}
+
+func TestBidMustBeInAuctionCurrency(t *testing.T) {
+    userRepo := newMemoryUserRepo(userWithBalance(2, usd(5000)))
+    require.NoError(t, userRepo.Adjust(context.Background(), 2, eur(300)))
+    bidRepo := &memoryBidRepo{}
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, newMemoryAuctionRepo(eurAuction(1)), &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{},
+        escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+
+    _, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(200)})
+    assert.Error(t, err, "bids are never converted")
+
+    _, err = uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: eur(400)})
+    assert.Error(t, err, "the USD wallet does not back EUR bids")
+
+    resp, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: eur(200)})
+    require.NoError(t, err)
+    assert.Equal(t, eur(200), resp.Amount)
+}
+
+func TestListAuctionsConvertsDisplayPrices(t *testing.T) {
+    withBuyNow := activeAuction(2)
+    withBuyNow.BuyNowPrice = usd(1000)
+    rates := exchange.NewStaticRateProvider("USD", map[string]float64{"EUR": 0.5})
+    uc := auctionUC.NewListAuctionsUseCase(newMemoryAuctionRepo(eurAuction(1), withBuyNow), rates)
+
+    resp, err := uc.Execute(context.Background(), 1, 10, nil, "")
+    require.NoError(t, err)
+    require.Len(t, resp.Auctions, 2)
+    assert.Nil(t, resp.Auctions[0].DisplayCurrentPrice)
+
+    resp, err = uc.Execute(context.Background(), 1, 10, nil, "USD")
+    require.NoError(t, err)
+    require.Len(t, resp.Auctions, 2)
+    assert.Equal(t, eur(100), resp.Auctions[0].CurrentPrice, "the auction keeps its own currency")
+    assert.Equal(t, usd(200), *resp.Auctions[0].DisplayCurrentPrice)
+    assert.Nil(t, resp.Auctions[0].DisplayBuyNowPrice)
+    assert.Equal(t, usd(100), *resp.Auctions[1].DisplayCurrentPrice)
+    assert.Equal(t, usd(1000), *resp.Auctions[1].DisplayBuyNowPrice)
+
+    _, err = uc.Execute(context.Background(), 1, 10, nil, "JPY")
+    assert.Error(t, err, "no rate for the display currency")
+}
+
+func TestStaticRateProviderCrossesThroughBase(t *testing.T) {
+    rates := exchange.NewStaticRateProvider("USD", map[string]float64{"EUR": 0.5, "GBP": 0.25})
+
+    rate, err := rates.Rate(context.Background(), "EUR", "GBP")
+    require.NoError(t, err)
+    assert.Equal(t, 0.5, rate)
+    assert.Equal(t, entity.NewMoney(1234, "GBP"), entity.NewMoney(2467, "EUR").Convert(rate, "GBP"))
+
+    _, err = rates.Rate(context.Background(), "USD", "XYZ")
+    assert.Error(t, err)
+}
+
+func TestLedgerTransactionRejectsMixedCurrencies(t *testing.T) {
+    tx := &entity.LedgerTransaction{Entries: []*entity.LedgerEntry{
+        {AccountID: 1, Direction: entity.EntryDirectionDebit, Amount: usd(100)},
+        {AccountID: 2, Direction: entity.EntryDirectionCredit, Amount: eur(100)},
+    }}
+    assert.False(t, tx.IsBalanced())
+}
@@ -0,0 +1,204 @@
+package tests
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    auctionDto "auction-system/internal/application/dto/auction"
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    auctionUC "auction-system/internal/application/usecase/auction"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+)
+
+func multiUnitAuction(pricing entity.MultiUnitPricing) *entity.Auction {
+    return &entity.Auction{
+        ID:           1,
+        LotID:        1,
+        Type:         entity.AuctionTypeEnglish,
+        StartPrice:   usd(100),
+        MinStep:      usd(10),
+        CurrentPrice: usd(100),
+        Quantity:     3,
+        Pricing:      pricing,
+        Status:       entity.AuctionStatusActive,
+        EndTime:      time.Now().Add(time.Hour),
+    }
+}
+
+func TestAllocateUnits(t *testing.T) {
+    bids := []*entity.Bid{
+        {ID: 1, UserID: 2, Amount: usd(150), Quantity: 2},
+        {ID: 2, UserID: 3, Amount: usd(120), Quantity: 2},
+        {ID: 3, UserID: 4, Amount: usd(110), Quantity: 1},
+    }
+
+    uniform := multiUnitAuction(entity.MultiUnitPricingUniform).Allocate(bids)
+    require.Len(t, uniform, 2)
+    assert.Equal(t, 2, uniform[0].Quantity)
+    assert.Equal(t, usd(120), uniform[0].UnitPrice)
+    assert.Equal(t, 1, uniform[1].Quantity, "marginal bid is partially filled")
+    assert.Equal(t, usd(120), uniform[1].UnitPrice)
+
+    discriminatory := multiUnitAuction(entity.MultiUnitPricingDiscriminatory).Allocate(bids)
+    require.Len(t, discriminatory, 2)
+    assert.Equal(t, usd(300), discriminatory[0].Total())
+    assert.Equal(t, usd(120), discriminatory[1].Total())
+
+    reserved := multiUnitAuction(entity.MultiUnitPricingUniform)
+    reserved.ReservePrice = usd(130)
+    winners := reserved.Allocate(bids)
+    require.Len(t, winners, 1, "bids below the reserve win nothing")
+    assert.Equal(t, usd(150), winners[0].UnitPrice)
+}
+
+func TestMultiUnitBidding(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(multiUnitAuction(entity.MultiUnitPricingUniform))
+    userRepo := newMemoryUserRepo(
+        userWithBalance(2, usd(5000)),
+        userWithBalance(3, usd(5000)),
+        userWithBalance(4, usd(200)),
+    )
+    bidRepo := &memoryBidRepo{}
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+    place := func(userID int64, amount int64, quantity int) error {
+        _, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: userID, Amount: usd(amount), Quantity: quantity})
+        return err
+    }
+    currentPrice := func() entity.Money {
+        auction, err := auctionRepo.GetByID(context.Background(), 1)
+        require.NoError(t, err)
+        return auction.CurrentPrice
+    }
+
+    require.NoError(t, place(2, 150, 2))
+    assert.Equal(t, usd(100), currentPrice(), "units left, price stays at start")
+
+    require.NoError(t, place(3, 120, 2))
+    assert.Equal(t, usd(120), currentPrice())
+
+    assert.Error(t, place(3, 125, 1), "must beat clearing price plus step")
+    assert.Error(t, place(3, 200, 4), "more units than on offer")
+    assert.Error(t, place(4, 140, 2), "funds must cover the total, not the unit price")
+
+    require.NoError(t, place(3, 130, 1))
+    assert.Equal(t, usd(130), currentPrice())
+}
+
+func TestMultiUnitBidsAddUpAgainstFunds(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(multiUnitAuction(entity.MultiUnitPricingDiscriminatory))
+    userRepo := newMemoryUserRepo(userWithBalance(2, usd(400)))
+    bidRepo := &memoryBidRepo{}
+    holdRepo := &memoryHoldRepo{}
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(holdRepo, bidRepo, userRepo, userRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+    place := func(amount int64, quantity int) error {
+        _, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(amount), Quantity: quantity})
+        return err
+    }
+
+    require.NoError(t, place(150, 2))
+    assert.Error(t, place(110, 1), "300 is held for the first bid, 100 is left")
+
+    held, err := holdRepo.GetTotalByUserID(context.Background(), 2, "USD")
+    require.NoError(t, err)
+    assert.Equal(t, usd(300), held)
+}
+
+func TestCloseMultiUnitAuction(t *testing.T) {
+    auction := endedAuction(entity.Money{})
+    auction.Quantity = 3
+    auction.Pricing = entity.MultiUnitPricingUniform
+    f := newCloserFixture(auction,
+        &entity.Bid{AuctionID: 1, UserID: 2, Amount: usd(150), Quantity: 2},
+        &entity.Bid{AuctionID: 1, UserID: 3, Amount: usd(120), Quantity: 2},
+    )
+
+    closed := f.run(t, 1)
+
+    assert.Equal(t, entity.AuctionStatusEnded, closed.Status)
+    assert.Equal(t, usd(120), closed.CurrentPrice)
+    f.collect(t)
+    assert.Equal(t, usd(4760), f.balance(t, 2))
+    assert.Equal(t, usd(4880), f.balance(t, 3))
+    assert.Equal(t, usd(1360), f.balance(t, 1))
+
+    winners, err := f.winnerRepo.GetByAuctionID(context.Background(), 1)
+    require.NoError(t, err)
+    require.Len(t, winners, 2)
+    assert.Equal(t, int64(2), winners[0].UserID)
+    assert.Equal(t, 2, winners[0].Quantity)
+    assert.Equal(t, int64(3), winners[1].UserID)
+    assert.Equal(t, 1, winners[1].Quantity)
+}
+
+func TestCreateAuctionTakesQuantityFromLot(t *testing.T) {
+    lotRepo := newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1, Quantity: 50})
+    uc := auctionUC.NewCreateAuctionUseCase(newMemoryAuctionRepo(), lotRepo)
+    now := time.Now()
+    req := &auctionDto.CreateAuctionRequest{
+        LotID:      1,
+        StartPrice: usd(100),
+        MinStep:    usd(10),
+        StartTime:  now,
+        EndTime:    now.Add(time.Hour),
+        Pricing:    entity.MultiUnitPricingDiscriminatory,
+    }
+
+    resp, err := uc.Execute(context.Background(), req)
+    require.NoError(t, err)
+    assert.Equal(t, 50, resp.Quantity)
+    assert.Equal(t, entity.MultiUnitPricingDiscriminatory, resp.Pricing)
+
+    req.Type = entity.AuctionTypeDutch
+    _, err = uc.Execute(context.Background(), req)
+    assert.Error(t, err)
+}
+
+func TestMultiUnitAuctionOmitsReserveMet(t *testing.T) {
+    auction := multiUnitAuction(entity.MultiUnitPricingUniform)
+    auction.CurrentPrice = usd(150)
+    assert.True(t, *auctionDto.FromEntity(auction).ReserveMet, "without a reserve it is always met")
+
+    auction.ReservePrice = usd(200)
+    assert.Nil(t, auctionDto.FromEntity(auction).ReserveMet, "the lowest winning bid does not tell")
+
+    single := activeAuction(2)
+    single.ReservePrice = usd(200)
+    require.NotNil(t, auctionDto.FromEntity(single).ReserveMet)
+    assert.False(t, *auctionDto.FromEntity(single).ReserveMet)
+}
+
+type memoryWinnerRepo struct {
+    mu      sync.Mutex
+    winners []*entity.AuctionWinner
+}
+
+func (r *memoryWinnerRepo) Create(ctx context.Context, winner *entity.AuctionWinner) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    winner.ID = int64(len(r.winners) + 1)
+    winner.CreatedAt = time.Now()
+    copied := *winner
+    r.winners = append(r.winners, &copied)
+    return nil
+}
+
+func (r *memoryWinnerRepo) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.AuctionWinner, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.AuctionWinner
+    for _, w := range r.winners {
+        if w.AuctionID == auctionID {
+            copied := *w
+            result = append(result, &copied)
+        }
+    }
+    return result, nil
+}
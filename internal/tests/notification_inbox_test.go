@@ -0,0 +1,248 @@
+package tests
+
+import (
+    "context"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    "auction-system/internal/application/eventbus"
+    notificationUC "auction-system/internal/application/usecase/notification"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    notificationInfra "auction-system/internal/infrastructure/notification"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+func TestInboxNotificationAdapterStoresAndPublishesPerUser(t *testing.T) {
+    ctx := context.Background()
+    inbox := &memoryNotificationRepo{}
+    bus := eventbus.NewNotificationBus(16, 0)
+    winner, loser := bus.Subscribe(2), bus.Subscribe(3)
+    notifier := notificationInfra.NewInboxNotificationAdapter(inbox, bus)
+
+    auction := &entity.Auction{ID: 5, Quantity: 5, CurrentPrice: usd(100), Winners: []*entity.AuctionWinner{
+        {UserID: 2, Quantity: 2, UnitPrice: usd(100)},
+        {UserID: 2, Quantity: 1, UnitPrice: usd(100)},
+        {UserID: 4, Quantity: 2, UnitPrice: usd(100)},
+    }}
+    require.NoError(t, notifier.NotifyAuctionResults(ctx, auction, 2, []int64{2, 3}))
+    require.NoError(t, notifier.NotifyTransactionStatus(ctx, 3, usd(40), false))
+
+    won := <-winner.Events()
+    assert.Equal(t, notification.AuctionWon, won.Type)
+    assert.Equal(t, int64(2), won.UserID)
+    assert.Equal(t, "You won 3 units in auction 5 for 300.00 USD", won.Message, "what the user's bids won, not the clearing price")
+    closed, failed := <-loser.Events(), <-loser.Events()
+    assert.Equal(t, notification.AuctionClosed, closed.Type)
+    assert.Equal(t, notification.TransactionFailed, failed.Type)
+
+    stored, total, err := inbox.GetByUserID(ctx, 3, false, 0, 10)
+    require.NoError(t, err)
+    assert.Equal(t, int64(2), total)
+    assert.Equal(t, []int64{failed.ID, closed.ID}, []int64{stored[0].ID, stored[1].ID}, "the inbox and the stream share IDs")
+    assert.Nil(t, stored[0].ReadAt)
+}
+
+// notificationFixture keeps the inboxes and notification preferences of
+// users 1 to 3, who have email addresses, and serves them through the
+// notification API. After route, router delivers their notifications.
+type notificationFixture struct {
+    userRepo    *memoryUserRepo
+    preferences *memoryNotificationPreferenceRepo
+    inbox       *memoryNotificationRepo
+    handler     *handler.NotificationHandler
+    router      notification.NotificationService
+    sink        *smtpSink
+    deliveries  *memoryWebhookDeliveryRepo
+}
+
+func newNotificationFixture() *notificationFixture {
+    f := &notificationFixture{
+        userRepo: newMemoryUserRepo(
+            emailUser(1, "alice", "alice@auction.test"),
+            emailUser(2, "bob", "bob@auction.test"),
+            emailUser(3, "carol", "carol@auction.test"),
+        ),
+        preferences: &memoryNotificationPreferenceRepo{},
+        inbox:       &memoryNotificationRepo{},
+    }
+    f.handler = handler.NewNotificationHandler(
+        notificationUC.NewListNotificationsUseCase(f.userRepo, f.inbox),
+        notificationUC.NewGetUnreadCountUseCase(f.userRepo, f.inbox),
+        notificationUC.NewMarkReadUseCase(f.userRepo, f.inbox),
+        notificationUC.NewMarkAllReadUseCase(f.userRepo, f.inbox),
+        notificationUC.NewGetPreferencesUseCase(f.userRepo, f.preferences),
+        notificationUC.NewUpdatePreferencesUseCase(f.userRepo, f.preferences),
+    )
+    return f
+}
+
+// fillInbox gives user 1 three notifications and user 2 one.
+func (f *notificationFixture) fillInbox(t *testing.T) {
+    for _, userID := range []int64{1, 2, 1, 1} {
+        require.NoError(t, f.inbox.Create(context.Background(), &notification.Notification{UserID: userID, Type: notification.NewBid, Message: "New bid"}))
+    }
+}
+
+func TestListNotificationsPagesNewestFirst(t *testing.T) {
+    f := newNotificationFixture()
+    f.fillInbox(t)
+    ctx := context.Background()
+
+    page, err := f.handler.ListNotifications(ctx, &pb.ListNotificationsRequest{UserId: 1, PageSize: 2, PageNumber: 1})
+    require.NoError(t, err)
+    require.Len(t, page.Notifications, 2)
+    assert.Equal(t, int64(4), page.Notifications[0].Id)
+    assert.Equal(t, int64(3), page.Notifications[1].Id)
+    assert.Equal(t, int64(3), page.TotalCount)
+    assert.Equal(t, int64(3), page.UnreadCount)
+
+    page, err = f.handler.ListNotifications(ctx, &pb.ListNotificationsRequest{UserId: 1, PageSize: 2, PageNumber: 2})
+    require.NoError(t, err)
+    require.Len(t, page.Notifications, 1)
+    assert.Equal(t, int64(1), page.Notifications[0].Id)
+
+    _, err = f.handler.ListNotifications(ctx, &pb.ListNotificationsRequest{UserId: 1, PageSize: 0, PageNumber: 1})
+    assert.Error(t, err)
+    _, err = f.handler.ListNotifications(ctx, &pb.ListNotificationsRequest{UserId: 7, PageSize: 10, PageNumber: 1})
+    assert.Error(t, err, "unknown user")
+}
+
+func TestMarkReadOnlyTouchesOwnUnreadNotifications(t *testing.T) {
+    f := newNotificationFixture()
+    f.fillInbox(t)
+    ctx := context.Background()
+
+    marked, err := f.handler.MarkRead(ctx, &pb.MarkReadRequest{UserId: 1, Ids: []int64{1, 2, 3}})
+    require.NoError(t, err)
+    assert.Equal(t, int64(2), marked.MarkedCount, "notification 2 belongs to user 2")
+    assert.Equal(t, int64(1), marked.UnreadCount)
+
+    again, err := f.handler.MarkRead(ctx, &pb.MarkReadRequest{UserId: 1, Ids: []int64{1}})
+    require.NoError(t, err)
+    assert.Zero(t, again.MarkedCount)
+
+    unread, err := f.handler.ListNotifications(ctx, &pb.ListNotificationsRequest{UserId: 1, PageSize: 10, PageNumber: 1, UnreadOnly: true})
+    require.NoError(t, err)
+    require.Len(t, unread.Notifications, 1)
+    assert.Equal(t, int64(4), unread.Notifications[0].Id)
+    assert.Nil(t, unread.Notifications[0].ReadAt)
+
+    all, err := f.handler.ListNotifications(ctx, &pb.ListNotificationsRequest{UserId: 1, PageSize: 10, PageNumber: 1})
+    require.NoError(t, err)
+    assert.NotNil(t, all.Notifications[1].ReadAt)
+
+    other, err := f.handler.GetUnreadCount(ctx, &pb.GetUnreadCountRequest{UserId: 2})
+    require.NoError(t, err)
+    assert.Equal(t, int64(1), other.UnreadCount)
+
+    _, err = f.handler.MarkRead(ctx, &pb.MarkReadRequest{UserId: 1})
+    assert.Error(t, err, "no IDs given")
+}
+
+func TestMarkAllRead(t *testing.T) {
+    f := newNotificationFixture()
+    f.fillInbox(t)
+    ctx := context.Background()
+
+    marked, err := f.handler.MarkAllRead(ctx, &pb.MarkAllReadRequest{UserId: 1})
+    require.NoError(t, err)
+    assert.Equal(t, int64(3), marked.MarkedCount)
+    assert.Zero(t, marked.UnreadCount)
+
+    unread, err := f.handler.GetUnreadCount(ctx, &pb.GetUnreadCountRequest{UserId: 1})
+    require.NoError(t, err)
+    assert.Zero(t, unread.UnreadCount)
+
+    other, err := f.handler.GetUnreadCount(ctx, &pb.GetUnreadCountRequest{UserId: 2})
+    require.NoError(t, err)
+    assert.Equal(t, int64(1), other.UnreadCount, "other users keep their unread notifications")
+
+    list, err := f.handler.ListNotifications(ctx, &pb.ListNotificationsRequest{UserId: 1, PageSize: 10, PageNumber: 1, UnreadOnly: true})
+    require.NoError(t, err)
+    assert.Empty(t, list.Notifications)
+    assert.Zero(t, list.TotalCount)
+}
+
+type memoryNotificationRepo struct {
+    mu            sync.Mutex
+    notifications []*notification.Notification
+}
+
+func (r *memoryNotificationRepo) Create(ctx context.Context, n *notification.Notification) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    n.ID = int64(len(r.notifications) + 1)
+    n.CreatedAt = time.Now()
+    copied := *n
+    r.notifications = append(r.notifications, &copied)
+    return nil
+}
+
+func (r *memoryNotificationRepo) GetByUserID(ctx context.Context, userID int64, unreadOnly bool, offset, limit int) ([]*notification.Notification, int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var matched []*notification.Notification
+    for i := len(r.notifications) - 1; i >= 0; i-- {
+        n := r.notifications[i]
+        if n.UserID == userID && (!unreadOnly || n.ReadAt == nil) {
+            copied := *n
+            matched = append(matched, &copied)
+        }
+    }
+    total := int64(len(matched))
+    if offset >= len(matched) {
+        return nil, total, nil
+    }
+    matched = matched[offset:]
+    if len(matched) > limit {
+        matched = matched[:limit]
+    }
+    return matched, total, nil
+}
+
+func (r *memoryNotificationRepo) CountUnread(ctx context.Context, userID int64) (int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var count int64
+    for _, n := range r.notifications {
+        if n.UserID == userID && n.ReadAt == nil {
+            count++
+        }
+    }
+    return count, nil
+}
+
+func (r *memoryNotificationRepo) MarkRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    selected := make(map[int64]bool)
+    for _, id := range ids {
+        selected[id] = true
+    }
+    return r.markRead(userID, func(n *notification.Notification) bool { return selected[n.ID] }), nil
+}
+
+func (r *memoryNotificationRepo) MarkAllRead(ctx context.Context, userID int64) (int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    return r.markRead(userID, func(n *notification.Notification) bool { return true }), nil
+}
+
+// markRead must be called with r.mu held.
+func (r *memoryNotificationRepo) markRead(userID int64, selected func(*notification.Notification) bool) int64 {
+    now := time.Now()
+    var marked int64
+    for _, n := range r.notifications {
+        if n.UserID == userID && n.ReadAt == nil && selected(n) {
+            n.ReadAt = &now
+            marked++
+        }
+    }
+    return marked
+}
@@ -0,0 +1,291 @@
+package tests
+
+import (
+    "context"
+    "encoding/json"
+    "sort"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/notification"
+    "auction-system/internal/application/eventbus"
+    notificationUC "auction-system/internal/application/usecase/notification"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/notification"
+    notificationInfra "auction-system/internal/infrastructure/notification"
+    pb "auction-system/pkg/api"
+)
+
+// route sends the notifications of the fixture's users, who have a
+// webhook each, to their inboxes, email and webhooks by their preferences.
+// Email to rejectEmails is refused.
+func (f *notificationFixture) route(t *testing.T, rejectEmails ...string) {
+    f.sink = newSMTPSink(t, rejectEmails...)
+    templates, err := notificationInfra.NewEmailTemplates("en")
+    require.NoError(t, err)
+    mailer, err := notificationInfra.NewSMTPMailer(f.sink.config())
+    require.NoError(t, err)
+
+    var webhooks *memoryWebhookRepo
+    webhooks, f.deliveries = newMemoryWebhookRepos()
+    for _, userID := range []int64{1, 2, 3} {
+        require.NoError(t, webhooks.Create(context.Background(), &entity.Webhook{UserID: userID, URL: "https://example.com/hooks", EventTypes: []entity.AuctionEventType{entity.AuctionEventEnded}}))
+    }
+
+    f.router = notificationInfra.NewRoutingNotificationAdapter(f.preferences, map[notification.Channel]notification.NotificationService{
+        notification.ChannelInApp:   notificationInfra.NewInboxNotificationAdapter(f.inbox, eventbus.NewNotificationBus(16, 0)),
+        notification.ChannelEmail:   notificationInfra.NewEmailNotificationAdapter(f.userRepo, templates, mailer),
+        notification.ChannelWebhook: notificationInfra.NewWebhookNotificationAdapter(f.deliveries),
+    })
+}
+
+func (f *notificationFixture) setChannels(t *testing.T, userID int64, kind notification.NotificationType, channels ...notification.Channel) {
+    p, err := f.preferences.GetByUserID(context.Background(), userID)
+    require.NoError(t, err)
+    p.Channels[kind] = channels
+    require.NoError(t, f.preferences.Save(context.Background(), p))
+}
+
+func (f *notificationFixture) inboxTypes(t *testing.T, userID int64) []notification.NotificationType {
+    stored, _, err := f.inbox.GetByUserID(context.Background(), userID, false, 0, 10)
+    require.NoError(t, err)
+    var types []notification.NotificationType
+    for _, n := range stored {
+        types = append(types, n.Type)
+    }
+    return types
+}
+
+func (f *notificationFixture) webhookPayloads(t *testing.T) []notification.Notification {
+    due, err := f.deliveries.GetDue(context.Background(), time.Now().Add(time.Minute), 10)
+    require.NoError(t, err)
+    payloads := make([]notification.Notification, len(due))
+    for i, d := range due {
+        assert.Equal(t, int64(0), d.EventID)
+        require.NoError(t, json.Unmarshal(d.Payload, &payloads[i]))
+        assert.Equal(t, string(payloads[i].Type), d.EventType)
+    }
+    return payloads
+}
+
+func (f *notificationFixture) emailed() []string {
+    var to []string
+    for _, email := range f.sink.emails() {
+        to = append(to, email.to...)
+    }
+    return to
+}
+
+func TestRoutingFollowsPreferences(t *testing.T) {
+    f := newNotificationFixture()
+    f.route(t)
+    f.setChannels(t, 2, notification.AuctionClosed, notification.ChannelWebhook)
+    f.setChannels(t, 3, notification.AuctionClosed)
+
+    auction := &entity.Auction{ID: 7, CurrentPrice: usd(250)}
+    require.NoError(t, f.router.NotifyAuctionResults(context.Background(), auction, 1, []int64{1, 2, 3}))
+
+    assert.Equal(t, []notification.NotificationType{notification.AuctionWon}, f.inboxTypes(t, 1), "the defaults include in-app")
+    assert.Empty(t, f.inboxTypes(t, 2))
+    assert.Empty(t, f.inboxTypes(t, 3), "the type is turned off")
+    assert.Equal(t, []string{"alice@auction.test"}, f.emailed())
+
+    payloads := f.webhookPayloads(t)
+    require.Len(t, payloads, 1, "event filters do not apply to notifications")
+    assert.Equal(t, int64(2), payloads[0].UserID)
+    assert.Equal(t, notification.AuctionClosed, payloads[0].Type)
+    assert.Equal(t, "Auction 7 has ended", payloads[0].Message)
+}
+
+func TestQuietHoursOnlyDeliverInApp(t *testing.T) {
+    f := newNotificationFixture()
+    f.route(t)
+    ctx := context.Background()
+    f.setChannels(t, 1, notification.Outbid, notification.ChannelInApp, notification.ChannelEmail, notification.ChannelWebhook)
+
+    // Quiet from an hour ago to an hour from now in the user's time zone.
+    p, err := f.preferences.GetByUserID(ctx, 1)
+    require.NoError(t, err)
+    p.TimeZone = "Asia/Tokyo"
+    now := time.Now().In(p.Location())
+    minute := now.Hour()*60 + now.Minute()
+    p.QuietHours = &notification.QuietHours{Start: (minute + 1380) % 1440, End: (minute + 60) % 1440}
+    require.NoError(t, f.preferences.Save(ctx, p))
+
+    auction := &entity.Auction{ID: 7}
+    require.NoError(t, f.router.NotifyOutbid(ctx, auction, 1, &entity.Bid{Amount: usd(120)}))
+    assert.Equal(t, []notification.NotificationType{notification.Outbid}, f.inboxTypes(t, 1))
+    assert.Empty(t, f.emailed())
+    assert.Empty(t, f.webhookPayloads(t))
+
+    p.QuietHours = &notification.QuietHours{Start: (minute + 60) % 1440, End: (minute + 120) % 1440}
+    require.NoError(t, f.preferences.Save(ctx, p))
+    require.NoError(t, f.router.NotifyOutbid(ctx, auction, 1, &entity.Bid{Amount: usd(130)}))
+    assert.Equal(t, []string{"alice@auction.test"}, f.emailed())
+    assert.Len(t, f.webhookPayloads(t), 1)
+}
+
+func TestQuietHoursWrapPastMidnight(t *testing.T) {
+    quiet := &notification.QuietHours{Start: 22 * 60, End: 7 * 60}
+    at := func(hour, minute int) time.Time {
+        return time.Date(2026, 3, 1, hour, minute, 0, 0, time.UTC)
+    }
+
+    assert.True(t, quiet.Contains(at(23, 30), time.UTC))
+    assert.True(t, quiet.Contains(at(6, 59), time.UTC))
+    assert.False(t, quiet.Contains(at(7, 0), time.UTC))
+    assert.False(t, quiet.Contains(at(21, 59), time.UTC))
+
+    moscow, err := time.LoadLocation("Europe/Moscow")
+    require.NoError(t, err)
+    assert.True(t, quiet.Contains(at(20, 0), moscow), "23:00 in Moscow")
+}
+
+func TestFailingChannelDoesNotStopOthers(t *testing.T) {
+    f := newNotificationFixture()
+    f.route(t, "alice@auction.test")
+    f.setChannels(t, 1, notification.TransactionFailed, notification.ChannelEmail, notification.ChannelWebhook, notification.ChannelInApp)
+
+    err := f.router.NotifyTransactionStatus(context.Background(), 1, usd(40), false)
+    require.Error(t, err)
+
+    assert.Equal(t, []notification.NotificationType{notification.TransactionFailed}, f.inboxTypes(t, 1))
+    assert.Len(t, f.webhookPayloads(t), 1)
+}
+
+func TestNotificationPreferences(t *testing.T) {
+    f := newNotificationFixture()
+    ctx := context.Background()
+
+    resp, err := f.handler.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{UserId: 1})
+    require.NoError(t, err)
+    defaults := resp.Preferences
+    require.Len(t, defaults.Channels, len(notification.Types))
+    for _, c := range defaults.Channels {
+        assert.Equal(t, []string{"IN_APP", "EMAIL"}, c.Channels, c.Type)
+    }
+    assert.Equal(t, "UTC", defaults.TimeZone)
+    assert.Equal(t, "NONE", defaults.Digest)
+    assert.Nil(t, defaults.QuietHours)
+
+    _, err = f.handler.UpdateNotificationPreferences(ctx, &pb.UpdateNotificationPreferencesRequest{
+        UserId: 1,
+        Channels: []*pb.NotificationChannels{
+            {Type: "OUTBID", Channels: []string{"NONE"}},
+            {Type: "NEW_BID", Channels: []string{"WEBHOOK", "IN_APP", "WEBHOOK"}},
+        },
+        QuietHours: &pb.QuietHours{Start: "22:30", End: "07:00"},
+        TimeZone:   "Europe/Moscow",
+        Digest:     "DAILY",
+    })
+    require.NoError(t, err)
+
+    resp, err = f.handler.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{UserId: 1})
+    require.NoError(t, err)
+    channels := make(map[string][]string)
+    for _, c := range resp.Preferences.Channels {
+        channels[c.Type] = c.Channels
+    }
+    assert.Empty(t, channels["OUTBID"])
+    assert.Equal(t, []string{"WEBHOOK", "IN_APP"}, channels["NEW_BID"])
+    assert.Equal(t, []string{"IN_APP", "EMAIL"}, channels["AUCTION_WON"])
+    assert.Equal(t, "22:30", resp.Preferences.QuietHours.Start)
+    assert.Equal(t, "07:00", resp.Preferences.QuietHours.End)
+    assert.Equal(t, "Europe/Moscow", resp.Preferences.TimeZone)
+    assert.Equal(t, "DAILY", resp.Preferences.Digest)
+    assert.NotNil(t, resp.Preferences.UpdatedAt)
+
+    other, err := f.handler.GetNotificationPreferences(ctx, &pb.GetNotificationPreferencesRequest{UserId: 2})
+    require.NoError(t, err)
+    assert.Equal(t, defaults.Channels, other.Preferences.Channels, "preferences are per user")
+}
+
+func TestUpdateNotificationPreferencesValidation(t *testing.T) {
+    f := newNotificationFixture()
+    ctx := context.Background()
+    channels := func(kind string, names ...string) []*pb.NotificationChannels {
+        return []*pb.NotificationChannels{{Type: kind, Channels: names}}
+    }
+
+    for name, req := range map[string]*pb.UpdateNotificationPreferencesRequest{
+        "unknown type":      {Channels: channels("BID_WITHDRAWN", "EMAIL")},
+        "unknown channel":   {Channels: channels("OUTBID", "SMS")},
+        "none and email":    {Channels: channels("OUTBID", "NONE", "EMAIL")},
+        "no channels":       {Channels: channels("OUTBID")},
+        "type listed twice": {Channels: append(channels("OUTBID", "EMAIL"), channels("OUTBID", "IN_APP")...)},
+        "bad time":          {QuietHours: &pb.QuietHours{Start: "25:00", End: "07:00"}},
+        "empty period":      {QuietHours: &pb.QuietHours{Start: "07:00", End: "07:00"}},
+        "unknown time zone": {TimeZone: "Mars/Olympus"},
+        "unknown digest":    {Digest: "HOURLY"},
+    } {
+        req.UserId = 1
+        _, err := f.handler.UpdateNotificationPreferences(ctx, req)
+        assert.Error(t, err, name)
+    }
+
+    _, err := f.handler.UpdateNotificationPreferences(ctx, &pb.UpdateNotificationPreferencesRequest{UserId: 42})
+    assert.Error(t, err, "unknown user")
+}
+
+func TestUpdateNotificationPreferencesReplacesThem(t *testing.T) {
+    preferences := &memoryNotificationPreferenceRepo{}
+    userRepo := newMemoryUserRepo(userWithBalance(1, usd(0)))
+    update := notificationUC.NewUpdatePreferencesUseCase(userRepo, preferences)
+    ctx := context.Background()
+
+    _, err := update.Execute(ctx, &dto.UpdatePreferencesRequest{UserID: 1, Channels: []dto.NotificationChannels{{Type: "OUTBID", Channels: []string{"NONE"}}}})
+    require.NoError(t, err)
+    _, err = update.Execute(ctx, &dto.UpdatePreferencesRequest{UserID: 1, Channels: []dto.NotificationChannels{{Type: "NEW_BID", Channels: []string{"EMAIL"}}}})
+    require.NoError(t, err)
+
+    p, err := preferences.GetByUserID(ctx, 1)
+    require.NoError(t, err)
+    assert.Equal(t, notification.DefaultChannels, p.ChannelsFor(notification.Outbid))
+    assert.Equal(t, []notification.Channel{notification.ChannelEmail}, p.ChannelsFor(notification.NewBid))
+}
+
+type memoryNotificationPreferenceRepo struct {
+    mu          sync.Mutex
+    preferences map[int64]*notification.Preferences
+}
+
+func (r *memoryNotificationPreferenceRepo) GetByUserID(ctx context.Context, userID int64) (*notification.Preferences, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    p, ok := r.preferences[userID]
+    if !ok {
+        return notification.DefaultPreferences(userID), nil
+    }
+    copied := *p
+    return &copied, nil
+}
+
+func (r *memoryNotificationPreferenceRepo) Save(ctx context.Context, preferences *notification.Preferences) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    if r.preferences == nil {
+        r.preferences = make(map[int64]*notification.Preferences)
+    }
+    preferences.UpdatedAt = time.Now()
+    copied := *preferences
+    r.preferences[preferences.UserID] = &copied
+    return nil
+}
+
+func (r *memoryNotificationPreferenceRepo) GetWithDigest(ctx context.Context) ([]*notification.Preferences, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*notification.Preferences
+    for _, p := range r.preferences {
+        if p.Digest != notification.DigestNone {
+            copied := *p
+            result = append(result, &copied)
+        }
+    }
+    sort.Slice(result, func(i, j int) bool { return result[i].UserID < result[j].UserID })
+    return result, nil
+}
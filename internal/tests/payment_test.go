@@ -0,0 +1,416 @@
+package tests
+
+import (
+    "context"
+    "fmt"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    "auction-system/internal/application/settlement"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/notification"
+)
+
+// fakeGateway declines as many charges of a user as declines holds for
+// them and accepts the rest. Like a real provider it answers a repeated key
+// with its first answer and does not carry the operation out again.
+type fakeGateway struct {
+    mu            sync.Mutex
+    declines      map[int64]int
+    refundFailure error
+    answers       map[string]error
+    charged       []int64
+    refunded      []int64
+}
+
+func (g *fakeGateway) ProcessPayment(ctx context.Context, key string, userID int64, amount entity.Money) error {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    if err, ok := g.answers[key]; ok {
+        return err
+    }
+    var err error
+    if g.declines[userID] > 0 {
+        g.declines[userID]--
+        err = fmt.Errorf("card declined")
+    } else {
+        g.charged = append(g.charged, userID)
+    }
+    g.answer(key, err)
+    return err
+}
+
+func (g *fakeGateway) RefundPayment(ctx context.Context, key string, userID int64, amount entity.Money) error {
+    g.mu.Lock()
+    defer g.mu.Unlock()
+    if err, ok := g.answers[key]; ok {
+        return err
+    }
+    // A refund that could not be carried out may be asked for again.
+    if g.refundFailure != nil {
+        return g.refundFailure
+    }
+    g.refunded = append(g.refunded, userID)
+    g.answer(key, nil)
+    return nil
+}
+
+func (g *fakeGateway) answer(key string, err error) {
+    if g.answers == nil {
+        g.answers = map[string]error{}
+    }
+    g.answers[key] = err
+}
+
+type transactionStatus struct {
+    userID  int64
+    amount  entity.Money
+    success bool
+}
+
+// bidNotification is an outbid or new-bid notification.
+type bidNotification struct {
+    userID int64
+    bidID  int64
+}
+
+// auctionResult is one NotifyAuctionResults call.
+type auctionResult struct {
+    winner       int64
+    participants []int64
+}
+
+type recordingNotifier struct {
+    mu       sync.Mutex
+    statuses []transactionStatus
+    outbid   []bidNotification
+    newBids  []bidNotification
+    digests  []*notification.DigestReport
+    results  []auctionResult
+}
+
+func (n *recordingNotifier) NotifyAuctionStarted(ctx context.Context, auction *entity.Auction, participants []int64) error {
+    return nil
+}
+
+func (n *recordingNotifier) NotifyAuctionResults(ctx context.Context, auction *entity.Auction, winner int64, participants []int64) error {
+    n.mu.Lock()
+    defer n.mu.Unlock()
+    n.results = append(n.results, auctionResult{winner: winner, participants: participants})
+    return nil
+}
+
+func (n *recordingNotifier) NotifyTransactionStatus(ctx context.Context, userID int64, amount entity.Money, success bool) error {
+    n.mu.Lock()
+    defer n.mu.Unlock()
+    n.statuses = append(n.statuses, transactionStatus{userID: userID, amount: amount, success: success})
+    return nil
+}
+
+func (n *recordingNotifier) NotifyOutbid(ctx context.Context, auction *entity.Auction, userID int64, bid *entity.Bid) error {
+    n.mu.Lock()
+    defer n.mu.Unlock()
+    n.outbid = append(n.outbid, bidNotification{userID: userID, bidID: bid.ID})
+    return nil
+}
+
+func (n *recordingNotifier) NotifyNewBid(ctx context.Context, auction *entity.Auction, sellerID int64, bid *entity.Bid) error {
+    n.mu.Lock()
+    defer n.mu.Unlock()
+    n.newBids = append(n.newBids, bidNotification{userID: sellerID, bidID: bid.ID})
+    return nil
+}
+
+func (n *recordingNotifier) NotifyDigest(ctx context.Context, digest *notification.DigestReport) error {
+    n.mu.Lock()
+    defer n.mu.Unlock()
+    n.digests = append(n.digests, digest)
+    return nil
+}
+
+var testRetryPolicy = settlement.RetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: 90 * time.Second}
+
+// newPaymentProcessor collects payments into the wallets in userRepo.
+func newPaymentProcessor(paymentRepo *memoryPaymentRepo, auctionRepo *memoryAuctionRepo, lotRepo *memoryLotRepo, holdRepo *memoryHoldRepo, userRepo *memoryUserRepo, gateway *fakeGateway, notifier *recordingNotifier) *settlement.PaymentProcessor {
+    return settlement.NewPaymentProcessor(paymentRepo, auctionRepo, lotRepo, holdRepo, userRepo, newMemoryLedger(userRepo), gateway, notifier, &memoryTxManager{}, testRetryPolicy)
+}
+
+type paymentFixture struct {
+    *market
+    auctionRepo *memoryAuctionRepo
+    gateway     *fakeGateway
+    notifier    *recordingNotifier
+    processor   *settlement.PaymentProcessor
+}
+
+// newPaymentFixture settles a two-unit auction won by users 2 and 3, each
+// paying 300 for one unit to seller 1. User 2's card is declined declines
+// times.
+func newPaymentFixture(t *testing.T, declines int) *paymentFixture {
+    f := &paymentFixture{
+        market: newMarket(
+            userWithBalance(1, usd(0)),
+            userWithBalance(2, usd(1000)),
+            userWithBalance(3, usd(1000)),
+        ),
+        auctionRepo: newMemoryAuctionRepo(&entity.Auction{ID: 1, LotID: 1, Quantity: 2, Status: entity.AuctionStatusActive}),
+        gateway:     &fakeGateway{declines: map[int64]int{2: declines}},
+        notifier:    &recordingNotifier{},
+    }
+    lotRepo := newMemoryLotRepo(&entity.Lot{ID: 1, CreatorID: 1})
+    f.processor = f.paymentProcessor(f.auctionRepo, lotRepo, f.gateway, f.notifier)
+
+    f.settle(t, f.settlement(lotRepo, entity.FeeSchedule{}, newInvoiceIssuer(f.userRepo)), f.auctionRepo,
+        &entity.AuctionWinner{AuctionID: 1, UserID: 2, BidID: 1, Quantity: 1, UnitPrice: usd(300)},
+        &entity.AuctionWinner{AuctionID: 1, UserID: 3, BidID: 2, Quantity: 1, UnitPrice: usd(300)},
+    )
+    return f
+}
+
+func (f *paymentFixture) status(t *testing.T) entity.AuctionStatus {
+    auction, err := f.auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    return auction.Status
+}
+
+func (f *paymentFixture) payments(t *testing.T) []*entity.Payment {
+    payments, err := f.paymentRepo.GetByAuctionID(context.Background(), 1)
+    require.NoError(t, err)
+    require.Len(t, payments, 2)
+    return payments
+}
+
+func TestSettleOpensPendingPayments(t *testing.T) {
+    f := newPaymentFixture(t, 0)
+
+    assert.Equal(t, entity.AuctionStatusEnded, f.status(t))
+    for _, p := range f.payments(t) {
+        assert.Equal(t, entity.PaymentStatusPending, p.Status)
+        assert.Equal(t, usd(300), p.Amount)
+        assert.Equal(t, int64(1), p.SellerID)
+    }
+
+    assert.Equal(t, usd(300), f.held(t, 2), "the winner's funds stay held until the payment is captured")
+}
+
+func TestCapturedPaymentsCompleteAuction(t *testing.T) {
+    f := newPaymentFixture(t, 0)
+
+    require.NoError(t, f.processor.ProcessDue(context.Background(), time.Now()))
+
+    assert.Equal(t, entity.AuctionStatusCompleted, f.status(t))
+    for _, p := range f.payments(t) {
+        assert.Equal(t, entity.PaymentStatusCaptured, p.Status)
+        assert.Equal(t, 1, p.Attempts)
+    }
+    assert.Equal(t, usd(600), f.balance(t, 1))
+    assert.Equal(t, usd(700), f.balance(t, 2))
+    assert.Equal(t, usd(700), f.balance(t, 3))
+    assert.Empty(t, f.holdRepo.holds)
+    assert.ElementsMatch(t, []transactionStatus{
+        {userID: 2, amount: usd(300), success: true},
+        {userID: 3, amount: usd(300), success: true},
+    }, f.notifier.statuses)
+}
+
+func TestFailedPaymentIsRetriedWithBackoff(t *testing.T) {
+    f := newPaymentFixture(t, 1)
+    ctx := context.Background()
+    now := time.Now()
+
+    require.NoError(t, f.processor.ProcessDue(ctx, now))
+    payments := f.payments(t)
+    assert.Equal(t, entity.PaymentStatusPending, payments[0].Status)
+    assert.Equal(t, "card declined", payments[0].LastError)
+    assert.Equal(t, now.Add(time.Minute), payments[0].NextAttemptAt)
+    assert.Equal(t, entity.PaymentStatusCaptured, payments[1].Status)
+    assert.Equal(t, entity.AuctionStatusEnded, f.status(t), "one payment is still outstanding")
+
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(30*time.Second)))
+    assert.Equal(t, 1, f.payments(t)[0].Attempts, "not due before the backoff elapses")
+
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(time.Minute)))
+    payments = f.payments(t)
+    assert.Equal(t, entity.PaymentStatusCaptured, payments[0].Status)
+    assert.Equal(t, 2, payments[0].Attempts)
+    assert.Equal(t, entity.AuctionStatusCompleted, f.status(t))
+}
+
+func TestExhaustedPaymentCallsOffSale(t *testing.T) {
+    f := newPaymentFixture(t, testRetryPolicy.MaxAttempts)
+    ctx := context.Background()
+    now := time.Now()
+
+    // The first payment fails on every attempt while the second is captured
+    // on the first round.
+    require.NoError(t, f.processor.ProcessDue(ctx, now))
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(time.Minute)))
+    assert.Equal(t, now.Add(time.Minute+90*time.Second), f.payments(t)[0].NextAttemptAt, "backoff is capped")
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(time.Hour)))
+
+    payments := f.payments(t)
+    assert.Equal(t, entity.PaymentStatusFailed, payments[0].Status)
+    assert.Equal(t, 3, payments[0].Attempts)
+    assert.Equal(t, entity.PaymentStatusRefunded, payments[1].Status)
+    assert.Equal(t, entity.AuctionStatusPaymentFailed, f.status(t))
+
+    assert.Equal(t, []int64{3}, f.gateway.refunded)
+    assert.Equal(t, usd(0), f.balance(t, 1))
+    assert.Equal(t, usd(1000), f.balance(t, 2))
+    assert.Equal(t, usd(1000), f.balance(t, 3))
+    assert.Empty(t, f.holdRepo.holds)
+    assert.Equal(t, []transactionStatus{
+        {userID: 3, amount: usd(300), success: true},
+        {userID: 2, amount: usd(300), success: false},
+        {userID: 3, amount: usd(300), success: false},
+    }, f.notifier.statuses)
+}
+
+func TestInterruptedChargeIsResumedUnderSameKey(t *testing.T) {
+    f := newPaymentFixture(t, 0)
+    ctx := context.Background()
+    now := time.Now()
+
+    // The provider took the money but the worker stopped before recording
+    // the answer.
+    payment := f.payments(t)[0]
+    payment.Attempts = 1
+    payment.Status = entity.PaymentStatusCharging
+    payment.NextAttemptAt = now.Add(testRetryPolicy.Backoff)
+    require.NoError(t, f.paymentRepo.Update(ctx, payment))
+    require.NoError(t, f.gateway.ProcessPayment(ctx, payment.ChargeKey(), payment.UserID, payment.Amount))
+
+    require.NoError(t, f.processor.ProcessDue(ctx, now))
+    assert.Equal(t, entity.PaymentStatusCharging, f.payments(t)[0].Status, "leased until the lease runs out")
+
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(testRetryPolicy.Backoff)))
+    payments := f.payments(t)
+    assert.Equal(t, entity.PaymentStatusCaptured, payments[0].Status)
+    assert.Equal(t, 1, payments[0].Attempts)
+    assert.Equal(t, []int64{2, 3}, f.gateway.charged, "the resumed charge is not carried out again")
+    assert.Equal(t, usd(700), f.balance(t, 2))
+    assert.Equal(t, entity.AuctionStatusCompleted, f.status(t))
+}
+
+func TestRejectedRefundIsRetriedUnderSameKey(t *testing.T) {
+    f := newPaymentFixture(t, testRetryPolicy.MaxAttempts)
+    f.gateway.refundFailure = fmt.Errorf("provider unavailable")
+    ctx := context.Background()
+    now := time.Now()
+
+    require.NoError(t, f.processor.ProcessDue(ctx, now))
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(time.Minute)))
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(time.Hour)))
+
+    payments := f.payments(t)
+    assert.Equal(t, entity.PaymentStatusRefunding, payments[1].Status)
+    assert.Equal(t, usd(1000), f.balance(t, 3), "the postings are reversed with the refund decision")
+    assert.Equal(t, usd(0), f.balance(t, 1))
+
+    f.gateway.refundFailure = nil
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(2*time.Hour)))
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(3*time.Hour)))
+
+    assert.Equal(t, entity.PaymentStatusRefunded, f.payments(t)[1].Status)
+    assert.Equal(t, []int64{3}, f.gateway.refunded)
+    assert.Equal(t, usd(1000), f.balance(t, 3))
+    assert.Equal(t, usd(0), f.balance(t, 1))
+}
+
+func TestRefundWaitsForSellerToCoverProceeds(t *testing.T) {
+    f := newPaymentFixture(t, testRetryPolicy.MaxAttempts)
+    ctx := context.Background()
+    now := time.Now()
+
+    require.NoError(t, f.processor.ProcessDue(ctx, now))
+    // The seller spends the proceeds of the captured payment.
+    require.NoError(t, f.userRepo.Adjust(ctx, 1, usd(-200)))
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(time.Minute)))
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(time.Hour)))
+    require.NoError(t, f.processor.ProcessDue(ctx, now.Add(2*time.Hour)))
+
+    payments := f.payments(t)
+    assert.Equal(t, entity.PaymentStatusFailed, payments[0].Status)
+    assert.Equal(t, entity.PaymentStatusCaptured, payments[1].Status)
+    assert.Contains(t, payments[1].LastError, "refund failed")
+    assert.Empty(t, f.gateway.refunded, "nothing is refunded that the ledger cannot reverse")
+    assert.Equal(t, usd(100), f.balance(t, 1))
+    assert.Equal(t, usd(700), f.balance(t, 3))
+    assert.Equal(t, entity.AuctionStatusPaymentFailed, f.status(t))
+}
+
+type memoryPaymentRepo struct {
+    mu       sync.Mutex
+    locks    memoryRowLocks
+    payments []*entity.Payment
+}
+
+func (r *memoryPaymentRepo) Create(ctx context.Context, payment *entity.Payment) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    payment.ID = int64(len(r.payments) + 1)
+    payment.CreatedAt = time.Now()
+    payment.UpdatedAt = payment.CreatedAt
+    copied := *payment
+    r.payments = append(r.payments, &copied)
+    return nil
+}
+
+func (r *memoryPaymentRepo) GetByIDForUpdate(ctx context.Context, id int64) (*entity.Payment, error) {
+    r.locks.lock(ctx, id)
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, p := range r.payments {
+        if p.ID == id {
+            copied := *p
+            return &copied, nil
+        }
+    }
+    return nil, errors.NewNotFoundError("payment not found")
+}
+
+func (r *memoryPaymentRepo) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.Payment, error) {
+    return r.list(func(p *entity.Payment) bool { return p.AuctionID == auctionID }, 0), nil
+}
+
+func (r *memoryPaymentRepo) GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.Payment, error) {
+    return r.list(func(p *entity.Payment) bool {
+        due := p.Status == entity.PaymentStatusPending || p.Status == entity.PaymentStatusCharging || p.Status == entity.PaymentStatusRefunding
+        return due && !p.NextAttemptAt.After(now)
+    }, limit), nil
+}
+
+func (r *memoryPaymentRepo) list(match func(p *entity.Payment) bool, limit int) []*entity.Payment {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.Payment
+    for _, p := range r.payments {
+        if match(p) {
+            copied := *p
+            result = append(result, &copied)
+            if len(result) == limit {
+                break
+            }
+        }
+    }
+    return result
+}
+
+func (r *memoryPaymentRepo) Update(ctx context.Context, payment *entity.Payment) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for i, p := range r.payments {
+        if p.ID == payment.ID {
+            payment.UpdatedAt = time.Now()
+            copied := *payment
+            r.payments[i] = &copied
+            return nil
+        }
+    }
+    return errors.NewNotFoundError("payment not found")
+}
@@ -0,0 +1,161 @@
+package tests
+
+import (
+    "context"
+    "database/sql"
+    "math/rand"
+    "os"
+    "sync"
+    "testing"
+    "time"
+
+    _ "github.com/lib/pq"
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/infrastructure/persistence/postgres"
+)
+
+const concurrentBids = 300
+
+// placeConcurrentBids fires concurrentBids bids in random order and returns
+// the amounts that were accepted.
+func placeConcurrentBids(t *testing.T, uc *bidUC.PlaceBidUseCase, auctionID, userID int64, startPrice, minStep entity.Money) []entity.Money {
+    amounts := make([]entity.Money, concurrentBids)
+    for i := range amounts {
+        amounts[i] = startPrice.Add(minStep.Mul(int64(i + 1)))
+    }
+    rand.Shuffle(len(amounts), func(i, j int) { amounts[i], amounts[j] = amounts[j], amounts[i] })
+
+    var (
+        mu       sync.Mutex
+        accepted []entity.Money
+        wg       sync.WaitGroup
+    )
+    start := make(chan struct{})
+    for _, amount := range amounts {
+        wg.Add(1)
+        go func(amount entity.Money) {
+            defer wg.Done()
+            <-start
+            _, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{
+                AuctionID: auctionID,
+                UserID:    userID,
+                Amount:    amount,
+            })
+            if err == nil {
+                mu.Lock()
+                accepted = append(accepted, amount)
+                mu.Unlock()
+            }
+        }(amount)
+    }
+    close(start)
+    wg.Wait()
+
+    require.NotEmpty(t, accepted)
+    return accepted
+}
+
+func maxAmount(amounts []entity.Money) entity.Money {
+    max := amounts[0]
+    for _, a := range amounts[1:] {
+        if a.GreaterThan(max) {
+            max = a
+        }
+    }
+    return max
+}
+
+// TestPlaceBidConcurrent relies on the memory repositories locking only the
+// rows read with ForUpdate, so it fails if the use case reads the auction
+// without locking it.
+func TestPlaceBidConcurrent(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(&entity.Auction{
+        ID:           1,
+        LotID:        1,
+        StartPrice:   usd(100),
+        MinStep:      usd(1),
+        CurrentPrice: usd(100),
+        Status:       entity.AuctionStatusActive,
+        EndTime:      time.Now().Add(time.Hour),
+    })
+    bidRepo := &memoryBidRepo{}
+    userRepo := newMemoryUserRepo(userWithBalance(1, usd(1_000_000_000)))
+
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+    accepted := placeConcurrentBids(t, uc, 1, 1, usd(100), usd(1))
+
+    auction, err := auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, maxAmount(accepted), auction.CurrentPrice)
+
+    bids, err := bidRepo.GetByAuctionID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Len(t, bids, len(accepted))
+    for i := 1; i < len(bids); i++ {
+        assert.Greater(t, bids[i-1].ID, bids[i].ID, "accepted bids must be strictly increasing")
+    }
+}
+
+// TestPlaceBidConcurrentPostgres runs the same scenario against a migrated
+// database. It is skipped unless TEST_DATABASE_DSN is set.
+func TestPlaceBidConcurrentPostgres(t *testing.T) {
+    dsn := os.Getenv("TEST_DATABASE_DSN")
+    if dsn == "" {
+        t.Skip("TEST_DATABASE_DSN is not set")
+    }
+
+    db, err := sql.Open("postgres", dsn)
+    require.NoError(t, err)
+    defer db.Close()
+    db.SetMaxOpenConns(20)
+
+    ctx := context.Background()
+    userRepo := postgres.NewUserRepository(db)
+    lotRepo := postgres.NewLotRepository(db)
+    auctionRepo := postgres.NewAuctionRepository(db)
+    bidRepo := postgres.NewBidRepository(db)
+    walletRepo := postgres.NewWalletRepository(db)
+
+    user := &entity.User{
+        Username: "concurrency_test",
+        Email:    "concurrency_test_" + time.Now().Format("150405.000000") + "@example.com",
+    }
+    require.NoError(t, userRepo.Create(ctx, user))
+    defer userRepo.Delete(ctx, user.ID)
+    require.NoError(t, walletRepo.Adjust(ctx, user.ID, usd(1000000)))
+
+    lot := &entity.Lot{Title: "Concurrency test lot", StartPrice: usd(100), Currency: "USD", CreatorID: user.ID}
+    require.NoError(t, lotRepo.Create(ctx, lot))
+    defer lotRepo.Delete(ctx, lot.ID)
+
+    auction := &entity.Auction{
+        LotID:        lot.ID,
+        StartPrice:   usd(100),
+        MinStep:      usd(1),
+        CurrentPrice: usd(100),
+        Currency:     "USD",
+        StartTime:    time.Now().Add(-time.Minute),
+        EndTime:      time.Now().Add(time.Hour),
+        Status:       entity.AuctionStatusActive,
+    }
+    require.NoError(t, auctionRepo.Create(ctx, auction))
+
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, postgres.NewMaxBidRepository(db), postgres.NewLotRepository(db), postgres.NewTxManager(db), escrow.NewService(postgres.NewFundHoldRepository(db), bidRepo, userRepo, walletRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+    accepted := placeConcurrentBids(t, uc, auction.ID, user.ID, usd(100), usd(1))
+
+    stored, err := auctionRepo.GetByID(ctx, auction.ID)
+    require.NoError(t, err)
+    assert.Equal(t, maxAmount(accepted), stored.CurrentPrice)
+
+    bids, err := bidRepo.GetByAuctionID(ctx, auction.ID)
+    require.NoError(t, err)
+    assert.Len(t, bids, len(accepted))
+    assert.Equal(t, stored.CurrentPrice, bids[0].Amount)
+}
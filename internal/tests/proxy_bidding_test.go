@@ -0,0 +1,138 @@
+package tests
+
+import (
+    "context"
+    "sort"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/domain/entity"
+)
+
+func TestProxyBidOutbidsManualBid(t *testing.T) {
+    f := newBidding(newBidders(), englishAuction())
+    ctx := context.Background()
+
+    resp, err := f.setMaxBid.Execute(ctx, &dto.SetMaxBidRequest{AuctionID: 1, UserID: 1, MaxAmount: usd(500)})
+    require.NoError(t, err)
+    assert.True(t, resp.IsLeading)
+    assert.Equal(t, usd(110), resp.CurrentPrice)
+
+    _, err = f.placeBid.Execute(ctx, &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(200)})
+    require.NoError(t, err)
+
+    leading := f.leader(t)
+    assert.Equal(t, int64(1), leading.UserID)
+    assert.Equal(t, usd(210), leading.Amount)
+    assert.True(t, leading.IsAuto)
+
+    auction, err := f.auctionRepo.GetByID(ctx, 1)
+    require.NoError(t, err)
+    assert.Equal(t, usd(210), auction.CurrentPrice)
+}
+
+func TestProxyBidsCompete(t *testing.T) {
+    f := newBidding(newBidders(), englishAuction())
+    ctx := context.Background()
+
+    _, err := f.setMaxBid.Execute(ctx, &dto.SetMaxBidRequest{AuctionID: 1, UserID: 1, MaxAmount: usd(500)})
+    require.NoError(t, err)
+
+    resp, err := f.setMaxBid.Execute(ctx, &dto.SetMaxBidRequest{AuctionID: 1, UserID: 2, MaxAmount: usd(300)})
+    require.NoError(t, err)
+    assert.False(t, resp.IsLeading)
+
+    leading := f.leader(t)
+    assert.Equal(t, int64(1), leading.UserID)
+    assert.Equal(t, usd(310), leading.Amount)
+
+    resp, err = f.setMaxBid.Execute(ctx, &dto.SetMaxBidRequest{AuctionID: 1, UserID: 3, MaxAmount: usd(800)})
+    require.NoError(t, err)
+    assert.True(t, resp.IsLeading)
+    assert.Equal(t, usd(510), resp.CurrentPrice)
+}
+
+func TestProxyBidTieGoesToEarliest(t *testing.T) {
+    f := newBidding(newBidders(), englishAuction())
+    ctx := context.Background()
+
+    _, err := f.setMaxBid.Execute(ctx, &dto.SetMaxBidRequest{AuctionID: 1, UserID: 1, MaxAmount: usd(300)})
+    require.NoError(t, err)
+    _, err = f.setMaxBid.Execute(ctx, &dto.SetMaxBidRequest{AuctionID: 1, UserID: 2, MaxAmount: usd(300)})
+    require.NoError(t, err)
+
+    leading := f.leader(t)
+    assert.Equal(t, int64(1), leading.UserID)
+    assert.Equal(t, usd(300), leading.Amount)
+}
+
+func TestSetMaxBidBelowMinimumStep(t *testing.T) {
+    f := newBidding(newBidders(), englishAuction())
+
+    _, err := f.setMaxBid.Execute(context.Background(), &dto.SetMaxBidRequest{AuctionID: 1, UserID: 1, MaxAmount: usd(105)})
+    assert.Error(t, err)
+}
+
+type memoryMaxBidRepo struct {
+    mu      sync.Mutex
+    maxBids []*entity.MaxBid
+}
+
+func (r *memoryMaxBidRepo) Upsert(ctx context.Context, maxBid *entity.MaxBid) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    maxBid.UpdatedAt = time.Now()
+    for _, m := range r.maxBids {
+        if m.AuctionID == maxBid.AuctionID && m.UserID == maxBid.UserID {
+            m.MaxAmount = maxBid.MaxAmount
+            m.UpdatedAt = maxBid.UpdatedAt
+            maxBid.ID = m.ID
+            maxBid.CreatedAt = m.CreatedAt
+            return nil
+        }
+    }
+    maxBid.ID = int64(len(r.maxBids) + 1)
+    maxBid.CreatedAt = maxBid.UpdatedAt
+    copied := *maxBid
+    r.maxBids = append(r.maxBids, &copied)
+    return nil
+}
+
+func (r *memoryMaxBidRepo) GetByAuctionAndUser(ctx context.Context, auctionID, userID int64) (*entity.MaxBid, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, m := range r.maxBids {
+        if m.AuctionID == auctionID && m.UserID == userID {
+            copied := *m
+            return &copied, nil
+        }
+    }
+    return nil, nil
+}
+
+func (r *memoryMaxBidRepo) GetByAuctionID(ctx context.Context, auctionID int64) ([]*entity.MaxBid, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.MaxBid
+    for _, m := range r.maxBids {
+        if m.AuctionID == auctionID {
+            copied := *m
+            result = append(result, &copied)
+        }
+    }
+    sort.Slice(result, func(i, j int) bool {
+        if c := result[i].MaxAmount.Cmp(result[j].MaxAmount); c != 0 {
+            return c > 0
+        }
+        if !result[i].UpdatedAt.Equal(result[j].UpdatedAt) {
+            return result[i].UpdatedAt.Before(result[j].UpdatedAt)
+        }
+        return result[i].ID < result[j].ID
+    })
+    return result, nil
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+)
+
+func sealedAuction(auctionType entity.AuctionType) *entity.Auction {
+    return &entity.Auction{
+        ID:           1,
+        LotID:        1,
+        Type:         auctionType,
+        StartPrice:   usd(100),
+        MinStep:      usd(10),
+        CurrentPrice: usd(100),
+        Status:       entity.AuctionStatusActive,
+        EndTime:      time.Now().Add(time.Hour),
+    }
+}
+
+func TestClearingPrice(t *testing.T) {
+    bids := func(amounts ...int64) []*entity.Bid {
+        result := make([]*entity.Bid, len(amounts))
+        for i, amount := range amounts {
+            result[i] = &entity.Bid{Amount: usd(amount)}
+        }
+        return result
+    }
+
+    first := sealedAuction(entity.AuctionTypeSealedFirstPrice)
+    assert.Equal(t, usd(500), first.ClearingPrice(bids(500, 300)))
+
+    second := sealedAuction(entity.AuctionTypeSealedSecondPrice)
+    assert.Equal(t, usd(310), second.ClearingPrice(bids(500, 300)))
+    assert.Equal(t, usd(500), second.ClearingPrice(bids(500, 495)), "never above the winning bid")
+    assert.Equal(t, usd(100), second.ClearingPrice(bids(500)), "single bidder pays the start price")
+
+    second.ReservePrice = usd(400)
+    assert.Equal(t, usd(400), second.ClearingPrice(bids(500, 300)), "reserve acts as a floor")
+}
+
+func TestSealedBidReplacesOwnBid(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(sealedAuction(entity.AuctionTypeSealedFirstPrice))
+    bidRepo := &memoryBidRepo{}
+    userRepo := newMemoryUserRepo(userWithBalance(2, usd(5000)))
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+
+    first, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(300)})
+    require.NoError(t, err)
+    second, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(250)})
+    require.NoError(t, err)
+    assert.Equal(t, first.ID, second.ID)
+    assert.Equal(t, usd(250), second.Amount, "a sealed bid may also be lowered")
+
+    bids, err := bidRepo.GetByAuctionID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Len(t, bids, 1)
+
+    auction, err := auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, usd(100), auction.CurrentPrice, "current price must not reveal bids")
+
+    _, err = uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 2, Amount: usd(50)})
+    assert.Error(t, err, "bid below start price")
+}
+
+func TestSealedBidsHiddenUntilClose(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(sealedAuction(entity.AuctionTypeSealedFirstPrice))
+    bidRepo := &memoryBidRepo{}
+    _ = bidRepo.Create(context.Background(), &entity.Bid{AuctionID: 1, UserID: 2, Amount: usd(300)})
+    list := bidUC.NewListBidsUseCase(bidRepo, auctionRepo)
+    get := bidUC.NewGetBidUseCase(bidRepo, auctionRepo)
+    req := &dto.ListBidsRequest{AuctionID: 1, PageSize: 10, PageNumber: 1}
+
+    listed, err := list.Execute(context.Background(), req)
+    require.NoError(t, err)
+    assert.Empty(t, listed.Bids)
+    assert.Equal(t, int64(1), listed.TotalCount)
+
+    got, err := get.Execute(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Zero(t, got.Amount)
+
+    require.NoError(t, auctionRepo.UpdateStatus(context.Background(), 1, entity.AuctionStatusEnded))
+
+    listed, err = list.Execute(context.Background(), req)
+    require.NoError(t, err)
+    require.Len(t, listed.Bids, 1)
+    assert.Equal(t, usd(300), listed.Bids[0].Amount)
+
+    got, err = get.Execute(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, usd(300), got.Amount)
+}
+
+func TestCloseSecondPriceAuction(t *testing.T) {
+    auction := endedAuction(entity.Money{})
+    auction.Type = entity.AuctionTypeSealedSecondPrice
+    auction.CurrentPrice = auction.StartPrice
+    f := newCloserFixture(auction,
+        &entity.Bid{AuctionID: 1, UserID: 3, Amount: usd(300)},
+        &entity.Bid{AuctionID: 1, UserID: 2, Amount: usd(500)},
+    )
+
+    closed := f.run(t, 1)
+
+    assert.Equal(t, entity.AuctionStatusEnded, closed.Status)
+    require.NotNil(t, closed.WinnerID)
+    assert.Equal(t, int64(2), *closed.WinnerID)
+    assert.Equal(t, usd(310), closed.CurrentPrice)
+    f.collect(t)
+    assert.Equal(t, usd(4690), f.balance(t, 2))
+    assert.Equal(t, usd(1310), f.balance(t, 1))
+}
+
+func TestTiedBidsRankByWhoReachedTheAmountFirst(t *testing.T) {
+    ctx := context.Background()
+    now := time.Now()
+    // The first bidder raised their bid to the amount the second one had
+    // already offered.
+    bidRepo := &memoryBidRepo{bids: []*entity.Bid{
+        {ID: 1, AuctionID: 1, UserID: 2, Amount: usd(120), Quantity: 1, UpdatedAt: now},
+        {ID: 2, AuctionID: 1, UserID: 3, Amount: usd(120), Quantity: 1, UpdatedAt: now.Add(-time.Minute)},
+    }}
+
+    highest, err := bidRepo.GetHighestByAuctionID(ctx, 1)
+    require.NoError(t, err)
+    assert.Equal(t, int64(3), highest.UserID)
+
+    ranked, err := bidRepo.GetByAuctionID(ctx, 1)
+    require.NoError(t, err)
+    require.Len(t, ranked, 2)
+    assert.Equal(t, highest.ID, ranked[0].ID, "the highest bid heads the ranking")
+}
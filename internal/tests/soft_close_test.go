@@ -0,0 +1,128 @@
+package tests
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+    "google.golang.org/protobuf/types/known/timestamppb"
+
+    auctionDto "auction-system/internal/application/dto/auction"
+    dto "auction-system/internal/application/dto/bid"
+    "auction-system/internal/application/escrow"
+    "auction-system/internal/application/eventbus"
+    bidUC "auction-system/internal/application/usecase/bid"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+func TestExtendForBidAt(t *testing.T) {
+    end := time.Date(2024, 3, 25, 10, 0, 0, 0, time.UTC)
+    newAuction := func() *entity.Auction {
+        return &entity.Auction{
+            EndTime:           end,
+            ExtensionWindow:   5 * time.Minute,
+            ExtensionDuration: 3 * time.Minute,
+            MaxExtension:      7 * time.Minute,
+        }
+    }
+
+    a := newAuction()
+    assert.False(t, a.ExtendForBidAt(end.Add(-10*time.Minute)), "bid outside the window")
+    assert.Equal(t, end, a.EndTime)
+
+    assert.True(t, a.ExtendForBidAt(end.Add(-time.Minute)))
+    assert.Equal(t, end.Add(3*time.Minute), a.EndTime)
+
+    assert.True(t, a.ExtendForBidAt(a.EndTime.Add(-time.Minute)))
+    assert.True(t, a.ExtendForBidAt(a.EndTime.Add(-time.Minute)), "last extension is capped")
+    assert.Equal(t, end.Add(7*time.Minute), a.EndTime)
+    assert.Equal(t, 7*time.Minute, a.TotalExtension)
+
+    assert.False(t, a.ExtendForBidAt(a.EndTime.Add(-time.Minute)), "max extension reached")
+
+    disabled := &entity.Auction{EndTime: end}
+    assert.False(t, disabled.ExtendForBidAt(end.Add(-time.Second)))
+}
+
+func TestPlaceBidExtendsEndTime(t *testing.T) {
+    end := time.Now().Add(time.Minute)
+    auctionRepo := newMemoryAuctionRepo(&entity.Auction{
+        ID:                1,
+        CurrentPrice:      usd(100),
+        MinStep:           usd(10),
+        Status:            entity.AuctionStatusActive,
+        EndTime:           end,
+        ExtensionWindow:   2 * time.Minute,
+        ExtensionDuration: 5 * time.Minute,
+    })
+    userRepo := newMemoryUserRepo(userWithBalance(1, usd(1000)))
+    bidRepo := &memoryBidRepo{}
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+
+    _, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 1, Amount: usd(110)})
+    require.NoError(t, err)
+
+    auction, err := auctionRepo.GetByID(context.Background(), 1)
+    require.NoError(t, err)
+    assert.Equal(t, end.Add(5*time.Minute), auction.EndTime)
+    assert.Equal(t, 5*time.Minute, auction.TotalExtension)
+}
+
+func TestPlaceBidAfterEndTime(t *testing.T) {
+    auctionRepo := newMemoryAuctionRepo(&entity.Auction{
+        ID:           1,
+        CurrentPrice: usd(100),
+        MinStep:      usd(10),
+        Status:       entity.AuctionStatusActive,
+        EndTime:      time.Now().Add(-time.Second),
+    })
+    userRepo := newMemoryUserRepo(userWithBalance(1, usd(1000)))
+    bidRepo := &memoryBidRepo{}
+    uc := bidUC.NewPlaceBidUseCase(bidRepo, auctionRepo, &memoryMaxBidRepo{}, newMemoryLotRepo(), &memoryTxManager{}, escrow.NewService(&memoryHoldRepo{}, bidRepo, userRepo, userRepo), eventbus.NewAuctionBus(16, 0), newTestDispatcher(), 50)
+
+    _, err := uc.Execute(context.Background(), &dto.PlaceBidRequest{AuctionID: 1, UserID: 1, Amount: usd(110)})
+    assert.Error(t, err)
+}
+
+func TestCreateAuctionSoftCloseSettings(t *testing.T) {
+    mockUC := new(mockCreateAuctionUC)
+    h := &handler.AuctionHandler{
+        CreateAuctionUC: mockUC,
+    }
+
+    ctx := context.Background()
+    now := time.Now()
+    req := &pb.CreateAuctionRequest{
+        LotId:                  1,
+        StartPrice:             pbUSD(100),
+        MinStep:                pbUSD(10),
+        StartTime:              timestamppb.New(now),
+        EndTime:                timestamppb.New(now.Add(24 * time.Hour)),
+        ExtensionWindowMinutes: 5,
+        ExtensionMinutes:       2,
+        MaxExtensionMinutes:    30,
+    }
+
+    expectedResp := createTestAuctionResponse()
+    expectedResp.ExtensionWindow = 5 * time.Minute
+    expectedResp.ExtensionDuration = 2 * time.Minute
+    expectedResp.MaxExtension = 30 * time.Minute
+    mockUC.On("Execute", ctx, mock.MatchedBy(func(req *auctionDto.CreateAuctionRequest) bool {
+        return req.ExtensionWindow == 5*time.Minute &&
+            req.ExtensionDuration == 2*time.Minute &&
+            req.MaxExtension == 30*time.Minute
+    })).Return(expectedResp, nil)
+
+    resp, err := h.CreateAuction(ctx, req)
+
+    assert.NoError(t, err)
+    assert.Equal(t, int32(5), resp.Auction.ExtensionWindowMinutes)
+    assert.Equal(t, int32(2), resp.Auction.ExtensionMinutes)
+    assert.Equal(t, int32(30), resp.Auction.MaxExtensionMinutes)
+    mockUC.AssertExpectations(t)
+}
@@ -7,6 +7,7 @@ import (
     
     "github.com/stretchr/testify/assert"
     "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
     
     pb "auction-system/pkg/api"
     dto "auction-system/internal/application/dto/user"
@@ -88,7 +89,7 @@ func createTestUserResponse() *dto.UserResponse {
         ID:        1,
         Username:  "testuser",
         Email:     "test@example.com",
-        Balance:   100.0,
+        Wallets:   []dto.WalletResponse{{Currency: "USD", Balance: usd(100), HeldBalance: usd(0), AvailableBalance: usd(100)}},
         CreatedAt: now,
     }
 }
@@ -240,15 +241,16 @@ func TestUpdateBalance(t *testing.T) {
     ctx := context.Background()
     req := &pb.UpdateBalanceRequest{
         UserId: 1,
-        Amount: 50.0,
+        Amount: pbUSD(50),
     }
 
     expectedUser := createTestUserResponse()
-    expectedUser.Balance = 150.0
+    expectedUser.Wallets[0].Balance = usd(150)
+    expectedUser.Wallets[0].AvailableBalance = usd(150)
 
     mockBalanceUC.On("Execute", ctx, userUC.UpdateBalanceInput{
         UserID: req.UserId,
-        Amount: req.Amount,
+        Amount: usd(50),
     }).Return(nil)
 
     mockGetUserUC.On("Execute", ctx, int64(1)).Return(expectedUser, nil)
@@ -260,7 +262,9 @@ func TestUpdateBalance(t *testing.T) {
     assert.Equal(t, expectedUser.ID, resp.User.Id)
     assert.Equal(t, expectedUser.Username, resp.User.Username)
     assert.Equal(t, expectedUser.Email, resp.User.Email)
-    assert.Equal(t, expectedUser.Balance, resp.User.Balance)
+    require.Len(t, resp.User.Wallets, 1)
+    assert.Equal(t, "USD", resp.User.Wallets[0].Currency)
+    assert.Equal(t, expectedUser.Wallets[0].Balance, fromPB(resp.User.Wallets[0].Balance))
     mockBalanceUC.AssertExpectations(t)
     mockGetUserUC.AssertExpectations(t)
 }
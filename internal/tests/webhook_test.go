@@ -0,0 +1,593 @@
+package tests
+
+import (
+    "context"
+    "encoding/json"
+    "io"
+    "net/http"
+    "net/http/httptest"
+    "strconv"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/require"
+
+    webhookDto "auction-system/internal/application/dto/webhook"
+    "auction-system/internal/application/eventbus"
+    webhookUC "auction-system/internal/application/usecase/webhook"
+    "auction-system/internal/application/webhook"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/domain/event"
+    webhookDomain "auction-system/internal/domain/webhook"
+    webhookInfra "auction-system/internal/infrastructure/webhook"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+// webhookReceiver is a local webhook endpoint that answers with the status
+// codes in answers, one per request, and 200 once they run out.
+type webhookReceiver struct {
+    server *httptest.Server
+
+    mu       sync.Mutex
+    answers  []int
+    requests []receivedWebhook
+}
+
+type receivedWebhook struct {
+    header http.Header
+    body   []byte
+}
+
+func newWebhookReceiver(t *testing.T, answers ...int) *webhookReceiver {
+    r := &webhookReceiver{answers: answers}
+    r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+        body, _ := io.ReadAll(req.Body)
+        r.mu.Lock()
+        r.requests = append(r.requests, receivedWebhook{header: req.Header.Clone(), body: body})
+        answer := http.StatusOK
+        if len(r.answers) > 0 {
+            answer, r.answers = r.answers[0], r.answers[1:]
+        }
+        r.mu.Unlock()
+        w.WriteHeader(answer)
+        io.WriteString(w, http.StatusText(answer))
+    }))
+    t.Cleanup(r.server.Close)
+    return r
+}
+
+func (r *webhookReceiver) received() []receivedWebhook {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    return append([]receivedWebhook(nil), r.requests...)
+}
+
+type webhookFixture struct {
+    webhooks   *memoryWebhookRepo
+    deliveries *memoryWebhookDeliveryRepo
+    publisher  event.Publisher
+    deliverer  *webhook.Deliverer
+    handler    *handler.WebhookHandler
+}
+
+var webhookRetryPolicy = webhook.RetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: 90 * time.Second}
+
+// newWebhookFixture has users 1 and 2. Its webhooks may point to private
+// addresses, such as the local receivers of the tests.
+func newWebhookFixture() *webhookFixture {
+    userRepo := newMemoryUserRepo(userWithBalance(1, usd(0)), userWithBalance(2, usd(0)))
+    txManager := &memoryTxManager{}
+    f := &webhookFixture{}
+    f.webhooks, f.deliveries = newMemoryWebhookRepos()
+    f.publisher = webhook.NewPublisher(eventbus.NewAuctionBus(16, 0), f.deliveries)
+    f.deliverer = webhook.NewDeliverer(f.webhooks, f.deliveries, webhookInfra.NewHTTPSender(time.Second, true), txManager, webhookRetryPolicy)
+    f.handler = handler.NewWebhookHandler(
+        webhookUC.NewCreateWebhookUseCase(userRepo, f.webhooks, true),
+        webhookUC.NewListWebhooksUseCase(userRepo, f.webhooks),
+        webhookUC.NewDeleteWebhookUseCase(f.webhooks),
+        webhookUC.NewListWebhookDeliveriesUseCase(f.webhooks, f.deliveries),
+        webhookUC.NewRedeliverWebhookUseCase(f.webhooks, f.deliveries, txManager),
+    )
+    return f
+}
+
+func (f *webhookFixture) createWebhook(t *testing.T, userID int64, url string, eventTypes ...string) *pb.Webhook {
+    resp, err := f.handler.CreateWebhook(context.Background(), &pb.CreateWebhookRequest{
+        UserId:     userID,
+        Url:        url,
+        EventTypes: eventTypes,
+        Secret:     "0123456789abcdef",
+    })
+    require.NoError(t, err)
+    return resp.Webhook
+}
+
+func (f *webhookFixture) publish(t *testing.T, eventType entity.AuctionEventType) *entity.AuctionEvent {
+    e := &entity.AuctionEvent{AuctionID: 9, Type: eventType, CurrentPrice: usd(120), Status: entity.AuctionStatusActive, OccurredAt: time.Now()}
+    require.NoError(t, f.publisher.Publish(context.Background(), e))
+    return e
+}
+
+func (f *webhookFixture) deliveryLog(t *testing.T, userID, webhookID int64) []*pb.WebhookDelivery {
+    resp, err := f.handler.ListWebhookDeliveries(context.Background(), &pb.ListWebhookDeliveriesRequest{UserId: userID, WebhookId: webhookID, PageSize: 20, PageNumber: 1})
+    require.NoError(t, err)
+    return resp.Deliveries
+}
+
+func TestWebhookPublisherQueuesMatchingWebhooks(t *testing.T) {
+    f := newWebhookFixture()
+    receiver := newWebhookReceiver(t)
+    all := f.createWebhook(t, 1, receiver.server.URL)
+    bids := f.createWebhook(t, 2, receiver.server.URL, "BID_PLACED")
+
+    placed := f.publish(t, entity.AuctionEventBidPlaced)
+    ended := f.publish(t, entity.AuctionEventEnded)
+    require.NotZero(t, placed.ID, "the bus numbers the event")
+
+    log := f.deliveryLog(t, 1, all.Id)
+    require.Len(t, log, 2)
+    assert.Equal(t, ended.ID, log[0].EventId)
+    assert.Equal(t, placed.ID, log[1].EventId)
+    assert.Equal(t, "PENDING", log[0].Status)
+
+    log = f.deliveryLog(t, 2, bids.Id)
+    require.Len(t, log, 1)
+    assert.Equal(t, "BID_PLACED", log[0].EventType)
+
+    var payload entity.AuctionEvent
+    require.NoError(t, json.Unmarshal([]byte(log[0].Payload), &payload))
+    assert.Equal(t, placed.ID, payload.ID)
+    assert.Equal(t, int64(9), payload.AuctionID)
+}
+
+func TestWebhookDeliveryIsSigned(t *testing.T) {
+    f := newWebhookFixture()
+    receiver := newWebhookReceiver(t)
+    hook := f.createWebhook(t, 1, receiver.server.URL)
+    published := f.publish(t, entity.AuctionEventStarted)
+
+    require.NoError(t, f.deliverer.ProcessDue(context.Background(), time.Now()))
+
+    requests := receiver.received()
+    require.Len(t, requests, 1)
+    request := requests[0]
+    assert.Equal(t, "application/json", request.header.Get("Content-Type"))
+    assert.Equal(t, "STARTED", request.header.Get(webhookDomain.EventHeader))
+
+    log := f.deliveryLog(t, 1, hook.Id)
+    require.Len(t, log, 1)
+    assert.Equal(t, strconv.FormatInt(log[0].Id, 10), request.header.Get(webhookDomain.DeliveryHeader))
+    assert.JSONEq(t, log[0].Payload, string(request.body))
+
+    timestamp, err := strconv.ParseInt(request.header.Get(webhookDomain.TimestampHeader), 10, 64)
+    require.NoError(t, err)
+    assert.Equal(t, "sha256="+webhookDomain.Signature("0123456789abcdef", timestamp, request.body), request.header.Get(webhookDomain.SignatureHeader))
+
+    var payload entity.AuctionEvent
+    require.NoError(t, json.Unmarshal(request.body, &payload))
+    assert.Equal(t, published.ID, payload.ID)
+
+    assert.Equal(t, "DELIVERED", log[0].Status)
+    assert.Equal(t, int32(1), log[0].Attempts)
+    assert.Equal(t, int32(200), log[0].LastStatusCode)
+    assert.NotNil(t, log[0].DeliveredAt)
+
+    require.NoError(t, f.deliverer.ProcessDue(context.Background(), time.Now().Add(time.Hour)))
+    assert.Len(t, receiver.received(), 1, "a delivered event is not sent again")
+}
+
+func TestWebhookRetriesWithBackoffUntilDead(t *testing.T) {
+    f := newWebhookFixture()
+    receiver := newWebhookReceiver(t, 500, 500, 500)
+    hook := f.createWebhook(t, 1, receiver.server.URL)
+    f.publish(t, entity.AuctionEventEnded)
+    ctx := context.Background()
+    now := time.Now()
+
+    require.NoError(t, f.deliverer.ProcessDue(ctx, now))
+    delivery := f.deliveryLog(t, 1, hook.Id)[0]
+    assert.Equal(t, "PENDING", delivery.Status)
+    assert.Equal(t, int32(500), delivery.LastStatusCode)
+    assert.Contains(t, delivery.LastError, "500")
+    assert.WithinDuration(t, now.Add(time.Minute), delivery.NextAttemptAt.AsTime(), time.Millisecond)
+
+    require.NoError(t, f.deliverer.ProcessDue(ctx, now.Add(30*time.Second)))
+    assert.Len(t, receiver.received(), 1, "not due yet")
+
+    now = now.Add(time.Minute)
+    require.NoError(t, f.deliverer.ProcessDue(ctx, now))
+    delivery = f.deliveryLog(t, 1, hook.Id)[0]
+    assert.Equal(t, int32(2), delivery.Attempts)
+    assert.WithinDuration(t, now.Add(90*time.Second), delivery.NextAttemptAt.AsTime(), time.Millisecond, "the delay is capped")
+
+    now = now.Add(90 * time.Second)
+    require.NoError(t, f.deliverer.ProcessDue(ctx, now))
+    delivery = f.deliveryLog(t, 1, hook.Id)[0]
+    assert.Equal(t, "DEAD", delivery.Status)
+    assert.Equal(t, int32(3), delivery.Attempts)
+
+    require.NoError(t, f.deliverer.ProcessDue(ctx, now.Add(time.Hour)))
+    assert.Len(t, receiver.received(), 3)
+}
+
+func TestWebhookUnreachableReceiverIsRetried(t *testing.T) {
+    f := newWebhookFixture()
+    receiver := newWebhookReceiver(t)
+    receiver.server.Close()
+    hook := f.createWebhook(t, 1, receiver.server.URL)
+    f.publish(t, entity.AuctionEventEnded)
+
+    require.NoError(t, f.deliverer.ProcessDue(context.Background(), time.Now()))
+    delivery := f.deliveryLog(t, 1, hook.Id)[0]
+    assert.Equal(t, "PENDING", delivery.Status)
+    assert.Equal(t, int32(0), delivery.LastStatusCode)
+    assert.NotEmpty(t, delivery.LastError)
+}
+
+// concurrentSender runs during before the first request it sends, while
+// that request is still in flight.
+type concurrentSender struct {
+    next   webhookDomain.Sender
+    during func(ctx context.Context)
+    sent   int
+}
+
+func (s *concurrentSender) Send(ctx context.Context, hook *entity.Webhook, delivery *entity.WebhookDelivery) (int, error) {
+    s.sent++
+    if s.sent == 1 {
+        s.during(ctx)
+    }
+    return s.next.Send(ctx, hook, delivery)
+}
+
+func TestWebhookIsSentWithoutTransactionAndClaimedOnce(t *testing.T) {
+    f := newWebhookFixture()
+    receiver := newWebhookReceiver(t)
+    hook := f.createWebhook(t, 1, receiver.server.URL)
+    f.publish(t, entity.AuctionEventEnded)
+    ctx := context.Background()
+    now := time.Now()
+
+    sender := &concurrentSender{next: webhookInfra.NewHTTPSender(time.Second, true)}
+    deliverer := webhook.NewDeliverer(f.webhooks, f.deliveries, sender, &memoryTxManager{}, webhookRetryPolicy)
+    sender.during = func(ctx context.Context) {
+        assert.Nil(t, ctx.Value(memoryTxKey{}), "no transaction is open while the receiver is called")
+        // A second worker would block here if the first one held the
+        // delivery's lock, and must not send the claimed delivery again.
+        assert.NoError(t, deliverer.ProcessDue(context.Background(), now))
+    }
+
+    require.NoError(t, deliverer.ProcessDue(ctx, now))
+    assert.Equal(t, 1, sender.sent)
+    assert.Len(t, receiver.received(), 1)
+    delivery := f.deliveryLog(t, 1, hook.Id)[0]
+    assert.Equal(t, "DELIVERED", delivery.Status)
+    assert.Equal(t, int32(1), delivery.Attempts)
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+    f := newWebhookFixture()
+    receiver := newWebhookReceiver(t, 500, 500, 500)
+    hook := f.createWebhook(t, 1, receiver.server.URL)
+    f.publish(t, entity.AuctionEventEnded)
+    ctx := context.Background()
+
+    delivery := f.deliveryLog(t, 1, hook.Id)[0]
+    redeliver := &pb.RedeliverWebhookRequest{UserId: 1, WebhookId: hook.Id, DeliveryId: delivery.Id}
+    _, err := f.handler.RedeliverWebhook(ctx, redeliver)
+    assert.Error(t, err, "the delivery is still queued")
+
+    now := time.Now()
+    for i := 0; i < webhookRetryPolicy.MaxAttempts; i++ {
+        require.NoError(t, f.deliverer.ProcessDue(ctx, now))
+        now = now.Add(time.Hour)
+    }
+    require.Equal(t, "DEAD", f.deliveryLog(t, 1, hook.Id)[0].Status)
+
+    _, err = f.handler.RedeliverWebhook(ctx, &pb.RedeliverWebhookRequest{UserId: 2, WebhookId: hook.Id, DeliveryId: delivery.Id})
+    assert.Error(t, err, "someone else's webhook")
+
+    resp, err := f.handler.RedeliverWebhook(ctx, redeliver)
+    require.NoError(t, err)
+    assert.Equal(t, "PENDING", resp.Delivery.Status)
+    assert.Equal(t, int32(0), resp.Delivery.Attempts)
+
+    require.NoError(t, f.deliverer.ProcessDue(ctx, time.Now()))
+    redelivered := receiver.received()
+    require.Len(t, redelivered, 4)
+    assert.Equal(t, redelivered[0].header.Get(webhookDomain.DeliveryHeader), redelivered[3].header.Get(webhookDomain.DeliveryHeader), "receivers can de-duplicate")
+
+    log := f.deliveryLog(t, 1, hook.Id)
+    require.Len(t, log, 1)
+    assert.Equal(t, "DELIVERED", log[0].Status)
+}
+
+func TestCreateWebhookValidation(t *testing.T) {
+    f := newWebhookFixture()
+    ctx := context.Background()
+
+    for name, req := range map[string]*pb.CreateWebhookRequest{
+        "relative url":  {UserId: 1, Url: "/hooks"},
+        "not http":      {UserId: 1, Url: "ftp://example.com/hooks"},
+        "unknown event": {UserId: 1, Url: "https://example.com/hooks", EventTypes: []string{"BID_WITHDRAWN"}},
+        "short secret":  {UserId: 1, Url: "https://example.com/hooks", Secret: "hunter2"},
+        "unknown user":  {UserId: 42, Url: "https://example.com/hooks"},
+    } {
+        _, err := f.handler.CreateWebhook(ctx, req)
+        assert.Error(t, err, name)
+    }
+
+    created, err := f.handler.CreateWebhook(ctx, &pb.CreateWebhookRequest{UserId: 1, Url: "https://example.com/hooks", EventTypes: []string{"ENDED", "ENDED"}})
+    require.NoError(t, err)
+    assert.Equal(t, []string{"ENDED"}, created.Webhook.EventTypes)
+    assert.Regexp(t, "^whsec_[0-9a-f]{64}$", created.Webhook.Secret)
+
+    listed, err := f.handler.ListWebhooks(ctx, &pb.ListWebhooksRequest{UserId: 1})
+    require.NoError(t, err)
+    require.Len(t, listed.Webhooks, 1)
+    assert.Empty(t, listed.Webhooks[0].Secret, "the secret is only shown once")
+}
+
+func TestCreateWebhookRefusesPrivateAddresses(t *testing.T) {
+    userRepo := newMemoryUserRepo(userWithBalance(1, usd(0)))
+    webhooks, _ := newMemoryWebhookRepos()
+    uc := webhookUC.NewCreateWebhookUseCase(userRepo, webhooks, false)
+    ctx := context.Background()
+
+    for _, url := range []string{
+        "http://127.0.0.1:8080/hooks",
+        "http://localhost/hooks",
+        "http://10.1.2.3/hooks",
+        "http://192.168.0.10/hooks",
+        "http://169.254.169.254/latest/meta-data",
+        "http://[::1]/hooks",
+        "http://[::ffff:127.0.0.1]/hooks",
+        "http://[fe80::1]/hooks",
+        "http://0.0.0.0/hooks",
+    } {
+        _, err := uc.Execute(ctx, &webhookDto.CreateWebhookRequest{UserID: 1, URL: url})
+        assert.Error(t, err, url)
+    }
+
+    _, err := uc.Execute(ctx, &webhookDto.CreateWebhookRequest{UserID: 1, URL: "https://93.184.216.34/hooks"})
+    assert.NoError(t, err)
+}
+
+func TestWebhookSenderRefusesPrivateAddressWhenDialing(t *testing.T) {
+    receiver := newWebhookReceiver(t)
+    sender := webhookInfra.NewHTTPSender(time.Second, false)
+
+    hook := &entity.Webhook{ID: 1, URL: receiver.server.URL, Secret: "0123456789abcdef"}
+    status, err := sender.Send(context.Background(), hook, &entity.WebhookDelivery{ID: 1, Payload: []byte("{}")})
+    require.Error(t, err)
+    assert.Contains(t, err.Error(), "not public")
+    assert.Zero(t, status)
+    assert.Empty(t, receiver.received())
+}
+
+func TestDeleteWebhookDropsItsDeliveries(t *testing.T) {
+    f := newWebhookFixture()
+    receiver := newWebhookReceiver(t)
+    hook := f.createWebhook(t, 1, receiver.server.URL)
+    f.publish(t, entity.AuctionEventEnded)
+    ctx := context.Background()
+
+    _, err := f.handler.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{UserId: 2, WebhookId: hook.Id})
+    assert.Error(t, err, "someone else's webhook")
+
+    resp, err := f.handler.DeleteWebhook(ctx, &pb.DeleteWebhookRequest{UserId: 1, WebhookId: hook.Id})
+    require.NoError(t, err)
+    assert.True(t, resp.Success)
+
+    require.NoError(t, f.deliverer.ProcessDue(ctx, time.Now()))
+    assert.Empty(t, receiver.received())
+    _, err = f.handler.ListWebhookDeliveries(ctx, &pb.ListWebhookDeliveriesRequest{UserId: 1, WebhookId: hook.Id, PageSize: 20, PageNumber: 1})
+    assert.Error(t, err)
+}
+
+// memoryWebhookRepo removes a deleted webhook's deliveries from deliveries,
+// which in turn queues deliveries for the webhooks stored here.
+type memoryWebhookRepo struct {
+    mu         sync.Mutex
+    webhooks   []*entity.Webhook
+    deliveries *memoryWebhookDeliveryRepo
+}
+
+type memoryWebhookDeliveryRepo struct {
+    mu         sync.Mutex
+    locks      memoryRowLocks
+    deliveries []*entity.WebhookDelivery
+    webhooks   *memoryWebhookRepo
+}
+
+func newMemoryWebhookRepos() (*memoryWebhookRepo, *memoryWebhookDeliveryRepo) {
+    webhooks := &memoryWebhookRepo{}
+    deliveries := &memoryWebhookDeliveryRepo{webhooks: webhooks}
+    webhooks.deliveries = deliveries
+    return webhooks, deliveries
+}
+
+func (r *memoryWebhookRepo) Create(ctx context.Context, webhook *entity.Webhook) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var lastID int64
+    if len(r.webhooks) > 0 {
+        lastID = r.webhooks[len(r.webhooks)-1].ID
+    }
+    webhook.ID = lastID + 1
+    webhook.CreatedAt = time.Now()
+    copied := *webhook
+    r.webhooks = append(r.webhooks, &copied)
+    return nil
+}
+
+func (r *memoryWebhookRepo) GetByID(ctx context.Context, id int64) (*entity.Webhook, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, w := range r.webhooks {
+        if w.ID == id {
+            copied := *w
+            return &copied, nil
+        }
+    }
+    return nil, errors.NewNotFoundError("webhook not found")
+}
+
+func (r *memoryWebhookRepo) GetByUserID(ctx context.Context, userID int64) ([]*entity.Webhook, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var webhooks []*entity.Webhook
+    for _, w := range r.webhooks {
+        if w.UserID == userID {
+            copied := *w
+            webhooks = append(webhooks, &copied)
+        }
+    }
+    return webhooks, nil
+}
+
+func (r *memoryWebhookRepo) Delete(ctx context.Context, id int64) error {
+    r.mu.Lock()
+    for i, w := range r.webhooks {
+        if w.ID == id {
+            r.webhooks = append(r.webhooks[:i], r.webhooks[i+1:]...)
+            r.mu.Unlock()
+
+            r.deliveries.mu.Lock()
+            defer r.deliveries.mu.Unlock()
+            var kept []*entity.WebhookDelivery
+            for _, d := range r.deliveries.deliveries {
+                if d.WebhookID != id {
+                    kept = append(kept, d)
+                }
+            }
+            r.deliveries.deliveries = kept
+            return nil
+        }
+    }
+    r.mu.Unlock()
+    return errors.NewNotFoundError("webhook not found")
+}
+
+func (r *memoryWebhookDeliveryRepo) CreateForEvent(ctx context.Context, event *entity.AuctionEvent, payload []byte) error {
+    webhooks := r.webhooks.all()
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    now := time.Now()
+    for _, w := range webhooks {
+        if !w.Wants(event.Type) {
+            continue
+        }
+        r.deliveries = append(r.deliveries, &entity.WebhookDelivery{
+            ID:            int64(len(r.deliveries) + 1),
+            WebhookID:     w.ID,
+            EventID:       event.ID,
+            EventType:     string(event.Type),
+            Payload:       payload,
+            Status:        entity.WebhookDeliveryStatusPending,
+            NextAttemptAt: now,
+            CreatedAt:     now,
+            UpdatedAt:     now,
+        })
+    }
+    return nil
+}
+
+func (r *memoryWebhookDeliveryRepo) CreateForUser(ctx context.Context, userID int64, eventType string, payload []byte) error {
+    webhooks := r.webhooks.all()
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    now := time.Now()
+    for _, w := range webhooks {
+        if w.UserID != userID {
+            continue
+        }
+        r.deliveries = append(r.deliveries, &entity.WebhookDelivery{
+            ID:            int64(len(r.deliveries) + 1),
+            WebhookID:     w.ID,
+            EventType:     eventType,
+            Payload:       payload,
+            Status:        entity.WebhookDeliveryStatusPending,
+            NextAttemptAt: now,
+            CreatedAt:     now,
+            UpdatedAt:     now,
+        })
+    }
+    return nil
+}
+
+func (r *memoryWebhookRepo) all() []*entity.Webhook {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    return append([]*entity.Webhook(nil), r.webhooks...)
+}
+
+func (r *memoryWebhookDeliveryRepo) GetByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, d := range r.deliveries {
+        if d.ID == id {
+            copied := *d
+            return &copied, nil
+        }
+    }
+    return nil, errors.NewNotFoundError("webhook delivery not found")
+}
+
+func (r *memoryWebhookDeliveryRepo) GetByIDForUpdate(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+    r.locks.lock(ctx, id)
+    return r.GetByID(ctx, id)
+}
+
+func (r *memoryWebhookDeliveryRepo) GetByWebhookID(ctx context.Context, webhookID int64, status entity.WebhookDeliveryStatus, offset, limit int) ([]*entity.WebhookDelivery, int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var matched []*entity.WebhookDelivery
+    for i := len(r.deliveries) - 1; i >= 0; i-- {
+        d := r.deliveries[i]
+        if d.WebhookID == webhookID && (status == "" || d.Status == status) {
+            copied := *d
+            matched = append(matched, &copied)
+        }
+    }
+    total := int64(len(matched))
+    if offset >= len(matched) {
+        return nil, total, nil
+    }
+    matched = matched[offset:]
+    if len(matched) > limit {
+        matched = matched[:limit]
+    }
+    return matched, total, nil
+}
+
+func (r *memoryWebhookDeliveryRepo) GetDue(ctx context.Context, now time.Time, limit int) ([]*entity.WebhookDelivery, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var due []*entity.WebhookDelivery
+    for _, d := range r.deliveries {
+        if d.Status == entity.WebhookDeliveryStatusPending && !d.NextAttemptAt.After(now) && len(due) < limit {
+            copied := *d
+            due = append(due, &copied)
+        }
+    }
+    return due, nil
+}
+
+func (r *memoryWebhookDeliveryRepo) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for i, d := range r.deliveries {
+        if d.ID == delivery.ID {
+            delivery.UpdatedAt = time.Now()
+            copied := *delivery
+            r.deliveries[i] = &copied
+            return nil
+        }
+    }
+    return errors.NewNotFoundError("webhook delivery not found")
+}
@@ -0,0 +1,431 @@
+package tests
+
+import (
+    "context"
+    "fmt"
+    "sync"
+    "testing"
+    "time"
+
+    "github.com/stretchr/testify/assert"
+    "github.com/stretchr/testify/mock"
+    "github.com/stretchr/testify/require"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/metadata"
+    "google.golang.org/grpc/status"
+
+    "auction-system/internal/application/auth"
+    dto "auction-system/internal/application/dto/withdrawal"
+    "auction-system/internal/application/ledger"
+    "auction-system/internal/application/payout"
+    withdrawalUC "auction-system/internal/application/usecase/withdrawal"
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/errors"
+    "auction-system/internal/interfaces/grpc/handler"
+    pb "auction-system/pkg/api"
+)
+
+// fakePayoutProvider declines every payout while err is set.
+type fakePayoutProvider struct {
+    err   error
+    calls int
+}
+
+func (p *fakePayoutProvider) Payout(ctx context.Context, withdrawal *entity.Withdrawal) (string, error) {
+    p.calls++
+    if p.err != nil {
+        return "", p.err
+    }
+    return fmt.Sprintf("PAY-%d", withdrawal.ID), nil
+}
+
+const testDestination = "DE89370400440532013000"
+
+type withdrawalFixture struct {
+    *market
+    ledgerRepo     *memoryLedgerRepo
+    ledger         *ledger.Service
+    withdrawalRepo *memoryWithdrawalRepo
+    provider       *fakePayoutProvider
+    notifier       *recordingNotifier
+    request        *withdrawalUC.RequestWithdrawalUseCase
+    approve        *withdrawalUC.ApproveWithdrawalUseCase
+    reject         *withdrawalUC.RejectWithdrawalUseCase
+    get            *withdrawalUC.GetWithdrawalUseCase
+    processor      *payout.Processor
+}
+
+// newWithdrawalFixture gives seller 2 a balance of 500 USD; user 1 is an
+// admin and user 3 an ordinary user.
+func newWithdrawalFixture(t *testing.T) *withdrawalFixture {
+    admin := &entity.User{ID: 1, IsAdmin: true}
+    f := &withdrawalFixture{
+        market:         newMarket(admin, &entity.User{ID: 2}, &entity.User{ID: 3}),
+        ledgerRepo:     newMemoryLedgerRepo(),
+        withdrawalRepo: &memoryWithdrawalRepo{},
+        provider:       &fakePayoutProvider{},
+        notifier:       &recordingNotifier{},
+    }
+    txManager := &memoryTxManager{}
+    ledgerService := ledger.NewService(f.ledgerRepo, f.userRepo, txManager)
+    require.NoError(t, ledgerService.Deposit(context.Background(), 2, usd(500), "top-up"))
+    f.ledger = ledgerService
+
+    f.request = withdrawalUC.NewRequestWithdrawalUseCase(f.userRepo, f.userRepo, f.holdRepo, f.withdrawalRepo, txManager, ledgerService)
+    f.approve = withdrawalUC.NewApproveWithdrawalUseCase(f.userRepo, f.withdrawalRepo, txManager)
+    f.reject = withdrawalUC.NewRejectWithdrawalUseCase(f.userRepo, f.withdrawalRepo, txManager, ledgerService)
+    f.get = withdrawalUC.NewGetWithdrawalUseCase(f.userRepo, f.withdrawalRepo)
+    f.processor = payout.NewProcessor(f.withdrawalRepo, ledgerService, f.provider, f.notifier, txManager)
+    return f
+}
+
+// accountBalance is the balance of a ledger account, e.g. the payout one.
+func (f *withdrawalFixture) accountBalance(t *testing.T, accountType entity.AccountType, userID *int64) entity.Money {
+    account := f.ledgerRepo.account(accountType, userID, "USD")
+    balance, err := f.ledgerRepo.GetBalance(context.Background(), account.ID)
+    require.NoError(t, err)
+    return balance
+}
+
+func (f *withdrawalFixture) requestWithdrawal(t *testing.T, amount entity.Money) *dto.WithdrawalResponse {
+    resp, err := f.request.Execute(context.Background(), &dto.RequestWithdrawalRequest{UserID: 2, Amount: amount, Destination: testDestination})
+    require.NoError(t, err)
+    return resp
+}
+
+func historyStatuses(w *dto.WithdrawalResponse) []string {
+    var statuses []string
+    for _, e := range w.History {
+        statuses = append(statuses, e.Status)
+    }
+    return statuses
+}
+
+func TestRequestWithdrawalReservesFunds(t *testing.T) {
+    f := newWithdrawalFixture(t)
+    ctx := context.Background()
+
+    for _, req := range []*dto.RequestWithdrawalRequest{
+        {UserID: 2, Amount: usd(0), Destination: testDestination},
+        {UserID: 2, Amount: usd(-100), Destination: testDestination},
+        {UserID: 2, Amount: usd(100), Destination: " "},
+        {UserID: 2, Amount: usd(600), Destination: testDestination},
+    } {
+        _, err := f.request.Execute(ctx, req)
+        assert.Error(t, err, "%+v", req)
+    }
+
+    w := f.requestWithdrawal(t, usd(300))
+    assert.Equal(t, string(entity.WithdrawalStatusPending), w.Status)
+    assert.Equal(t, usd(200), f.balance(t, 2))
+    assert.Equal(t, usd(300), f.accountBalance(t, entity.AccountTypePayout, nil), "the payout account holds the pending amount")
+
+    _, err := f.request.Execute(ctx, &dto.RequestWithdrawalRequest{UserID: 2, Amount: usd(300), Destination: testDestination})
+    assert.Error(t, err, "reserved funds cannot be requested twice")
+}
+
+func TestApprovedWithdrawalIsPaidOut(t *testing.T) {
+    f := newWithdrawalFixture(t)
+    ctx := context.Background()
+    w := f.requestWithdrawal(t, usd(300))
+
+    _, err := f.approve.Execute(asUser(3), &dto.ApproveWithdrawalRequest{WithdrawalID: w.ID})
+    assert.Error(t, err, "only admins review withdrawals")
+
+    require.NoError(t, f.processor.ProcessApproved(ctx))
+    assert.Equal(t, 0, f.provider.calls, "pending withdrawals are not paid out")
+
+    approved, err := f.approve.Execute(asUser(1), &dto.ApproveWithdrawalRequest{WithdrawalID: w.ID})
+    require.NoError(t, err)
+    assert.Equal(t, string(entity.WithdrawalStatusApproved), approved.Status)
+    require.NotNil(t, approved.ReviewerID)
+    assert.Equal(t, int64(1), *approved.ReviewerID)
+
+    require.NoError(t, f.processor.ProcessApproved(ctx))
+    require.NoError(t, f.processor.ProcessApproved(ctx))
+    assert.Equal(t, 1, f.provider.calls)
+
+    got, err := f.get.Execute(ctx, &dto.GetWithdrawalRequest{WithdrawalID: w.ID, UserID: 2})
+    require.NoError(t, err)
+    assert.Equal(t, string(entity.WithdrawalStatusCompleted), got.Status)
+    assert.Equal(t, fmt.Sprintf("PAY-%d", w.ID), got.Reference)
+    assert.Equal(t, []string{"PENDING", "APPROVED", "COMPLETED"}, historyStatuses(got))
+    require.NotNil(t, got.History[1].ActorID)
+    assert.Equal(t, int64(1), *got.History[1].ActorID)
+    assert.Nil(t, got.History[2].ActorID, "the payout worker acts on its own")
+
+    assert.Equal(t, usd(200), f.balance(t, 2))
+    assert.True(t, f.accountBalance(t, entity.AccountTypePayout, nil).IsZero())
+    assert.Equal(t, []transactionStatus{{userID: 2, amount: usd(300), success: true}}, f.notifier.statuses)
+
+    _, err = f.reject.Execute(asUser(1), &dto.RejectWithdrawalRequest{WithdrawalID: w.ID, Reason: "too late"})
+    assert.Error(t, err, "only pending withdrawals can be reviewed")
+}
+
+func TestRejectedWithdrawalReturnsFunds(t *testing.T) {
+    f := newWithdrawalFixture(t)
+    w := f.requestWithdrawal(t, usd(300))
+
+    _, err := f.reject.Execute(asUser(1), &dto.RejectWithdrawalRequest{WithdrawalID: w.ID})
+    assert.Error(t, err, "a reason is required")
+
+    rejected, err := f.reject.Execute(asUser(1), &dto.RejectWithdrawalRequest{WithdrawalID: w.ID, Reason: "unverified account"})
+    require.NoError(t, err)
+    assert.Equal(t, string(entity.WithdrawalStatusRejected), rejected.Status)
+    assert.Equal(t, "unverified account", rejected.Note)
+    assert.Equal(t, usd(500), f.balance(t, 2))
+    assert.True(t, f.accountBalance(t, entity.AccountTypePayout, nil).IsZero())
+
+    _, err = f.approve.Execute(asUser(1), &dto.ApproveWithdrawalRequest{WithdrawalID: w.ID})
+    assert.Error(t, err)
+}
+
+func TestFailedPayoutReturnsFunds(t *testing.T) {
+    f := newWithdrawalFixture(t)
+    ctx := context.Background()
+    f.provider.err = fmt.Errorf("account closed")
+    w := f.requestWithdrawal(t, usd(300))
+    _, err := f.approve.Execute(asUser(1), &dto.ApproveWithdrawalRequest{WithdrawalID: w.ID})
+    require.NoError(t, err)
+
+    require.NoError(t, f.processor.ProcessApproved(ctx))
+
+    got, err := f.get.Execute(ctx, &dto.GetWithdrawalRequest{WithdrawalID: w.ID, UserID: 2})
+    require.NoError(t, err)
+    assert.Equal(t, string(entity.WithdrawalStatusFailed), got.Status)
+    assert.Equal(t, "account closed", got.Note)
+    assert.Equal(t, []string{"PENDING", "APPROVED", "FAILED"}, historyStatuses(got))
+    assert.Equal(t, usd(500), f.balance(t, 2))
+    assert.Equal(t, []transactionStatus{{userID: 2, amount: usd(300), success: false}}, f.notifier.statuses)
+}
+
+// asUser is a context authenticated as userID, as AdminAuthInterceptor
+// leaves it.
+func asUser(userID int64) context.Context {
+    return auth.WithUser(context.Background(), userID)
+}
+
+func TestWithdrawalReviewRequiresAuthenticatedReviewer(t *testing.T) {
+    f := newWithdrawalFixture(t)
+    w := f.requestWithdrawal(t, usd(300))
+
+    var appErr *errors.AppError
+    _, err := f.approve.Execute(context.Background(), &dto.ApproveWithdrawalRequest{WithdrawalID: w.ID})
+    require.ErrorAs(t, err, &appErr)
+    assert.Equal(t, errors.ErrorTypeUnauthorized, appErr.Type)
+    _, err = f.reject.Execute(context.Background(), &dto.RejectWithdrawalRequest{WithdrawalID: w.ID, Reason: "no"})
+    require.ErrorAs(t, err, &appErr)
+    assert.Equal(t, errors.ErrorTypeUnauthorized, appErr.Type)
+}
+
+func withBearer(token string) context.Context {
+    return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAdminAuthInterceptor(t *testing.T) {
+    approve := &grpc.UnaryServerInfo{FullMethod: pb.WithdrawalService_ApproveWithdrawal_FullMethodName}
+    var reviewer int64
+    handle := func(ctx context.Context, req any) (any, error) {
+        reviewer, _ = auth.UserID(ctx)
+        return &pb.WithdrawalResponse{}, nil
+    }
+    req := &pb.ApproveWithdrawalRequest{WithdrawalId: 1}
+
+    disabled := handler.NewAdminAuthInterceptor(nil, pb.WithdrawalService_ApproveWithdrawal_FullMethodName).Unary()
+    _, err := disabled(withBearer("anything"), req, approve, handle)
+    assert.Equal(t, codes.PermissionDenied, status.Code(err), "without tokens reviews are refused")
+
+    interceptor := handler.NewAdminAuthInterceptor(map[string]int64{"s3cret": 1}, pb.WithdrawalService_ApproveWithdrawal_FullMethodName).Unary()
+    _, err = interceptor(context.Background(), req, approve, handle)
+    assert.Equal(t, codes.Unauthenticated, status.Code(err))
+    _, err = interceptor(withBearer("guess"), req, approve, handle)
+    assert.Equal(t, codes.Unauthenticated, status.Code(err))
+    assert.Zero(t, reviewer)
+
+    _, err = interceptor(withBearer("s3cret"), req, approve, handle)
+    require.NoError(t, err)
+    assert.Equal(t, int64(1), reviewer, "the method runs as the token's admin")
+
+    get := &grpc.UnaryServerInfo{FullMethod: pb.WithdrawalService_GetWithdrawal_FullMethodName}
+    _, err = interceptor(context.Background(), &pb.GetWithdrawalRequest{}, get, func(ctx context.Context, req any) (any, error) {
+        return &pb.WithdrawalResponse{}, nil
+    })
+    assert.NoError(t, err, "other methods are not authenticated")
+}
+
+func TestWithdrawalReviewAndVisibility(t *testing.T) {
+    f := newWithdrawalFixture(t)
+    ctx := context.Background()
+    require.NoError(t, f.ledger.Deposit(ctx, 1, usd(100), "top-up"))
+
+    own, err := f.request.Execute(ctx, &dto.RequestWithdrawalRequest{UserID: 1, Amount: usd(50), Destination: testDestination})
+    require.NoError(t, err)
+    _, err = f.approve.Execute(asUser(1), &dto.ApproveWithdrawalRequest{WithdrawalID: own.ID})
+    assert.Error(t, err, "admins cannot approve their own withdrawals")
+    _, err = f.reject.Execute(asUser(1), &dto.RejectWithdrawalRequest{WithdrawalID: own.ID, Reason: "mine"})
+    assert.Error(t, err, "nor reject them")
+
+    w := f.requestWithdrawal(t, usd(100))
+    _, err = f.get.Execute(ctx, &dto.GetWithdrawalRequest{WithdrawalID: w.ID, UserID: 3})
+    assert.Error(t, err)
+    _, err = f.get.Execute(ctx, &dto.GetWithdrawalRequest{WithdrawalID: w.ID, UserID: 1})
+    assert.NoError(t, err, "admins see every withdrawal")
+
+    list, err := withdrawalUC.NewListWithdrawalsUseCase(f.userRepo, f.withdrawalRepo).Execute(ctx,
+        &dto.ListWithdrawalsRequest{UserID: 2, PageSize: 10, PageNumber: 1})
+    require.NoError(t, err)
+    assert.Equal(t, int64(1), list.TotalCount)
+    require.Len(t, list.Withdrawals, 1)
+    assert.Equal(t, w.ID, list.Withdrawals[0].ID)
+}
+
+type mockRequestWithdrawalUC struct {
+    mock.Mock
+}
+
+func (m *mockRequestWithdrawalUC) Execute(ctx context.Context, req *dto.RequestWithdrawalRequest) (*dto.WithdrawalResponse, error) {
+    args := m.Called(ctx, req)
+    if args.Get(0) == nil {
+        return nil, args.Error(1)
+    }
+    return args.Get(0).(*dto.WithdrawalResponse), args.Error(1)
+}
+
+var _ withdrawalUC.RequestWithdrawalUseCaseInterface = (*mockRequestWithdrawalUC)(nil)
+
+func TestWithdrawalHandler(t *testing.T) {
+    requestUC := new(mockRequestWithdrawalUC)
+    h := &handler.WithdrawalHandler{RequestWithdrawalUC: requestUC}
+    ctx := context.Background()
+
+    createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+    userID := int64(2)
+    requestUC.On("Execute", ctx, &dto.RequestWithdrawalRequest{UserID: 2, Amount: usd(300), Destination: testDestination}).Return(&dto.WithdrawalResponse{
+        ID:          1,
+        UserID:      2,
+        Amount:      usd(300),
+        Destination: testDestination,
+        Status:      "PENDING",
+        History:     []dto.WithdrawalEventResponse{{Status: "PENDING", ActorID: &userID, CreatedAt: createdAt}},
+        CreatedAt:   createdAt,
+        UpdatedAt:   createdAt,
+    }, nil)
+
+    resp, err := h.RequestWithdrawal(ctx, &pb.RequestWithdrawalRequest{UserId: 2, Amount: pbUSD(300), Destination: testDestination})
+    require.NoError(t, err)
+    assert.Equal(t, "PENDING", resp.Withdrawal.Status)
+    assert.Equal(t, usd(300), fromPB(resp.Withdrawal.Amount))
+    assert.Equal(t, int64(0), resp.Withdrawal.ReviewerId)
+    require.Len(t, resp.Withdrawal.History, 1)
+    assert.Equal(t, int64(2), resp.Withdrawal.History[0].ActorId)
+
+    requestUC.AssertExpectations(t)
+}
+
+type memoryWithdrawalRepo struct {
+    mu          sync.Mutex
+    locks       memoryRowLocks
+    withdrawals []*entity.Withdrawal
+}
+
+func (r *memoryWithdrawalRepo) Create(ctx context.Context, withdrawal *entity.Withdrawal) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    withdrawal.ID = int64(len(r.withdrawals) + 1)
+    withdrawal.CreatedAt = time.Now()
+    withdrawal.UpdatedAt = withdrawal.CreatedAt
+    withdrawal.History = []*entity.WithdrawalEvent{r.event(withdrawal, &withdrawal.UserID)}
+    copied := *withdrawal
+    r.withdrawals = append(r.withdrawals, &copied)
+    return nil
+}
+
+func (r *memoryWithdrawalRepo) event(withdrawal *entity.Withdrawal, actorID *int64) *entity.WithdrawalEvent {
+    return &entity.WithdrawalEvent{
+        WithdrawalID: withdrawal.ID,
+        Status:       withdrawal.Status,
+        ActorID:      actorID,
+        Note:         withdrawal.Note,
+        CreatedAt:    time.Now(),
+    }
+}
+
+func (r *memoryWithdrawalRepo) GetByID(ctx context.Context, id int64) (*entity.Withdrawal, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, w := range r.withdrawals {
+        if w.ID == id {
+            copied := *w
+            copied.History = append([]*entity.WithdrawalEvent(nil), w.History...)
+            return &copied, nil
+        }
+    }
+    return nil, errors.NewNotFoundError("withdrawal not found")
+}
+
+func (r *memoryWithdrawalRepo) GetByIDForUpdate(ctx context.Context, id int64) (*entity.Withdrawal, error) {
+    r.locks.lock(ctx, id)
+    withdrawal, err := r.GetByID(ctx, id)
+    if err != nil {
+        return nil, err
+    }
+    withdrawal.History = nil
+    return withdrawal, nil
+}
+
+func (r *memoryWithdrawalRepo) GetByUserID(ctx context.Context, userID int64, offset, limit int) ([]*entity.Withdrawal, int64, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var matched []*entity.Withdrawal
+    for n := len(r.withdrawals) - 1; n >= 0; n-- {
+        if r.withdrawals[n].UserID == userID {
+            copied := *r.withdrawals[n]
+            copied.History = nil
+            matched = append(matched, &copied)
+        }
+    }
+    total := int64(len(matched))
+    if offset >= len(matched) {
+        return nil, total, nil
+    }
+    matched = matched[offset:]
+    if len(matched) > limit {
+        matched = matched[:limit]
+    }
+    return matched, total, nil
+}
+
+func (r *memoryWithdrawalRepo) GetApproved(ctx context.Context, limit int) ([]*entity.Withdrawal, error) {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    var result []*entity.Withdrawal
+    for _, w := range r.withdrawals {
+        if w.Status == entity.WithdrawalStatusApproved {
+            copied := *w
+            copied.History = nil
+            result = append(result, &copied)
+            if len(result) == limit {
+                break
+            }
+        }
+    }
+    return result, nil
+}
+
+func (r *memoryWithdrawalRepo) UpdateStatus(ctx context.Context, withdrawal *entity.Withdrawal, actorID *int64) error {
+    r.mu.Lock()
+    defer r.mu.Unlock()
+    for _, w := range r.withdrawals {
+        if w.ID == withdrawal.ID {
+            event := r.event(withdrawal, actorID)
+            history := append(w.History, event)
+            withdrawal.UpdatedAt = time.Now()
+            withdrawal.History = append(withdrawal.History, event)
+            *w = *withdrawal
+            w.History = history
+            return nil
+        }
+    }
+    return errors.NewNotFoundError("withdrawal not found")
+}
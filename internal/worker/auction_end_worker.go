@@ -2,34 +2,47 @@ package worker
 
 import (
     "context"
+    "fmt"
     "log"
     "time"
     
+    "auction-system/internal/application/notifier"
+    "auction-system/internal/application/settlement"
     "auction-system/internal/domain/entity"
     "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/event"
 )
 
 type AuctionCloserWorker struct {
-    auctionRepo repository.AuctionRepository
-    bidRepo     repository.BidRepository
-    userRepo    repository.UserRepository
-    lotRepo     repository.LotRepository
-    interval    time.Duration
+    auctionRepo   repository.AuctionRepository
+    bidRepo       repository.BidRepository
+    lotRepo       repository.LotRepository
+    txManager     repository.TxManager
+    settlement    *settlement.Service
+    events        event.Publisher
+    notifications *notifier.Dispatcher
+    interval      time.Duration
 }
 
 func NewAuctionCloserWorker(
     auctionRepo repository.AuctionRepository,
     bidRepo repository.BidRepository,
-    userRepo repository.UserRepository,
     lotRepo repository.LotRepository,
+    txManager repository.TxManager,
+    settlement *settlement.Service,
+    events event.Publisher,
+    notifications *notifier.Dispatcher,
     interval time.Duration,
 ) *AuctionCloserWorker {
     return &AuctionCloserWorker{
-        auctionRepo: auctionRepo,
-        bidRepo:     bidRepo,
-        userRepo:    userRepo,
-        lotRepo:     lotRepo,
-        interval:    interval,
+        auctionRepo:   auctionRepo,
+        bidRepo:       bidRepo,
+        lotRepo:       lotRepo,
+        txManager:     txManager,
+        settlement:    settlement,
+        events:        events,
+        notifications: notifications,
+        interval:      interval,
     }
 }
 
@@ -66,50 +79,67 @@ func (w *AuctionCloserWorker) processEndedAuctions(ctx context.Context) error {
 }
 
 func (w *AuctionCloserWorker) processAuction(ctx context.Context, auction *entity.Auction) error {
-    bids, err := w.bidRepo.GetByAuctionID(ctx, auction.ID)
-    if err != nil {
-        return err
-    }
-
-    if len(bids) > 0 {
-        winningBid := bids[0]
-        
-        lot, err := w.lotRepo.GetByID(ctx, auction.LotID)
+    var closed *entity.Auction
+    err := w.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        locked, err := w.auctionRepo.GetByIDForUpdate(ctx, auction.ID)
         if err != nil {
             return err
         }
 
-        winner, err := w.userRepo.GetByID(ctx, winningBid.UserID)
-        if err != nil {
-            return err
+        // A late bid may have extended the auction after it was selected.
+        if locked.Status != entity.AuctionStatusActive || !locked.HasEnded(time.Now()) {
+            return nil
         }
 
-        newWinnerBalance := winner.Balance - winningBid.Amount
-        if err := w.userRepo.UpdateBalance(ctx, winner.ID, newWinnerBalance); err != nil {
+        if err := w.closeAuction(ctx, locked); err != nil {
             return err
         }
+        closed = locked
+        return nil
+    })
+    if err != nil {
+        return err
+    }
 
-        seller, err := w.userRepo.GetByID(ctx, lot.CreatorID)
-        if err != nil {
+    if closed != nil {
+        w.notifications.Dispatch(fmt.Sprintf("results of auction %d", closed.ID), notifier.AuctionResults(closed, w.bidRepo, w.lotRepo))
+    }
+    return nil
+}
+
+func (w *AuctionCloserWorker) closeAuction(ctx context.Context, auction *entity.Auction) error {
+    bids, err := w.bidRepo.GetByAuctionID(ctx, auction.ID)
+    if err != nil {
+        return err
+    }
+
+    winners := auction.Allocate(bids)
+
+    switch {
+    case len(bids) == 0:
+        auction.Status = entity.AuctionStatusEnded
+    case len(winners) == 0:
+        // No bid reached the reserve: the lot stays with the seller, no
+        // money moves and the bidders get their held funds back.
+        auction.Status = entity.AuctionStatusReserveNotMet
+        if err := w.settlement.ReleaseHolds(ctx, auction.ID); err != nil {
             return err
         }
-        
-        newSellerBalance := seller.Balance + winningBid.Amount
-        if err := w.userRepo.UpdateBalance(ctx, seller.ID, newSellerBalance); err != nil {
-            _ = w.userRepo.UpdateBalance(ctx, winner.ID, winner.Balance)
+    default:
+        if err := w.settlement.Settle(ctx, auction, winners); err != nil {
             return err
         }
-
-        auction.Status = entity.AuctionStatusEnded
-        auction.WinnerID = &winningBid.UserID
-        auction.WinnerBidID = &winningBid.ID
-    } else {
-        auction.Status = entity.AuctionStatusEnded
     }
 
-    if err := w.auctionRepo.UpdateStatus(ctx, auction.ID, auction.Status); err != nil {
+    _, err = w.auctionRepo.Update(ctx, auction.ID, &entity.Auction{
+        CurrentPrice: auction.CurrentPrice,
+        Status:       auction.Status,
+        WinnerID:     auction.WinnerID,
+        WinnerBidID:  auction.WinnerBidID,
+    })
+    if err != nil {
         return err
     }
 
-    return nil
+    return w.events.Publish(ctx, entity.NewAuctionEvent(entity.AuctionEventEnded, auction))
 }
@@ -7,6 +7,7 @@ import (
     "auction-system/internal/domain/entity"
     "auction-system/internal/domain/repository"
     "auction-system/internal/domain/notification"
+    "auction-system/internal/domain/event"
 )
 
 type AuctionStartWorker struct {
@@ -14,6 +15,7 @@ type AuctionStartWorker struct {
     bidRepo     repository.BidRepository
     lotRepo     repository.LotRepository
     notifier    notification.NotificationService
+    events      event.Publisher
     interval    time.Duration
 }
 
@@ -22,12 +24,14 @@ func NewAuctionStartWorker(
     bidRepo repository.BidRepository,
     lotRepo repository.LotRepository,
     notifier notification.NotificationService,
+    events event.Publisher,
 ) *AuctionStartWorker {
     return &AuctionStartWorker{
         auctionRepo: auctionRepo,
         bidRepo:     bidRepo,
         lotRepo:     lotRepo,
         notifier:    notifier,
+        events:      events,
         interval:    time.Second * 30,
     }
 }
@@ -75,6 +79,11 @@ func (w *AuctionStartWorker) processAuctions(ctx context.Context) error {
             log.Printf("Error updating auction %d status to ACTIVE: %v", auction.ID, err)
             continue
         }
+        auction.Status = entity.AuctionStatusActive
+
+        if err := w.events.Publish(ctx, entity.NewAuctionEvent(entity.AuctionEventStarted, auction)); err != nil {
+            log.Printf("Error publishing start of auction %d: %v", auction.ID, err)
+        }
 
         if err := w.notifier.NotifyAuctionStarted(ctx, auction, participants); err != nil {
             log.Printf("Error sending notifications for auction %d: %v", auction.ID, err)
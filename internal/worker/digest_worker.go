@@ -0,0 +1,38 @@
+package worker
+
+import (
+    "context"
+    "log"
+    "time"
+
+    "auction-system/internal/application/digest"
+)
+
+// DigestWorker sends the daily and weekly digests once they are due.
+type DigestWorker struct {
+    digests  *digest.Service
+    interval time.Duration
+}
+
+func NewDigestWorker(digests *digest.Service, interval time.Duration) *DigestWorker {
+    return &DigestWorker{
+        digests:  digests,
+        interval: interval,
+    }
+}
+
+func (w *DigestWorker) Start(ctx context.Context) {
+    ticker := time.NewTicker(w.interval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            if err := w.digests.SendDue(ctx, time.Now()); err != nil {
+                log.Printf("Error sending digests: %v", err)
+            }
+        }
+    }
+}
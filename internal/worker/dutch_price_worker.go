@@ -0,0 +1,92 @@
+package worker
+
+import (
+    "context"
+    "log"
+    "time"
+
+    "auction-system/internal/domain/entity"
+    "auction-system/internal/domain/repository"
+    "auction-system/internal/domain/event"
+)
+
+// DutchPriceWorker lowers the asking price of active Dutch auctions
+// according to their decrement schedule.
+type DutchPriceWorker struct {
+    auctionRepo repository.AuctionRepository
+    txManager   repository.TxManager
+    events      event.Publisher
+    interval    time.Duration
+}
+
+func NewDutchPriceWorker(
+    auctionRepo repository.AuctionRepository,
+    txManager repository.TxManager,
+    events event.Publisher,
+    interval time.Duration,
+) *DutchPriceWorker {
+    return &DutchPriceWorker{
+        auctionRepo: auctionRepo,
+        txManager:   txManager,
+        events:      events,
+        interval:    interval,
+    }
+}
+
+func (w *DutchPriceWorker) Start(ctx context.Context) {
+    ticker := time.NewTicker(w.interval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            if err := w.processAuctions(ctx); err != nil {
+                log.Printf("Error lowering dutch auction prices: %v", err)
+            }
+        }
+    }
+}
+
+func (w *DutchPriceWorker) processAuctions(ctx context.Context) error {
+    auctions, err := w.auctionRepo.GetActiveDutchAuctions(ctx)
+    if err != nil {
+        return err
+    }
+
+    for _, auction := range auctions {
+        if err := w.lowerPrice(ctx, auction.ID); err != nil {
+            log.Printf("Error lowering price for auction %d: %v", auction.ID, err)
+        }
+    }
+
+    return nil
+}
+
+func (w *DutchPriceWorker) lowerPrice(ctx context.Context, auctionID int64) error {
+    return w.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+        locked, err := w.auctionRepo.GetByIDForUpdate(ctx, auctionID)
+        if err != nil {
+            return err
+        }
+
+        // A bidder may have accepted the price after the auction was selected.
+        now := time.Now()
+        if locked.Status != entity.AuctionStatusActive || locked.HasEnded(now) {
+            return nil
+        }
+
+        price := locked.DutchPriceAt(now)
+        if !price.LessThan(locked.CurrentPrice) {
+            return nil
+        }
+
+        if _, err := w.auctionRepo.Update(ctx, locked.ID, &entity.Auction{CurrentPrice: price}); err != nil {
+            return err
+        }
+
+        locked.CurrentPrice = price
+        return w.events.Publish(ctx, entity.NewAuctionEvent(entity.AuctionEventPriceChanged, locked))
+    })
+}
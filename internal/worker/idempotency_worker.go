@@ -0,0 +1,38 @@
+package worker
+
+import (
+    "context"
+    "log"
+    "time"
+
+    "auction-system/internal/application/idempotency"
+)
+
+// IdempotencyWorker deletes idempotency keys once they have expired.
+type IdempotencyWorker struct {
+    keys     *idempotency.Service
+    interval time.Duration
+}
+
+func NewIdempotencyWorker(keys *idempotency.Service, interval time.Duration) *IdempotencyWorker {
+    return &IdempotencyWorker{
+        keys:     keys,
+        interval: interval,
+    }
+}
+
+func (w *IdempotencyWorker) Start(ctx context.Context) {
+    ticker := time.NewTicker(w.interval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            if _, err := w.keys.PurgeExpired(ctx, time.Now()); err != nil {
+                log.Printf("Error purging idempotency keys: %v", err)
+            }
+        }
+    }
+}
@@ -0,0 +1,39 @@
+package worker
+
+import (
+    "context"
+    "log"
+    "time"
+
+    "auction-system/internal/application/settlement"
+)
+
+// PaymentWorker collects the payments of settled auctions, retrying failed
+// attempts on the processor's backoff schedule.
+type PaymentWorker struct {
+    payments *settlement.PaymentProcessor
+    interval time.Duration
+}
+
+func NewPaymentWorker(payments *settlement.PaymentProcessor, interval time.Duration) *PaymentWorker {
+    return &PaymentWorker{
+        payments: payments,
+        interval: interval,
+    }
+}
+
+func (w *PaymentWorker) Start(ctx context.Context) {
+    ticker := time.NewTicker(w.interval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            if err := w.payments.ProcessDue(ctx, time.Now()); err != nil {
+                log.Printf("Error processing payments: %v", err)
+            }
+        }
+    }
+}
@@ -0,0 +1,38 @@
+package worker
+
+import (
+    "context"
+    "log"
+    "time"
+
+    "auction-system/internal/application/payout"
+)
+
+// PayoutWorker pays out withdrawals once an admin has approved them.
+type PayoutWorker struct {
+    payouts  *payout.Processor
+    interval time.Duration
+}
+
+func NewPayoutWorker(payouts *payout.Processor, interval time.Duration) *PayoutWorker {
+    return &PayoutWorker{
+        payouts:  payouts,
+        interval: interval,
+    }
+}
+
+func (w *PayoutWorker) Start(ctx context.Context) {
+    ticker := time.NewTicker(w.interval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            if err := w.payouts.ProcessApproved(ctx); err != nil {
+                log.Printf("Error processing payouts: %v", err)
+            }
+        }
+    }
+}
@@ -0,0 +1,39 @@
+package worker
+
+import (
+    "context"
+    "log"
+    "time"
+
+    "auction-system/internal/application/webhook"
+)
+
+// WebhookWorker sends queued webhook deliveries, retrying failed attempts
+// on the deliverer's backoff schedule.
+type WebhookWorker struct {
+    webhooks *webhook.Deliverer
+    interval time.Duration
+}
+
+func NewWebhookWorker(webhooks *webhook.Deliverer, interval time.Duration) *WebhookWorker {
+    return &WebhookWorker{
+        webhooks: webhooks,
+        interval: interval,
+    }
+}
+
+func (w *WebhookWorker) Start(ctx context.Context) {
+    ticker := time.NewTicker(w.interval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-ctx.Done():
+            return
+        case <-ticker.C:
+            if err := w.webhooks.ProcessDue(ctx, time.Now()); err != nil {
+                log.Printf("Error processing webhook deliveries: %v", err)
+            }
+        }
+    }
+}
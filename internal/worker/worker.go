@@ -3,25 +3,52 @@ package worker
 import (
 	"context"
 	"time"
+	"auction-system/internal/application/digest"
+	"auction-system/internal/application/idempotency"
+	"auction-system/internal/application/notifier"
+	"auction-system/internal/application/payout"
+	"auction-system/internal/application/settlement"
+	"auction-system/internal/application/webhook"
 	"auction-system/internal/domain/repository"
 	"auction-system/internal/domain/notification"
+	"auction-system/internal/domain/event"
 )
 
 type Worker struct {
 	auctionStartWorker *AuctionStartWorker
 	auctionEndWorker   *AuctionCloserWorker
+	dutchPriceWorker   *DutchPriceWorker
+	paymentWorker      *PaymentWorker
+	payoutWorker       *PayoutWorker
+	idempotencyWorker  *IdempotencyWorker
+	webhookWorker      *WebhookWorker
+	digestWorker       *DigestWorker
 }
 
 func NewWorker(
 	auctionRepo repository.AuctionRepository,
 	lotRepo repository.LotRepository,
-	userRepo repository.UserRepository,
 	bidRepo repository.BidRepository,
-	notifier notification.NotificationService,
+	txManager repository.TxManager,
+	settlement *settlement.Service,
+	payments *settlement.PaymentProcessor,
+	payouts *payout.Processor,
+	idempotencyKeys *idempotency.Service,
+	webhooks *webhook.Deliverer,
+	digests *digest.Service,
+	notifications notification.NotificationService,
+	dispatcher *notifier.Dispatcher,
+	events event.Publisher,
 ) *Worker {
 	return &Worker{
-		auctionStartWorker: NewAuctionStartWorker(auctionRepo, bidRepo, lotRepo, notifier),
-		auctionEndWorker:   NewAuctionCloserWorker(auctionRepo, bidRepo, userRepo, lotRepo, time.Second * 30),
+		auctionStartWorker: NewAuctionStartWorker(auctionRepo, bidRepo, lotRepo, notifications, events),
+		auctionEndWorker:   NewAuctionCloserWorker(auctionRepo, bidRepo, lotRepo, txManager, settlement, events, dispatcher, time.Second * 30),
+		dutchPriceWorker:   NewDutchPriceWorker(auctionRepo, txManager, events, time.Second * 10),
+		paymentWorker:      NewPaymentWorker(payments, time.Second * 10),
+		payoutWorker:       NewPayoutWorker(payouts, time.Second * 10),
+		idempotencyWorker:  NewIdempotencyWorker(idempotencyKeys, time.Hour),
+		webhookWorker:      NewWebhookWorker(webhooks, time.Second * 5),
+		digestWorker:       NewDigestWorker(digests, time.Minute),
 	}
 }
 
@@ -29,4 +56,16 @@ func (w *Worker) Start(ctx context.Context) {
 	go w.auctionStartWorker.Start(ctx)
 	
 	go w.auctionEndWorker.Start(ctx)
+
+	go w.dutchPriceWorker.Start(ctx)
+
+	go w.paymentWorker.Start(ctx)
+
+	go w.payoutWorker.Start(ctx)
+
+	go w.idempotencyWorker.Start(ctx)
+
+	go w.webhookWorker.Start(ctx)
+
+	go w.digestWorker.Start(ctx)
 }
@@ -0,0 +1,2 @@
+ALTER TABLE bids DROP COLUMN IF EXISTS is_auto;
+DROP TABLE IF EXISTS max_bids;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS max_bids (
+    id SERIAL PRIMARY KEY,
+    auction_id INTEGER NOT NULL REFERENCES auctions(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    max_amount DECIMAL(10,2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_max_bid UNIQUE (auction_id, user_id)
+);
+
+CREATE INDEX idx_max_bids_auction_id ON max_bids(auction_id);
+
+ALTER TABLE bids ADD COLUMN is_auto BOOLEAN NOT NULL DEFAULT FALSE;
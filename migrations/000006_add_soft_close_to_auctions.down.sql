@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_auctions_status_end_time;
+
+ALTER TABLE auctions
+    DROP COLUMN IF EXISTS extension_window_seconds,
+    DROP COLUMN IF EXISTS extension_seconds,
+    DROP COLUMN IF EXISTS max_extension_seconds,
+    DROP COLUMN IF EXISTS extended_seconds;
@@ -0,0 +1,7 @@
+ALTER TABLE auctions
+    ADD COLUMN extension_window_seconds INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN extension_seconds INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN max_extension_seconds INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN extended_seconds INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_auctions_status_end_time ON auctions(status, end_time);
@@ -0,0 +1,4 @@
+-- PostgreSQL cannot drop an enum value, so RESERVE_NOT_MET stays in the type.
+UPDATE auctions SET status = 'ENDED' WHERE status = 'RESERVE_NOT_MET';
+
+ALTER TABLE auctions DROP COLUMN IF EXISTS reserve_price;
@@ -0,0 +1,3 @@
+ALTER TYPE AuctionStatus ADD VALUE IF NOT EXISTS 'RESERVE_NOT_MET';
+
+ALTER TABLE auctions ADD COLUMN reserve_price DECIMAL(10,2);
@@ -0,0 +1,3 @@
+ALTER TABLE auctions
+    DROP COLUMN IF EXISTS buy_now_price,
+    DROP COLUMN IF EXISTS buy_now_disabled;
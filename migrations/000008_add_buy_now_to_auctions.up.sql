@@ -0,0 +1,3 @@
+ALTER TABLE auctions
+    ADD COLUMN buy_now_price DECIMAL(10,2),
+    ADD COLUMN buy_now_disabled BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_auctions_type_status;
+
+ALTER TABLE auctions
+    DROP COLUMN IF EXISTS type,
+    DROP COLUMN IF EXISTS price_decrement,
+    DROP COLUMN IF EXISTS decrement_interval_seconds,
+    DROP COLUMN IF EXISTS floor_price;
+
+DROP TYPE IF EXISTS AuctionType;
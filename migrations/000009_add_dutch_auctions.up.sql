@@ -0,0 +1,12 @@
+CREATE TYPE AuctionType AS ENUM (
+    'ENGLISH',
+    'DUTCH'
+);
+
+ALTER TABLE auctions
+    ADD COLUMN type AuctionType NOT NULL DEFAULT 'ENGLISH',
+    ADD COLUMN price_decrement DECIMAL(10,2),
+    ADD COLUMN decrement_interval_seconds INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN floor_price DECIMAL(10,2);
+
+CREATE INDEX idx_auctions_type_status ON auctions(type, status);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_bids_auction_id_user_id;
+
+-- PostgreSQL cannot drop an enum value, so the sealed types stay in AuctionType.
+UPDATE auctions SET type = 'ENGLISH' WHERE type IN ('SEALED_FIRST_PRICE', 'SEALED_SECOND_PRICE');
@@ -0,0 +1,4 @@
+ALTER TYPE AuctionType ADD VALUE IF NOT EXISTS 'SEALED_FIRST_PRICE';
+ALTER TYPE AuctionType ADD VALUE IF NOT EXISTS 'SEALED_SECOND_PRICE';
+
+CREATE INDEX idx_bids_auction_id_user_id ON bids(auction_id, user_id);
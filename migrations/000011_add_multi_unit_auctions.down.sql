@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS auction_winners;
+
+ALTER TABLE auctions
+    DROP COLUMN IF EXISTS quantity,
+    DROP COLUMN IF EXISTS pricing;
+
+DROP TYPE IF EXISTS MultiUnitPricing;
+
+ALTER TABLE bids DROP COLUMN IF EXISTS quantity;
+
+ALTER TABLE lots DROP COLUMN IF EXISTS quantity;
@@ -0,0 +1,32 @@
+ALTER TABLE lots ADD COLUMN quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0);
+
+ALTER TABLE bids ADD COLUMN quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0);
+
+CREATE TYPE MultiUnitPricing AS ENUM (
+    'UNIFORM',
+    'DISCRIMINATORY'
+);
+
+ALTER TABLE auctions
+    ADD COLUMN quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    ADD COLUMN pricing MultiUnitPricing NOT NULL DEFAULT 'UNIFORM';
+
+CREATE TABLE IF NOT EXISTS auction_winners (
+    id SERIAL PRIMARY KEY,
+    auction_id INTEGER NOT NULL REFERENCES auctions(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    bid_id INTEGER NOT NULL REFERENCES bids(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_price DECIMAL(10,2) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_auction_winners_auction_id ON auction_winners(auction_id);
+CREATE INDEX idx_auction_winners_user_id ON auction_winners(user_id);
+
+-- Auctions settled before this migration have a single winner charged the
+-- final current price.
+INSERT INTO auction_winners (auction_id, user_id, bid_id, quantity, unit_price)
+SELECT id, winner_id, winner_bid_id, 1, current_price
+FROM auctions
+WHERE winner_id IS NOT NULL AND winner_bid_id IS NOT NULL;
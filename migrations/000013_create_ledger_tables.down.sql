@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS ledger_entries;
+DROP FUNCTION IF EXISTS check_ledger_transaction_balanced();
+DROP TABLE IF EXISTS ledger_transactions;
+DROP TABLE IF EXISTS accounts;
+DROP TYPE IF EXISTS EntryDirection;
+DROP TYPE IF EXISTS LedgerTransactionKind;
+DROP TYPE IF EXISTS AccountType;
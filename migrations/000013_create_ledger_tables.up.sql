@@ -0,0 +1,98 @@
+CREATE TYPE AccountType AS ENUM (
+    'USER',
+    'EXTERNAL'
+);
+
+CREATE TABLE IF NOT EXISTS accounts (
+    id SERIAL PRIMARY KEY,
+    type AccountType NOT NULL,
+    user_id INTEGER UNIQUE REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- The external account is the other side of every deposit and withdrawal:
+-- money entering or leaving the platform.
+INSERT INTO accounts (type) VALUES ('EXTERNAL');
+
+CREATE TYPE LedgerTransactionKind AS ENUM (
+    'DEPOSIT',
+    'WITHDRAWAL',
+    'SETTLEMENT',
+    'REFUND',
+    'FEE'
+);
+
+CREATE TABLE IF NOT EXISTS ledger_transactions (
+    id SERIAL PRIMARY KEY,
+    kind LedgerTransactionKind NOT NULL,
+    auction_id INTEGER REFERENCES auctions(id) ON DELETE SET NULL,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TYPE EntryDirection AS ENUM (
+    'DEBIT',
+    'CREDIT'
+);
+
+CREATE TABLE IF NOT EXISTS ledger_entries (
+    id SERIAL PRIMARY KEY,
+    transaction_id INTEGER NOT NULL REFERENCES ledger_transactions(id) ON DELETE CASCADE,
+    account_id INTEGER NOT NULL REFERENCES accounts(id),
+    direction EntryDirection NOT NULL,
+    amount DECIMAL(12,2) NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_ledger_entries_transaction_id ON ledger_entries(transaction_id);
+CREATE INDEX idx_ledger_entries_account_id ON ledger_entries(account_id);
+
+-- Every transaction must balance once its entries are written; the check is
+-- deferred to commit so entries can be inserted one by one.
+CREATE OR REPLACE FUNCTION check_ledger_transaction_balanced() RETURNS TRIGGER AS $$
+DECLARE
+    imbalance DECIMAL(12,2);
+BEGIN
+    SELECT COALESCE(SUM(CASE WHEN direction = 'DEBIT' THEN amount ELSE -amount END), 0)
+    INTO imbalance
+    FROM ledger_entries
+    WHERE transaction_id = NEW.transaction_id;
+
+    IF imbalance <> 0 THEN
+        RAISE EXCEPTION 'ledger transaction % is not balanced', NEW.transaction_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER ledger_entries_balanced
+    AFTER INSERT OR UPDATE ON ledger_entries
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW EXECUTE FUNCTION check_ledger_transaction_balanced();
+
+-- Existing balances become opening deposits so that every user's balance is
+-- backed by postings.
+INSERT INTO accounts (type, user_id)
+SELECT 'USER', id FROM users;
+
+DO $$
+DECLARE
+    u RECORD;
+    tx_id INTEGER;
+    external_id INTEGER;
+BEGIN
+    SELECT id INTO external_id FROM accounts WHERE type = 'EXTERNAL';
+    FOR u IN
+        SELECT a.id AS account_id, users.balance
+        FROM users JOIN accounts a ON a.user_id = users.id
+        WHERE users.balance > 0
+    LOOP
+        INSERT INTO ledger_transactions (kind, description)
+        VALUES ('DEPOSIT', 'opening balance')
+        RETURNING id INTO tx_id;
+
+        INSERT INTO ledger_entries (transaction_id, account_id, direction, amount) VALUES
+            (tx_id, external_id, 'DEBIT', u.balance),
+            (tx_id, u.account_id, 'CREDIT', u.balance);
+    END LOOP;
+END $$;
@@ -0,0 +1,31 @@
+CREATE OR REPLACE FUNCTION check_ledger_transaction_balanced() RETURNS TRIGGER AS $$
+DECLARE
+    imbalance DECIMAL(12,2);
+BEGIN
+    SELECT COALESCE(SUM(CASE WHEN direction = 'DEBIT' THEN amount ELSE -amount END), 0)
+    INTO imbalance
+    FROM ledger_entries
+    WHERE transaction_id = NEW.transaction_id;
+
+    IF imbalance <> 0 THEN
+        RAISE EXCEPTION 'ledger transaction % is not balanced', NEW.transaction_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE ledger_entries ALTER COLUMN amount TYPE DECIMAL(12,2);
+ALTER TABLE fund_holds ALTER COLUMN amount TYPE DECIMAL(10,2);
+ALTER TABLE auction_winners ALTER COLUMN unit_price TYPE DECIMAL(10,2);
+ALTER TABLE max_bids ALTER COLUMN max_amount TYPE DECIMAL(10,2);
+ALTER TABLE bids ALTER COLUMN amount TYPE DECIMAL(10,2);
+ALTER TABLE auctions
+    ALTER COLUMN start_price TYPE DECIMAL(10,2),
+    ALTER COLUMN min_step TYPE DECIMAL(10,2),
+    ALTER COLUMN current_price TYPE DECIMAL(10,2),
+    ALTER COLUMN reserve_price TYPE DECIMAL(10,2),
+    ALTER COLUMN buy_now_price TYPE DECIMAL(10,2),
+    ALTER COLUMN price_decrement TYPE DECIMAL(10,2),
+    ALTER COLUMN floor_price TYPE DECIMAL(10,2);
+ALTER TABLE lots ALTER COLUMN start_price TYPE DECIMAL(10,2);
+ALTER TABLE users ALTER COLUMN balance TYPE DECIMAL(10,2);
@@ -0,0 +1,29 @@
+ALTER TABLE users ADD COLUMN balance DECIMAL(18,2) NOT NULL DEFAULT 0;
+
+UPDATE users
+SET balance = wallets.balance
+FROM wallets
+WHERE wallets.user_id = users.id AND wallets.currency = 'USD';
+
+DROP TABLE IF EXISTS wallets;
+
+DELETE FROM ledger_transactions
+WHERE id IN (
+    SELECT e.transaction_id
+    FROM ledger_entries e JOIN accounts a ON a.id = e.account_id
+    WHERE a.currency <> 'USD'
+);
+DELETE FROM accounts WHERE currency <> 'USD';
+DROP INDEX IF EXISTS idx_accounts_external_currency;
+ALTER TABLE accounts DROP CONSTRAINT accounts_user_id_currency_key;
+ALTER TABLE accounts ADD CONSTRAINT accounts_user_id_key UNIQUE (user_id);
+ALTER TABLE accounts DROP COLUMN currency;
+
+DROP INDEX IF EXISTS idx_fund_holds_user_currency;
+
+ALTER TABLE auction_winners DROP COLUMN currency;
+ALTER TABLE fund_holds DROP COLUMN currency;
+ALTER TABLE max_bids DROP COLUMN currency;
+ALTER TABLE bids DROP COLUMN currency;
+ALTER TABLE auctions DROP COLUMN currency;
+ALTER TABLE lots DROP COLUMN currency;
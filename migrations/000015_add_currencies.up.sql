@@ -0,0 +1,32 @@
+-- Lots and auctions carry a currency; bids, proxy ceilings, holds and
+-- winners record it next to their amount.
+ALTER TABLE lots ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE auctions ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE bids ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE max_bids ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE fund_holds ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE auction_winners ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+
+CREATE INDEX idx_fund_holds_user_currency ON fund_holds(user_id, currency);
+
+-- Ledger accounts are opened per currency; each currency has its own
+-- external account.
+ALTER TABLE accounts ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'USD';
+ALTER TABLE accounts DROP CONSTRAINT accounts_user_id_key;
+ALTER TABLE accounts ADD CONSTRAINT accounts_user_id_currency_key UNIQUE (user_id, currency);
+CREATE UNIQUE INDEX idx_accounts_external_currency ON accounts(currency) WHERE type = 'EXTERNAL';
+
+-- A user's balance per currency; the cached balance moves from users to
+-- wallets.
+CREATE TABLE IF NOT EXISTS wallets (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    currency CHAR(3) NOT NULL,
+    balance DECIMAL(18,2) NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, currency)
+);
+
+INSERT INTO wallets (user_id, currency, balance)
+SELECT id, 'USD', balance FROM users WHERE balance <> 0;
+
+ALTER TABLE users DROP COLUMN balance;
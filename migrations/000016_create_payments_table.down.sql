@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS payments;
+
+-- PostgreSQL cannot drop an enum value, so PAYMENT_FAILED stays in the type.
+UPDATE auctions SET status = 'ENDED' WHERE status = 'PAYMENT_FAILED';
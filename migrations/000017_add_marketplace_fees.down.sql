@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_accounts_platform_currency;
+
+-- PostgreSQL cannot drop an enum value, so PLATFORM stays in AccountType.
+
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS hammer,
+    DROP COLUMN IF EXISTS commission,
+    DROP COLUMN IF EXISTS buyer_premium;
+
+ALTER TABLE lots DROP COLUMN IF EXISTS category;
@@ -0,0 +1,18 @@
+-- Lots carry a category, which selects per-category fee overrides.
+ALTER TABLE lots ADD COLUMN category VARCHAR(50) NOT NULL DEFAULT '';
+
+-- Each payment records its fee breakdown; amount is hammer plus
+-- buyer_premium. Payments opened before fees existed charged the hammer
+-- price only.
+ALTER TABLE payments
+    ADD COLUMN hammer DECIMAL(18,2) NOT NULL DEFAULT 0,
+    ADD COLUMN commission DECIMAL(18,2) NOT NULL DEFAULT 0 CHECK (commission >= 0),
+    ADD COLUMN buyer_premium DECIMAL(18,2) NOT NULL DEFAULT 0 CHECK (buyer_premium >= 0);
+
+UPDATE payments SET hammer = amount;
+
+-- The platform account of each currency collects commissions and buyer
+-- premiums.
+ALTER TYPE AccountType ADD VALUE IF NOT EXISTS 'PLATFORM';
+
+CREATE UNIQUE INDEX idx_accounts_platform_currency ON accounts(currency) WHERE type = 'PLATFORM';
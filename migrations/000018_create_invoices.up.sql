@@ -0,0 +1,36 @@
+-- A single counter row hands out invoice numbers. Taking the next number
+-- locks the row until the settling transaction ends, so numbers are
+-- sequential and a rolled back sale leaves no gap.
+CREATE TABLE IF NOT EXISTS invoice_sequence (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    last_number BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO invoice_sequence (id, last_number) VALUES (TRUE, 0) ON CONFLICT DO NOTHING;
+
+-- Lot, buyer and seller details are copied at issue time.
+CREATE TABLE IF NOT EXISTS invoices (
+    id SERIAL PRIMARY KEY,
+    number VARCHAR(20) NOT NULL UNIQUE,
+    auction_id INTEGER NOT NULL REFERENCES auctions(id) ON DELETE CASCADE,
+    payment_id INTEGER NOT NULL UNIQUE REFERENCES payments(id) ON DELETE CASCADE,
+    lot_id INTEGER NOT NULL,
+    lot_title VARCHAR(255) NOT NULL,
+    buyer_id INTEGER NOT NULL REFERENCES users(id),
+    buyer_name VARCHAR(50) NOT NULL,
+    buyer_email VARCHAR(255) NOT NULL,
+    seller_id INTEGER NOT NULL REFERENCES users(id),
+    seller_name VARCHAR(50) NOT NULL,
+    seller_email VARCHAR(255) NOT NULL,
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    hammer DECIMAL(18,2) NOT NULL,
+    commission DECIMAL(18,2) NOT NULL DEFAULT 0,
+    buyer_premium DECIMAL(18,2) NOT NULL DEFAULT 0,
+    tax_rate DECIMAL(5,2) NOT NULL DEFAULT 0 CHECK (tax_rate >= 0),
+    tax DECIMAL(18,2) NOT NULL DEFAULT 0,
+    total DECIMAL(18,2) NOT NULL,
+    issued_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_invoices_buyer_id ON invoices(buyer_id);
+CREATE INDEX idx_invoices_seller_id ON invoices(seller_id);
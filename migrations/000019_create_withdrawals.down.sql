@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS withdrawal_events;
+DROP TABLE IF EXISTS withdrawals;
+
+DROP INDEX IF EXISTS idx_accounts_payout_currency;
+
+-- PostgreSQL cannot drop an enum value, so PAYOUT stays in AccountType.
+
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
@@ -0,0 +1,38 @@
+-- Admins review withdrawals.
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The payout account of each currency holds money reserved for withdrawals
+-- until it is paid out or returned.
+ALTER TYPE AccountType ADD VALUE IF NOT EXISTS 'PAYOUT';
+
+CREATE UNIQUE INDEX idx_accounts_payout_currency ON accounts(currency) WHERE type = 'PAYOUT';
+
+CREATE TABLE IF NOT EXISTS withdrawals (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    amount DECIMAL(18,2) NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    destination VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'APPROVED', 'COMPLETED', 'REJECTED', 'FAILED')),
+    reviewer_id INTEGER REFERENCES users(id),
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_withdrawals_user_id ON withdrawals(user_id);
+CREATE INDEX idx_withdrawals_open ON withdrawals(id) WHERE status IN ('PENDING', 'APPROVED');
+
+-- Every status a withdrawal enters is recorded, including the first.
+CREATE TABLE IF NOT EXISTS withdrawal_events (
+    id SERIAL PRIMARY KEY,
+    withdrawal_id INTEGER NOT NULL REFERENCES withdrawals(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    actor_id INTEGER REFERENCES users(id),
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_withdrawal_events_withdrawal_id ON withdrawal_events(withdrawal_id);
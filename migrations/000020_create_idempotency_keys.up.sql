@@ -0,0 +1,12 @@
+-- Requests made with an Idempotency-Key. response stays NULL while the
+-- first request is being processed.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key VARCHAR(255) NOT NULL,
+    method VARCHAR(255) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    response BYTEA,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (key, method)
+);
+
+CREATE INDEX idx_idempotency_keys_created_at ON idempotency_keys(created_at);
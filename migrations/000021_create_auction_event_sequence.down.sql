@@ -0,0 +1 @@
+DROP SEQUENCE IF EXISTS auction_event_id_seq;
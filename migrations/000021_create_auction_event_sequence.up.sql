@@ -0,0 +1,3 @@
+-- Numbers auction events across app instances; the events themselves are
+-- only sent with NOTIFY on the auction_events channel.
+CREATE SEQUENCE IF NOT EXISTS auction_event_id_seq;
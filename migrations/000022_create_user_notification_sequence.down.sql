@@ -0,0 +1 @@
+DROP SEQUENCE IF EXISTS user_notification_id_seq;
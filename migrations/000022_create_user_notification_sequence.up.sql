@@ -0,0 +1,3 @@
+-- Numbers user notifications across app instances; they are sent with
+-- NOTIFY on the user_notifications channel.
+CREATE SEQUENCE IF NOT EXISTS user_notification_id_seq;
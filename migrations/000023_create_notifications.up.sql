@@ -0,0 +1,13 @@
+-- In-app notification inbox. IDs come from user_notification_id_seq, so a
+-- notification has the same ID in the inbox and on the user's event streams.
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGINT PRIMARY KEY DEFAULT nextval('user_notification_id_seq'),
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL,
+    message TEXT NOT NULL,
+    read_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_notifications_user_id ON notifications(user_id, id DESC);
+CREATE INDEX idx_notifications_unread ON notifications(user_id) WHERE read_at IS NULL;
@@ -0,0 +1,14 @@
+-- Users without a row here get the default preferences.
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id INTEGER PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    -- Maps notification types to arrays of channels. Types that are missing
+    -- use the default channels.
+    channels JSONB NOT NULL DEFAULT '{}',
+    -- Minutes after midnight in time_zone; both are NULL without quiet hours.
+    quiet_hours_start SMALLINT,
+    quiet_hours_end SMALLINT,
+    time_zone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    digest VARCHAR(10) NOT NULL DEFAULT 'NONE'
+        CHECK (digest IN ('NONE', 'DAILY', 'WEEKLY')),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
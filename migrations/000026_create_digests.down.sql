@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS digests;
+DROP TABLE IF EXISTS watchlist;
+ALTER TABLE notification_preferences DROP COLUMN IF EXISTS followed_categories;
@@ -0,0 +1,19 @@
+ALTER TABLE notification_preferences
+    ADD COLUMN IF NOT EXISTS followed_categories TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE TABLE IF NOT EXISTS watchlist (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    auction_id INTEGER NOT NULL REFERENCES auctions(id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, auction_id)
+);
+
+-- One row per digest sent, so that no digest is sent twice. period_start
+-- is the first day of the digest's period in the user's time zone.
+CREATE TABLE IF NOT EXISTS digests (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    frequency VARCHAR(10) NOT NULL,
+    period_start DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, frequency, period_start)
+);
@@ -0,0 +1,9 @@
+UPDATE payments SET status = 'PENDING' WHERE status = 'CHARGING';
+UPDATE payments SET status = 'REFUNDED' WHERE status = 'REFUNDING';
+
+DROP INDEX IF EXISTS idx_payments_due;
+CREATE INDEX idx_payments_due ON payments(next_attempt_at) WHERE status = 'PENDING';
+
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+    CHECK (status IN ('PENDING', 'CAPTURED', 'FAILED', 'REFUNDED'));
@@ -0,0 +1,8 @@
+-- CHARGING and REFUNDING record a provider call that has been decided but
+-- whose result is not recorded yet; they are resumed like pending payments.
+ALTER TABLE payments DROP CONSTRAINT IF EXISTS payments_status_check;
+ALTER TABLE payments ADD CONSTRAINT payments_status_check
+    CHECK (status IN ('PENDING', 'CHARGING', 'CAPTURED', 'FAILED', 'REFUNDING', 'REFUNDED'));
+
+DROP INDEX IF EXISTS idx_payments_due;
+CREATE INDEX idx_payments_due ON payments(next_attempt_at) WHERE status IN ('PENDING', 'CHARGING', 'REFUNDING');
@@ -0,0 +1,5 @@
+-- Idempotency keys belong to the user that sent them, so two users can
+-- pick the same key without seeing each other's requests.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS user_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (user_id, key, method);
@@ -19,10 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuctionService_CreateAuction_FullMethodName = "/auction.AuctionService/CreateAuction"
-	AuctionService_GetAuction_FullMethodName    = "/auction.AuctionService/GetAuction"
-	AuctionService_UpdateAuction_FullMethodName = "/auction.AuctionService/UpdateAuction"
-	AuctionService_ListAuctions_FullMethodName  = "/auction.AuctionService/ListAuctions"
+	AuctionService_CreateAuction_FullMethodName       = "/auction.AuctionService/CreateAuction"
+	AuctionService_GetAuction_FullMethodName          = "/auction.AuctionService/GetAuction"
+	AuctionService_UpdateAuction_FullMethodName       = "/auction.AuctionService/UpdateAuction"
+	AuctionService_ListAuctions_FullMethodName        = "/auction.AuctionService/ListAuctions"
+	AuctionService_BuyNow_FullMethodName              = "/auction.AuctionService/BuyNow"
+	AuctionService_AcceptPrice_FullMethodName         = "/auction.AuctionService/AcceptPrice"
+	AuctionService_GetSettlement_FullMethodName       = "/auction.AuctionService/GetSettlement"
+	AuctionService_AddToWatchlist_FullMethodName      = "/auction.AuctionService/AddToWatchlist"
+	AuctionService_RemoveFromWatchlist_FullMethodName = "/auction.AuctionService/RemoveFromWatchlist"
+	AuctionService_GetWatchlist_FullMethodName        = "/auction.AuctionService/GetWatchlist"
+	AuctionService_WatchAuction_FullMethodName        = "/auction.AuctionService/WatchAuction"
 )
 
 // AuctionServiceClient is the client API for AuctionService service.
@@ -33,6 +40,20 @@ type AuctionServiceClient interface {
 	GetAuction(ctx context.Context, in *GetAuctionRequest, opts ...grpc.CallOption) (*GetAuctionResponse, error)
 	UpdateAuction(ctx context.Context, in *UpdateAuctionRequest, opts ...grpc.CallOption) (*UpdateAuctionResponse, error)
 	ListAuctions(ctx context.Context, in *ListAuctionsRequest, opts ...grpc.CallOption) (*ListAuctionsResponse, error)
+	BuyNow(ctx context.Context, in *BuyNowRequest, opts ...grpc.CallOption) (*BuyNowResponse, error)
+	AcceptPrice(ctx context.Context, in *AcceptPriceRequest, opts ...grpc.CallOption) (*AcceptPriceResponse, error)
+	// GetSettlement shows the seller the fees charged on a sold auction and
+	// what has been paid out.
+	GetSettlement(ctx context.Context, in *GetSettlementRequest, opts ...grpc.CallOption) (*GetSettlementResponse, error)
+	// AddToWatchlist puts an auction on the user's watchlist. Watched
+	// auctions that end soon are listed in the user's digest.
+	AddToWatchlist(ctx context.Context, in *AddToWatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error)
+	RemoveFromWatchlist(ctx context.Context, in *RemoveFromWatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error)
+	GetWatchlist(ctx context.Context, in *GetWatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error)
+	// WatchAuction streams what happens to a pending or active auction as it
+	// happens. The stream ends after the ENDED event; a watcher that falls
+	// too far behind is disconnected with RESOURCE_EXHAUSTED.
+	WatchAuction(ctx context.Context, in *WatchAuctionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuctionEvent], error)
 }
 
 type auctionServiceClient struct {
@@ -83,6 +104,85 @@ func (c *auctionServiceClient) ListAuctions(ctx context.Context, in *ListAuction
 	return out, nil
 }
 
+func (c *auctionServiceClient) BuyNow(ctx context.Context, in *BuyNowRequest, opts ...grpc.CallOption) (*BuyNowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyNowResponse)
+	err := c.cc.Invoke(ctx, AuctionService_BuyNow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionServiceClient) AcceptPrice(ctx context.Context, in *AcceptPriceRequest, opts ...grpc.CallOption) (*AcceptPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptPriceResponse)
+	err := c.cc.Invoke(ctx, AuctionService_AcceptPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionServiceClient) GetSettlement(ctx context.Context, in *GetSettlementRequest, opts ...grpc.CallOption) (*GetSettlementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSettlementResponse)
+	err := c.cc.Invoke(ctx, AuctionService_GetSettlement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionServiceClient) AddToWatchlist(ctx context.Context, in *AddToWatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistResponse)
+	err := c.cc.Invoke(ctx, AuctionService_AddToWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionServiceClient) RemoveFromWatchlist(ctx context.Context, in *RemoveFromWatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistResponse)
+	err := c.cc.Invoke(ctx, AuctionService_RemoveFromWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionServiceClient) GetWatchlist(ctx context.Context, in *GetWatchlistRequest, opts ...grpc.CallOption) (*WatchlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WatchlistResponse)
+	err := c.cc.Invoke(ctx, AuctionService_GetWatchlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionServiceClient) WatchAuction(ctx context.Context, in *WatchAuctionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AuctionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AuctionService_ServiceDesc.Streams[0], AuctionService_WatchAuction_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAuctionRequest, AuctionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuctionService_WatchAuctionClient = grpc.ServerStreamingClient[AuctionEvent]
+
 // AuctionServiceServer is the server API for AuctionService service.
 // All implementations must embed UnimplementedAuctionServiceServer
 // for forward compatibility.
@@ -91,6 +191,20 @@ type AuctionServiceServer interface {
 	GetAuction(context.Context, *GetAuctionRequest) (*GetAuctionResponse, error)
 	UpdateAuction(context.Context, *UpdateAuctionRequest) (*UpdateAuctionResponse, error)
 	ListAuctions(context.Context, *ListAuctionsRequest) (*ListAuctionsResponse, error)
+	BuyNow(context.Context, *BuyNowRequest) (*BuyNowResponse, error)
+	AcceptPrice(context.Context, *AcceptPriceRequest) (*AcceptPriceResponse, error)
+	// GetSettlement shows the seller the fees charged on a sold auction and
+	// what has been paid out.
+	GetSettlement(context.Context, *GetSettlementRequest) (*GetSettlementResponse, error)
+	// AddToWatchlist puts an auction on the user's watchlist. Watched
+	// auctions that end soon are listed in the user's digest.
+	AddToWatchlist(context.Context, *AddToWatchlistRequest) (*WatchlistResponse, error)
+	RemoveFromWatchlist(context.Context, *RemoveFromWatchlistRequest) (*WatchlistResponse, error)
+	GetWatchlist(context.Context, *GetWatchlistRequest) (*WatchlistResponse, error)
+	// WatchAuction streams what happens to a pending or active auction as it
+	// happens. The stream ends after the ENDED event; a watcher that falls
+	// too far behind is disconnected with RESOURCE_EXHAUSTED.
+	WatchAuction(*WatchAuctionRequest, grpc.ServerStreamingServer[AuctionEvent]) error
 	mustEmbedUnimplementedAuctionServiceServer()
 }
 
@@ -113,6 +227,27 @@ func (UnimplementedAuctionServiceServer) UpdateAuction(context.Context, *UpdateA
 func (UnimplementedAuctionServiceServer) ListAuctions(context.Context, *ListAuctionsRequest) (*ListAuctionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAuctions not implemented")
 }
+func (UnimplementedAuctionServiceServer) BuyNow(context.Context, *BuyNowRequest) (*BuyNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyNow not implemented")
+}
+func (UnimplementedAuctionServiceServer) AcceptPrice(context.Context, *AcceptPriceRequest) (*AcceptPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPrice not implemented")
+}
+func (UnimplementedAuctionServiceServer) GetSettlement(context.Context, *GetSettlementRequest) (*GetSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlement not implemented")
+}
+func (UnimplementedAuctionServiceServer) AddToWatchlist(context.Context, *AddToWatchlistRequest) (*WatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToWatchlist not implemented")
+}
+func (UnimplementedAuctionServiceServer) RemoveFromWatchlist(context.Context, *RemoveFromWatchlistRequest) (*WatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromWatchlist not implemented")
+}
+func (UnimplementedAuctionServiceServer) GetWatchlist(context.Context, *GetWatchlistRequest) (*WatchlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchlist not implemented")
+}
+func (UnimplementedAuctionServiceServer) WatchAuction(*WatchAuctionRequest, grpc.ServerStreamingServer[AuctionEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAuction not implemented")
+}
 func (UnimplementedAuctionServiceServer) mustEmbedUnimplementedAuctionServiceServer() {}
 func (UnimplementedAuctionServiceServer) testEmbeddedByValue()                        {}
 
@@ -206,6 +341,125 @@ func _AuctionService_ListAuctions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuctionService_BuyNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServiceServer).BuyNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionService_BuyNow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServiceServer).BuyNow(ctx, req.(*BuyNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionService_AcceptPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServiceServer).AcceptPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionService_AcceptPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServiceServer).AcceptPrice(ctx, req.(*AcceptPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionService_GetSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServiceServer).GetSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionService_GetSettlement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServiceServer).GetSettlement(ctx, req.(*GetSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionService_AddToWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServiceServer).AddToWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionService_AddToWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServiceServer).AddToWatchlist(ctx, req.(*AddToWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionService_RemoveFromWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServiceServer).RemoveFromWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionService_RemoveFromWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServiceServer).RemoveFromWatchlist(ctx, req.(*RemoveFromWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionService_GetWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatchlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionServiceServer).GetWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionService_GetWatchlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionServiceServer).GetWatchlist(ctx, req.(*GetWatchlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionService_WatchAuction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAuctionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuctionServiceServer).WatchAuction(m, &grpc.GenericServerStream[WatchAuctionRequest, AuctionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuctionService_WatchAuctionServer = grpc.ServerStreamingServer[AuctionEvent]
+
 // AuctionService_ServiceDesc is the grpc.ServiceDesc for AuctionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,7 +483,37 @@ var AuctionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAuctions",
 			Handler:    _AuctionService_ListAuctions_Handler,
 		},
+		{
+			MethodName: "BuyNow",
+			Handler:    _AuctionService_BuyNow_Handler,
+		},
+		{
+			MethodName: "AcceptPrice",
+			Handler:    _AuctionService_AcceptPrice_Handler,
+		},
+		{
+			MethodName: "GetSettlement",
+			Handler:    _AuctionService_GetSettlement_Handler,
+		},
+		{
+			MethodName: "AddToWatchlist",
+			Handler:    _AuctionService_AddToWatchlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWatchlist",
+			Handler:    _AuctionService_RemoveFromWatchlist_Handler,
+		},
+		{
+			MethodName: "GetWatchlist",
+			Handler:    _AuctionService_GetWatchlist_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAuction",
+			Handler:       _AuctionService_WatchAuction_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "auction.proto",
 }
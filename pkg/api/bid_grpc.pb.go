@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BidService_PlaceBid_FullMethodName = "/auction.BidService/PlaceBid"
-	BidService_GetBid_FullMethodName   = "/auction.BidService/GetBid"
-	BidService_ListBids_FullMethodName = "/auction.BidService/ListBids"
+	BidService_PlaceBid_FullMethodName  = "/auction.BidService/PlaceBid"
+	BidService_GetBid_FullMethodName    = "/auction.BidService/GetBid"
+	BidService_ListBids_FullMethodName  = "/auction.BidService/ListBids"
+	BidService_SetMaxBid_FullMethodName = "/auction.BidService/SetMaxBid"
 )
 
 // BidServiceClient is the client API for BidService service.
@@ -31,6 +32,7 @@ type BidServiceClient interface {
 	PlaceBid(ctx context.Context, in *PlaceBidRequest, opts ...grpc.CallOption) (*PlaceBidResponse, error)
 	GetBid(ctx context.Context, in *GetBidRequest, opts ...grpc.CallOption) (*GetBidResponse, error)
 	ListBids(ctx context.Context, in *ListBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error)
+	SetMaxBid(ctx context.Context, in *SetMaxBidRequest, opts ...grpc.CallOption) (*SetMaxBidResponse, error)
 }
 
 type bidServiceClient struct {
@@ -71,6 +73,16 @@ func (c *bidServiceClient) ListBids(ctx context.Context, in *ListBidsRequest, op
 	return out, nil
 }
 
+func (c *bidServiceClient) SetMaxBid(ctx context.Context, in *SetMaxBidRequest, opts ...grpc.CallOption) (*SetMaxBidResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMaxBidResponse)
+	err := c.cc.Invoke(ctx, BidService_SetMaxBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BidServiceServer is the server API for BidService service.
 // All implementations must embed UnimplementedBidServiceServer
 // for forward compatibility.
@@ -78,6 +90,7 @@ type BidServiceServer interface {
 	PlaceBid(context.Context, *PlaceBidRequest) (*PlaceBidResponse, error)
 	GetBid(context.Context, *GetBidRequest) (*GetBidResponse, error)
 	ListBids(context.Context, *ListBidsRequest) (*ListBidsResponse, error)
+	SetMaxBid(context.Context, *SetMaxBidRequest) (*SetMaxBidResponse, error)
 	mustEmbedUnimplementedBidServiceServer()
 }
 
@@ -97,6 +110,9 @@ func (UnimplementedBidServiceServer) GetBid(context.Context, *GetBidRequest) (*G
 func (UnimplementedBidServiceServer) ListBids(context.Context, *ListBidsRequest) (*ListBidsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBids not implemented")
 }
+func (UnimplementedBidServiceServer) SetMaxBid(context.Context, *SetMaxBidRequest) (*SetMaxBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxBid not implemented")
+}
 func (UnimplementedBidServiceServer) mustEmbedUnimplementedBidServiceServer() {}
 func (UnimplementedBidServiceServer) testEmbeddedByValue()                    {}
 
@@ -172,6 +188,24 @@ func _BidService_ListBids_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BidService_SetMaxBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaxBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BidServiceServer).SetMaxBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BidService_SetMaxBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BidServiceServer).SetMaxBid(ctx, req.(*SetMaxBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BidService_ServiceDesc is the grpc.ServiceDesc for BidService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +225,10 @@ var BidService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListBids",
 			Handler:    _BidService_ListBids_Handler,
 		},
+		{
+			MethodName: "SetMaxBid",
+			Handler:    _BidService_SetMaxBid_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "bid.proto",
@@ -19,12 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateUser_FullMethodName    = "/api.UserService/CreateUser"
-	UserService_GetUser_FullMethodName       = "/api.UserService/GetUser"
-	UserService_UpdateUser_FullMethodName    = "/api.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName    = "/api.UserService/DeleteUser"
-	UserService_ListUsers_FullMethodName     = "/api.UserService/ListUsers"
-	UserService_UpdateBalance_FullMethodName = "/api.UserService/UpdateBalance"
+	UserService_CreateUser_FullMethodName       = "/api.UserService/CreateUser"
+	UserService_GetUser_FullMethodName          = "/api.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName       = "/api.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName       = "/api.UserService/DeleteUser"
+	UserService_ListUsers_FullMethodName        = "/api.UserService/ListUsers"
+	UserService_UpdateBalance_FullMethodName    = "/api.UserService/UpdateBalance"
+	UserService_ListTransactions_FullMethodName = "/api.UserService/ListTransactions"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -36,7 +37,10 @@ type UserServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// UpdateBalance tops up a wallet; the amount must be positive. Money is
+	// paid out through WithdrawalService.
 	UpdateBalance(ctx context.Context, in *UpdateBalanceRequest, opts ...grpc.CallOption) (*UpdateBalanceResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
 }
 
 type userServiceClient struct {
@@ -107,6 +111,16 @@ func (c *userServiceClient) UpdateBalance(ctx context.Context, in *UpdateBalance
 	return out, nil
 }
 
+func (c *userServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -116,7 +130,10 @@ type UserServiceServer interface {
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// UpdateBalance tops up a wallet; the amount must be positive. Money is
+	// paid out through WithdrawalService.
 	UpdateBalance(context.Context, *UpdateBalanceRequest) (*UpdateBalanceResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -145,6 +162,9 @@ func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersReque
 func (UnimplementedUserServiceServer) UpdateBalance(context.Context, *UpdateBalanceRequest) (*UpdateBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBalance not implemented")
 }
+func (UnimplementedUserServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -274,6 +294,24 @@ func _UserService_UpdateBalance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +343,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBalance",
 			Handler:    _UserService_UpdateBalance_Handler,
 		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _UserService_ListTransactions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -1,19 +1,76 @@
+DELETE FROM withdrawals;
+DELETE FROM ledger_transactions;
+DELETE FROM accounts WHERE type = 'USER';
 DELETE FROM bids;
 DELETE FROM auctions;
 DELETE FROM lots;
 DELETE FROM users;
 
-INSERT INTO users (username, email, balance) VALUES
-('john_doe', 'john@example.com', 1000.00),
-('jane_smith', 'jane@example.com', 2000.00),
-('bob_wilson', 'bob@example.com', 1500.00),
-('alice_brown', 'alice@example.com', 3000.00),
-('mike_davis', 'mike@example.com', 2500.00),
-('sarah_miller', 'sarah@example.com', 1800.00),
-('david_taylor', 'david@example.com', 3500.00),
-('emma_wilson', 'emma@example.com', 2200.00),
-('james_moore', 'james@example.com', 1700.00),
-('lisa_anderson', 'lisa@example.com', 2800.00);
+INSERT INTO users (username, email) VALUES
+('john_doe', 'john@example.com'),
+('jane_smith', 'jane@example.com'),
+('bob_wilson', 'bob@example.com'),
+('alice_brown', 'alice@example.com'),
+('mike_davis', 'mike@example.com'),
+('sarah_miller', 'sarah@example.com'),
+('david_taylor', 'david@example.com'),
+('emma_wilson', 'emma@example.com'),
+('james_moore', 'james@example.com'),
+('lisa_anderson', 'lisa@example.com');
+
+INSERT INTO users (username, email, is_admin) VALUES
+('admin', 'admin@example.com', TRUE);
+
+CREATE TEMP TABLE opening_balances (email TEXT, currency CHAR(3), amount DECIMAL(18,2));
+INSERT INTO opening_balances VALUES
+('john@example.com', 'USD', 1000.00),
+('jane@example.com', 'USD', 2000.00),
+('bob@example.com', 'USD', 1500.00),
+('bob@example.com', 'EUR', 15000.00),
+('alice@example.com', 'USD', 3000.00),
+('mike@example.com', 'USD', 2500.00),
+('mike@example.com', 'EUR', 15000.00),
+('sarah@example.com', 'USD', 1800.00),
+('sarah@example.com', 'EUR', 8000.00),
+('david@example.com', 'USD', 3500.00),
+('emma@example.com', 'USD', 2200.00),
+('emma@example.com', 'EUR', 8000.00),
+('james@example.com', 'USD', 1700.00),
+('lisa@example.com', 'USD', 2800.00);
+
+INSERT INTO accounts (type, currency)
+SELECT DISTINCT 'EXTERNAL', currency FROM opening_balances
+ON CONFLICT (currency) WHERE type = 'EXTERNAL' DO NOTHING;
+
+INSERT INTO accounts (type, user_id, currency)
+SELECT 'USER', u.id, b.currency
+FROM opening_balances b JOIN users u ON u.email = b.email;
+
+INSERT INTO wallets (user_id, currency, balance)
+SELECT u.id, b.currency, b.amount
+FROM opening_balances b JOIN users u ON u.email = b.email;
+
+DO $$
+DECLARE
+    b RECORD;
+    tx_id INTEGER;
+BEGIN
+    FOR b IN
+        SELECT a.id AS account_id, e.id AS external_id, ob.amount
+        FROM opening_balances ob
+        JOIN users u ON u.email = ob.email
+        JOIN accounts a ON a.user_id = u.id AND a.currency = ob.currency
+        JOIN accounts e ON e.type = 'EXTERNAL' AND e.currency = ob.currency
+    LOOP
+        INSERT INTO ledger_transactions (kind, description)
+        VALUES ('DEPOSIT', 'opening balance')
+        RETURNING id INTO tx_id;
+
+        INSERT INTO ledger_entries (transaction_id, account_id, direction, amount) VALUES
+            (tx_id, b.external_id, 'DEBIT', b.amount),
+            (tx_id, b.account_id, 'CREDIT', b.amount);
+    END LOOP;
+END $$;
 
 INSERT INTO lots (title, description, start_price, creator_id) VALUES
 ('Vintage Rolex Watch', 'Rare collectible watch from 1950s', 5000.00, 1),
@@ -82,6 +139,14 @@ INSERT INTO bids (auction_id, user_id, amount) VALUES
 (10, 8, 21000.00),
 (10, 10, 22000.00);
 
+UPDATE lots SET category = 'watches' WHERE id IN (1, 9);
+UPDATE lots SET category = 'art' WHERE id IN (3, 7);
+
+-- The painting and the guitar are sold in euros.
+UPDATE lots SET currency = 'EUR' WHERE id IN (7, 8);
+UPDATE auctions SET currency = 'EUR' WHERE lot_id IN (7, 8);
+UPDATE bids SET currency = 'EUR' WHERE auction_id IN (7, 8);
+
 UPDATE auctions a
 SET current_price = (
     SELECT amount